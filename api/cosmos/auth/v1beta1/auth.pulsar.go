@@ -1785,6 +1785,8 @@ var (
 	fd_Params_tx_size_cost_per_byte     protoreflect.FieldDescriptor
 	fd_Params_sig_verify_cost_ed25519   protoreflect.FieldDescriptor
 	fd_Params_sig_verify_cost_secp256k1 protoreflect.FieldDescriptor
+	fd_Params_sig_verify_cost_taproot   protoreflect.FieldDescriptor
+	fd_Params_sig_verify_cost_schnorr   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1795,6 +1797,8 @@ func init() {
 	fd_Params_tx_size_cost_per_byte = md_Params.Fields().ByName("tx_size_cost_per_byte")
 	fd_Params_sig_verify_cost_ed25519 = md_Params.Fields().ByName("sig_verify_cost_ed25519")
 	fd_Params_sig_verify_cost_secp256k1 = md_Params.Fields().ByName("sig_verify_cost_secp256k1")
+	fd_Params_sig_verify_cost_taproot = md_Params.Fields().ByName("sig_verify_cost_taproot")
+	fd_Params_sig_verify_cost_schnorr = md_Params.Fields().ByName("sig_verify_cost_schnorr")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -1892,6 +1896,18 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.SigVerifyCostTaproot != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SigVerifyCostTaproot)
+		if !f(fd_Params_sig_verify_cost_taproot, value) {
+			return
+		}
+	}
+	if x.SigVerifyCostSchnorr != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SigVerifyCostSchnorr)
+		if !f(fd_Params_sig_verify_cost_schnorr, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1917,6 +1933,10 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.SigVerifyCostEd25519 != uint64(0)
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		return x.SigVerifyCostSecp256K1 != uint64(0)
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_taproot":
+		return x.SigVerifyCostTaproot != uint64(0)
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_schnorr":
+		return x.SigVerifyCostSchnorr != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -1943,6 +1963,10 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.SigVerifyCostEd25519 = uint64(0)
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		x.SigVerifyCostSecp256K1 = uint64(0)
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_taproot":
+		x.SigVerifyCostTaproot = uint64(0)
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_schnorr":
+		x.SigVerifyCostSchnorr = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -1974,6 +1998,12 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		value := x.SigVerifyCostSecp256K1
 		return protoreflect.ValueOfUint64(value)
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_taproot":
+		value := x.SigVerifyCostTaproot
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_schnorr":
+		value := x.SigVerifyCostSchnorr
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -2004,6 +2034,10 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.SigVerifyCostEd25519 = value.Uint()
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		x.SigVerifyCostSecp256K1 = value.Uint()
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_taproot":
+		x.SigVerifyCostTaproot = value.Uint()
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_schnorr":
+		x.SigVerifyCostSchnorr = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -2034,6 +2068,10 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field sig_verify_cost_ed25519 of message cosmos.auth.v1beta1.Params is not mutable"))
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		panic(fmt.Errorf("field sig_verify_cost_secp256k1 of message cosmos.auth.v1beta1.Params is not mutable"))
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_taproot":
+		panic(fmt.Errorf("field sig_verify_cost_taproot of message cosmos.auth.v1beta1.Params is not mutable"))
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_schnorr":
+		panic(fmt.Errorf("field sig_verify_cost_schnorr of message cosmos.auth.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -2057,6 +2095,10 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_taproot":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_schnorr":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -2141,6 +2183,12 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.SigVerifyCostSecp256K1 != 0 {
 			n += 1 + runtime.Sov(uint64(x.SigVerifyCostSecp256K1))
 		}
+		if x.SigVerifyCostTaproot != 0 {
+			n += 1 + runtime.Sov(uint64(x.SigVerifyCostTaproot))
+		}
+		if x.SigVerifyCostSchnorr != 0 {
+			n += 1 + runtime.Sov(uint64(x.SigVerifyCostSchnorr))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2170,6 +2218,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.SigVerifyCostSchnorr != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigVerifyCostSchnorr))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.SigVerifyCostTaproot != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigVerifyCostTaproot))
+			i--
+			dAtA[i] = 0x30
+		}
 		if x.SigVerifyCostSecp256K1 != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigVerifyCostSecp256K1))
 			i--
@@ -2339,6 +2397,44 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostTaproot", wireType)
+				}
+				x.SigVerifyCostTaproot = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SigVerifyCostTaproot |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSchnorr", wireType)
+				}
+				x.SigVerifyCostSchnorr = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SigVerifyCostSchnorr |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2559,6 +2655,10 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostEd25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256K1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// sig_verify_cost_taproot is the gas charged for verifying a BIP-322 signature of a taproot key.
+	SigVerifyCostTaproot uint64 `protobuf:"varint,6,opt,name=sig_verify_cost_taproot,json=sigVerifyCostTaproot,proto3" json:"sig_verify_cost_taproot,omitempty"`
+	// sig_verify_cost_schnorr is the gas charged for verifying a raw BIP-340 Schnorr signature of a taproot key.
+	SigVerifyCostSchnorr uint64 `protobuf:"varint,7,opt,name=sig_verify_cost_schnorr,json=sigVerifyCostSchnorr,proto3" json:"sig_verify_cost_schnorr,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -2616,6 +2716,20 @@ func (x *Params) GetSigVerifyCostSecp256K1() uint64 {
 	return 0
 }
 
+func (x *Params) GetSigVerifyCostTaproot() uint64 {
+	if x != nil {
+		return x.SigVerifyCostTaproot
+	}
+	return 0
+}
+
+func (x *Params) GetSigVerifyCostSchnorr() uint64 {
+	if x != nil {
+		return x.SigVerifyCostSchnorr
+	}
+	return 0
+}
+
 var File_cosmos_auth_v1beta1_auth_proto protoreflect.FileDescriptor
 
 var file_cosmos_auth_v1beta1_auth_proto_rawDesc = []byte{
@@ -2671,7 +2785,7 @@ var file_cosmos_auth_v1beta1_auth_proto_rawDesc = []byte{
 	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x37, 0x8a, 0xe7, 0xb0, 0x2a, 0x21,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x47, 0x72, 0x6f, 0x75, 0x70,
 	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x22, 0xd7, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x13,
+	0x6c, 0x22, 0xf9, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x13,
 	0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
 	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x4d, 0x65,
 	0x6d, 0x6f, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0c,
@@ -2690,22 +2804,32 @@ var file_cosmos_auth_v1beta1_auth_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x16, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72,
 	0x69, 0x66, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31,
 	0x52, 0x16, 0x73, 0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x53,
-	0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x3a, 0x21, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7,
-	0xb0, 0x2a, 0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f,
-	0x61, 0x75, 0x74, 0x68, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc4, 0x01, 0x0a, 0x17,
-	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x09, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x75,
-	0x74, 0x68, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x61, 0x75, 0x74, 0x68, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x58, 0xaa, 0x02, 0x13, 0x43,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0xca, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x75, 0x74, 0x68,
-	0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x5c, 0x41, 0x75, 0x74, 0x68, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x75, 0x74, 0x68, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x12, 0x4f, 0x0a, 0x17, 0x73, 0x69, 0x67, 0x5f,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x74, 0x61, 0x70, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x18, 0xe2, 0xde, 0x1f, 0x14, 0x53,
+	0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x54, 0x61, 0x70, 0x72,
+	0x6f, 0x6f, 0x74, 0x52, 0x14, 0x73, 0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f,
+	0x73, 0x74, 0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x4f, 0x0a, 0x17, 0x73, 0x69, 0x67,
+	0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x68,
+	0x6e, 0x6f, 0x72, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x18, 0xe2, 0xde, 0x1f, 0x14,
+	0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x53, 0x63, 0x68,
+	0x6e, 0x6f, 0x72, 0x72, 0x52, 0x14, 0x73, 0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43,
+	0x6f, 0x73, 0x74, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x3a, 0x21, 0xe8, 0xa0, 0x1f, 0x01,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x78, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc4, 0x01,
+	0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x09, 0x41, 0x75, 0x74, 0x68, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x61, 0x75, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x61, 0x75, 0x74,
+	0x68, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x58, 0xaa, 0x02,
+	0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x75,
+	0x74, 0x68, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x75, 0x74, 0x68, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x75, 0x74, 0x68, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
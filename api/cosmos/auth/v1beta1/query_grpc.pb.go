@@ -29,6 +29,7 @@ const (
 	Query_AddressBytesToString_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressBytesToString"
 	Query_AddressStringToBytes_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressStringToBytes"
 	Query_AccountInfo_FullMethodName          = "/cosmos.auth.v1beta1.Query/AccountInfo"
+	Query_VerifyMessage_FullMethodName        = "/cosmos.auth.v1beta1.Query/VerifyMessage"
 )
 
 // QueryClient is the client API for Query service.
@@ -60,6 +61,9 @@ type QueryClient interface {
 	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// VerifyMessage verifies the BIP-322 signature of an offchain message by an
+	// account, i.e. a proof of ownership of the account address.
+	VerifyMessage(ctx context.Context, in *QueryVerifyMessageRequest, opts ...grpc.CallOption) (*QueryVerifyMessageResponse, error)
 }
 
 type queryClient struct {
@@ -170,6 +174,16 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) VerifyMessage(ctx context.Context, in *QueryVerifyMessageRequest, opts ...grpc.CallOption) (*QueryVerifyMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryVerifyMessageResponse)
+	err := c.cc.Invoke(ctx, Query_VerifyMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -199,6 +213,9 @@ type QueryServer interface {
 	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// VerifyMessage verifies the BIP-322 signature of an offchain message by an
+	// account, i.e. a proof of ownership of the account address.
+	VerifyMessage(context.Context, *QueryVerifyMessageRequest) (*QueryVerifyMessageResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -239,6 +256,9 @@ func (UnimplementedQueryServer) AddressStringToBytes(context.Context, *AddressSt
 func (UnimplementedQueryServer) AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (UnimplementedQueryServer) VerifyMessage(context.Context, *QueryVerifyMessageRequest) (*QueryVerifyMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMessage not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -440,6 +460,24 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VerifyMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VerifyMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyMessage(ctx, req.(*QueryVerifyMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -487,6 +525,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "VerifyMessage",
+			Handler:    _Query_VerifyMessage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
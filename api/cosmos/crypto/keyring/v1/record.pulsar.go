@@ -3,6 +3,7 @@ package keyringv1
 
 import (
 	v1 "cosmossdk.io/api/cosmos/crypto/hd/v1"
+	binary "encoding/binary"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -945,14 +946,16 @@ func (x *fastReflection_Record) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Record_Local          protoreflect.MessageDescriptor
-	fd_Record_Local_priv_key protoreflect.FieldDescriptor
+	md_Record_Local            protoreflect.MessageDescriptor
+	fd_Record_Local_priv_key   protoreflect.FieldDescriptor
+	fd_Record_Local_key_origin protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_crypto_keyring_v1_record_proto_init()
 	md_Record_Local = File_cosmos_crypto_keyring_v1_record_proto.Messages().ByName("Record").Messages().ByName("Local")
 	fd_Record_Local_priv_key = md_Record_Local.Fields().ByName("priv_key")
+	fd_Record_Local_key_origin = md_Record_Local.Fields().ByName("key_origin")
 }
 
 var _ protoreflect.Message = (*fastReflection_Record_Local)(nil)
@@ -1026,6 +1029,12 @@ func (x *fastReflection_Record_Local) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.KeyOrigin != nil {
+		value := protoreflect.ValueOfMessage(x.KeyOrigin.ProtoReflect())
+		if !f(fd_Record_Local_key_origin, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1043,6 +1052,8 @@ func (x *fastReflection_Record_Local) Has(fd protoreflect.FieldDescriptor) bool
 	switch fd.FullName() {
 	case "cosmos.crypto.keyring.v1.Record.Local.priv_key":
 		return x.PrivKey != nil
+	case "cosmos.crypto.keyring.v1.Record.Local.key_origin":
+		return x.KeyOrigin != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Local"))
@@ -1061,6 +1072,8 @@ func (x *fastReflection_Record_Local) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "cosmos.crypto.keyring.v1.Record.Local.priv_key":
 		x.PrivKey = nil
+	case "cosmos.crypto.keyring.v1.Record.Local.key_origin":
+		x.KeyOrigin = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Local"))
@@ -1080,6 +1093,9 @@ func (x *fastReflection_Record_Local) Get(descriptor protoreflect.FieldDescripto
 	case "cosmos.crypto.keyring.v1.Record.Local.priv_key":
 		value := x.PrivKey
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.crypto.keyring.v1.Record.Local.key_origin":
+		value := x.KeyOrigin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Local"))
@@ -1102,6 +1118,8 @@ func (x *fastReflection_Record_Local) Set(fd protoreflect.FieldDescriptor, value
 	switch fd.FullName() {
 	case "cosmos.crypto.keyring.v1.Record.Local.priv_key":
 		x.PrivKey = value.Message().Interface().(*anypb.Any)
+	case "cosmos.crypto.keyring.v1.Record.Local.key_origin":
+		x.KeyOrigin = value.Message().Interface().(*Record_KeyOrigin)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Local"))
@@ -1127,6 +1145,11 @@ func (x *fastReflection_Record_Local) Mutable(fd protoreflect.FieldDescriptor) p
 			x.PrivKey = new(anypb.Any)
 		}
 		return protoreflect.ValueOfMessage(x.PrivKey.ProtoReflect())
+	case "cosmos.crypto.keyring.v1.Record.Local.key_origin":
+		if x.KeyOrigin == nil {
+			x.KeyOrigin = new(Record_KeyOrigin)
+		}
+		return protoreflect.ValueOfMessage(x.KeyOrigin.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Local"))
@@ -1143,6 +1166,9 @@ func (x *fastReflection_Record_Local) NewField(fd protoreflect.FieldDescriptor)
 	case "cosmos.crypto.keyring.v1.Record.Local.priv_key":
 		m := new(anypb.Any)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.crypto.keyring.v1.Record.Local.key_origin":
+		m := new(Record_KeyOrigin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Local"))
@@ -1216,6 +1242,10 @@ func (x *fastReflection_Record_Local) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.PrivKey)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.KeyOrigin != nil {
+			l = options.Size(x.KeyOrigin)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1245,6 +1275,20 @@ func (x *fastReflection_Record_Local) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.KeyOrigin != nil {
+			encoded, err := options.Marshal(x.KeyOrigin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if x.PrivKey != nil {
 			encoded, err := options.Marshal(x.PrivKey)
 			if err != nil {
@@ -1344,6 +1388,42 @@ func (x *fastReflection_Record_Local) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyOrigin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.KeyOrigin == nil {
+					x.KeyOrigin = &Record_KeyOrigin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.KeyOrigin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2171,12 +2251,14 @@ func (x *fastReflection_Record_Multi) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Record_Offline protoreflect.MessageDescriptor
+	md_Record_Offline            protoreflect.MessageDescriptor
+	fd_Record_Offline_key_origin protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_crypto_keyring_v1_record_proto_init()
 	md_Record_Offline = File_cosmos_crypto_keyring_v1_record_proto.Messages().ByName("Record").Messages().ByName("Offline")
+	fd_Record_Offline_key_origin = md_Record_Offline.Fields().ByName("key_origin")
 }
 
 var _ protoreflect.Message = (*fastReflection_Record_Offline)(nil)
@@ -2244,6 +2326,12 @@ func (x *fastReflection_Record_Offline) Interface() protoreflect.ProtoMessage {
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_Record_Offline) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.KeyOrigin != nil {
+		value := protoreflect.ValueOfMessage(x.KeyOrigin.ProtoReflect())
+		if !f(fd_Record_Offline_key_origin, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2259,6 +2347,8 @@ func (x *fastReflection_Record_Offline) Range(f func(protoreflect.FieldDescripto
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_Record_Offline) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Offline.key_origin":
+		return x.KeyOrigin != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Offline"))
@@ -2275,6 +2365,8 @@ func (x *fastReflection_Record_Offline) Has(fd protoreflect.FieldDescriptor) boo
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Record_Offline) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Offline.key_origin":
+		x.KeyOrigin = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Offline"))
@@ -2291,6 +2383,9 @@ func (x *fastReflection_Record_Offline) Clear(fd protoreflect.FieldDescriptor) {
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_Record_Offline) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Offline.key_origin":
+		value := x.KeyOrigin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Offline"))
@@ -2311,6 +2406,8 @@ func (x *fastReflection_Record_Offline) Get(descriptor protoreflect.FieldDescrip
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Record_Offline) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Offline.key_origin":
+		x.KeyOrigin = value.Message().Interface().(*Record_KeyOrigin)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Offline"))
@@ -2331,6 +2428,11 @@ func (x *fastReflection_Record_Offline) Set(fd protoreflect.FieldDescriptor, val
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Record_Offline) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Offline.key_origin":
+		if x.KeyOrigin == nil {
+			x.KeyOrigin = new(Record_KeyOrigin)
+		}
+		return protoreflect.ValueOfMessage(x.KeyOrigin.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Offline"))
@@ -2344,6 +2446,9 @@ func (x *fastReflection_Record_Offline) Mutable(fd protoreflect.FieldDescriptor)
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_Record_Offline) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Offline.key_origin":
+		m := new(Record_KeyOrigin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Offline"))
@@ -2413,6 +2518,10 @@ func (x *fastReflection_Record_Offline) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.KeyOrigin != nil {
+			l = options.Size(x.KeyOrigin)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2442,6 +2551,20 @@ func (x *fastReflection_Record_Offline) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.KeyOrigin != nil {
+			encoded, err := options.Marshal(x.KeyOrigin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -2491,6 +2614,42 @@ func (x *fastReflection_Record_Offline) ProtoMethods() *protoiface.Methods {
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_Offline: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyOrigin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.KeyOrigin == nil {
+					x.KeyOrigin = &Record_KeyOrigin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.KeyOrigin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2526,116 +2685,640 @@ func (x *fastReflection_Record_Offline) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cosmos/crypto/keyring/v1/record.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_Record_KeyOrigin              protoreflect.MessageDescriptor
+	fd_Record_KeyOrigin_fingerprint  protoreflect.FieldDescriptor
+	fd_Record_KeyOrigin_path         protoreflect.FieldDescriptor
+	fd_Record_KeyOrigin_account_xpub protoreflect.FieldDescriptor
 )
 
-// Record is used for representing a key in the keyring.
-type Record struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_cosmos_crypto_keyring_v1_record_proto_init()
+	md_Record_KeyOrigin = File_cosmos_crypto_keyring_v1_record_proto.Messages().ByName("Record").Messages().ByName("KeyOrigin")
+	fd_Record_KeyOrigin_fingerprint = md_Record_KeyOrigin.Fields().ByName("fingerprint")
+	fd_Record_KeyOrigin_path = md_Record_KeyOrigin.Fields().ByName("path")
+	fd_Record_KeyOrigin_account_xpub = md_Record_KeyOrigin.Fields().ByName("account_xpub")
+}
 
-	// name represents a name of Record
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// pub_key represents a public key in any format
-	PubKey *anypb.Any `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	// Record contains one of the following items
-	//
-	// Types that are assignable to Item:
-	//
-	//	*Record_Local_
-	//	*Record_Ledger_
-	//	*Record_Multi_
-	//	*Record_Offline_
-	Item isRecord_Item `protobuf_oneof:"item"`
+var _ protoreflect.Message = (*fastReflection_Record_KeyOrigin)(nil)
+
+type fastReflection_Record_KeyOrigin Record_KeyOrigin
+
+func (x *Record_KeyOrigin) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Record_KeyOrigin)(x)
 }
 
-func (x *Record) Reset() {
-	*x = Record{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[0]
+func (x *Record_KeyOrigin) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Record) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_Record_KeyOrigin_messageType fastReflection_Record_KeyOrigin_messageType
+var _ protoreflect.MessageType = fastReflection_Record_KeyOrigin_messageType{}
 
-func (*Record) ProtoMessage() {}
+type fastReflection_Record_KeyOrigin_messageType struct{}
 
-// Deprecated: Use Record.ProtoReflect.Descriptor instead.
-func (*Record) Descriptor() ([]byte, []int) {
-	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_Record_KeyOrigin_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Record_KeyOrigin)(nil)
+}
+func (x fastReflection_Record_KeyOrigin_messageType) New() protoreflect.Message {
+	return new(fastReflection_Record_KeyOrigin)
+}
+func (x fastReflection_Record_KeyOrigin_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Record_KeyOrigin
 }
 
-func (x *Record) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Record_KeyOrigin) Descriptor() protoreflect.MessageDescriptor {
+	return md_Record_KeyOrigin
 }
 
-func (x *Record) GetPubKey() *anypb.Any {
-	if x != nil {
-		return x.PubKey
-	}
-	return nil
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Record_KeyOrigin) Type() protoreflect.MessageType {
+	return _fastReflection_Record_KeyOrigin_messageType
 }
 
-func (x *Record) GetItem() isRecord_Item {
-	if x != nil {
-		return x.Item
-	}
-	return nil
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Record_KeyOrigin) New() protoreflect.Message {
+	return new(fastReflection_Record_KeyOrigin)
 }
 
-func (x *Record) GetLocal() *Record_Local {
-	if x, ok := x.GetItem().(*Record_Local_); ok {
-		return x.Local
-	}
-	return nil
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Record_KeyOrigin) Interface() protoreflect.ProtoMessage {
+	return (*Record_KeyOrigin)(x)
 }
 
-func (x *Record) GetLedger() *Record_Ledger {
-	if x, ok := x.GetItem().(*Record_Ledger_); ok {
-		return x.Ledger
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Record_KeyOrigin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Fingerprint != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Fingerprint)
+		if !f(fd_Record_KeyOrigin_fingerprint, value) {
+			return
+		}
+	}
+	if x.Path != "" {
+		value := protoreflect.ValueOfString(x.Path)
+		if !f(fd_Record_KeyOrigin_path, value) {
+			return
+		}
+	}
+	if x.AccountXpub != "" {
+		value := protoreflect.ValueOfString(x.AccountXpub)
+		if !f(fd_Record_KeyOrigin_account_xpub, value) {
+			return
+		}
 	}
-	return nil
 }
 
-func (x *Record) GetMulti() *Record_Multi {
-	if x, ok := x.GetItem().(*Record_Multi_); ok {
-		return x.Multi
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Record_KeyOrigin) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.KeyOrigin.fingerprint":
+		return x.Fingerprint != uint32(0)
+	case "cosmos.crypto.keyring.v1.Record.KeyOrigin.path":
+		return x.Path != ""
+	case "cosmos.crypto.keyring.v1.Record.KeyOrigin.account_xpub":
+		return x.AccountXpub != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.KeyOrigin"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.KeyOrigin does not contain field %s", fd.FullName()))
 	}
-	return nil
 }
 
-func (x *Record) GetOffline() *Record_Offline {
-	if x, ok := x.GetItem().(*Record_Offline_); ok {
-		return x.Offline
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Record_KeyOrigin) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.KeyOrigin.fingerprint":
+		x.Fingerprint = uint32(0)
+	case "cosmos.crypto.keyring.v1.Record.KeyOrigin.path":
+		x.Path = ""
+	case "cosmos.crypto.keyring.v1.Record.KeyOrigin.account_xpub":
+		x.AccountXpub = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.KeyOrigin"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.KeyOrigin does not contain field %s", fd.FullName()))
 	}
-	return nil
 }
 
-type isRecord_Item interface {
-	isRecord_Item()
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Record_KeyOrigin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.KeyOrigin.fingerprint":
+		value := x.Fingerprint
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.crypto.keyring.v1.Record.KeyOrigin.path":
+		value := x.Path
+		return protoreflect.ValueOfString(value)
+	case "cosmos.crypto.keyring.v1.Record.KeyOrigin.account_xpub":
+		value := x.AccountXpub
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.KeyOrigin"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.KeyOrigin does not contain field %s", descriptor.FullName()))
+	}
 }
 
-type Record_Local_ struct {
-	// local stores the private key locally.
-	Local *Record_Local `protobuf:"bytes,3,opt,name=local,proto3,oneof"`
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Record_KeyOrigin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.KeyOrigin.fingerprint":
+		x.Fingerprint = uint32(value.Uint())
+	case "cosmos.crypto.keyring.v1.Record.KeyOrigin.path":
+		x.Path = value.Interface().(string)
+	case "cosmos.crypto.keyring.v1.Record.KeyOrigin.account_xpub":
+		x.AccountXpub = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.KeyOrigin"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.KeyOrigin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Record_KeyOrigin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.KeyOrigin.fingerprint":
+		panic(fmt.Errorf("field fingerprint of message cosmos.crypto.keyring.v1.Record.KeyOrigin is not mutable"))
+	case "cosmos.crypto.keyring.v1.Record.KeyOrigin.path":
+		panic(fmt.Errorf("field path of message cosmos.crypto.keyring.v1.Record.KeyOrigin is not mutable"))
+	case "cosmos.crypto.keyring.v1.Record.KeyOrigin.account_xpub":
+		panic(fmt.Errorf("field account_xpub of message cosmos.crypto.keyring.v1.Record.KeyOrigin is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.KeyOrigin"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.KeyOrigin does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Record_KeyOrigin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.KeyOrigin.fingerprint":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.crypto.keyring.v1.Record.KeyOrigin.path":
+		return protoreflect.ValueOfString("")
+	case "cosmos.crypto.keyring.v1.Record.KeyOrigin.account_xpub":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.KeyOrigin"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.KeyOrigin does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Record_KeyOrigin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.keyring.v1.Record.KeyOrigin", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Record_KeyOrigin) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Record_KeyOrigin) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Record_KeyOrigin) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Record_KeyOrigin) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Record_KeyOrigin)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Fingerprint != 0 {
+			n += 5
+		}
+		l = len(x.Path)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AccountXpub)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Record_KeyOrigin)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AccountXpub) > 0 {
+			i -= len(x.AccountXpub)
+			copy(dAtA[i:], x.AccountXpub)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccountXpub)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Path) > 0 {
+			i -= len(x.Path)
+			copy(dAtA[i:], x.Path)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Path)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Fingerprint != 0 {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(x.Fingerprint))
+			i--
+			dAtA[i] = 0xd
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Record_KeyOrigin)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_KeyOrigin: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_KeyOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 5 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+				}
+				x.Fingerprint = 0
+				if (iNdEx + 4) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fingerprint = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Path = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccountXpub", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccountXpub = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/crypto/keyring/v1/record.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Record is used for representing a key in the keyring.
+type Record struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name represents a name of Record
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// pub_key represents a public key in any format
+	PubKey *anypb.Any `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// Record contains one of the following items
+	//
+	// Types that are assignable to Item:
+	//
+	//	*Record_Local_
+	//	*Record_Ledger_
+	//	*Record_Multi_
+	//	*Record_Offline_
+	Item isRecord_Item `protobuf_oneof:"item"`
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Record) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Record) GetPubKey() *anypb.Any {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
+func (x *Record) GetItem() isRecord_Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *Record) GetLocal() *Record_Local {
+	if x, ok := x.GetItem().(*Record_Local_); ok {
+		return x.Local
+	}
+	return nil
+}
+
+func (x *Record) GetLedger() *Record_Ledger {
+	if x, ok := x.GetItem().(*Record_Ledger_); ok {
+		return x.Ledger
+	}
+	return nil
+}
+
+func (x *Record) GetMulti() *Record_Multi {
+	if x, ok := x.GetItem().(*Record_Multi_); ok {
+		return x.Multi
+	}
+	return nil
+}
+
+func (x *Record) GetOffline() *Record_Offline {
+	if x, ok := x.GetItem().(*Record_Offline_); ok {
+		return x.Offline
+	}
+	return nil
+}
+
+type isRecord_Item interface {
+	isRecord_Item()
+}
+
+type Record_Local_ struct {
+	// local stores the private key locally.
+	Local *Record_Local `protobuf:"bytes,3,opt,name=local,proto3,oneof"`
 }
 
 type Record_Ledger_ struct {
@@ -2669,6 +3352,8 @@ type Record_Local struct {
 	unknownFields protoimpl.UnknownFields
 
 	PrivKey *anypb.Any `protobuf:"bytes,1,opt,name=priv_key,json=privKey,proto3" json:"priv_key,omitempty"`
+	// key_origin is the BIP-32 origin of the key, if it was derived from an extended key.
+	KeyOrigin *Record_KeyOrigin `protobuf:"bytes,2,opt,name=key_origin,json=keyOrigin,proto3" json:"key_origin,omitempty"`
 }
 
 func (x *Record_Local) Reset() {
@@ -2698,6 +3383,13 @@ func (x *Record_Local) GetPrivKey() *anypb.Any {
 	return nil
 }
 
+func (x *Record_Local) GetKeyOrigin() *Record_KeyOrigin {
+	if x != nil {
+		return x.KeyOrigin
+	}
+	return nil
+}
+
 // Ledger item
 type Record_Ledger struct {
 	state         protoimpl.MessageState
@@ -2766,6 +3458,9 @@ type Record_Offline struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// key_origin is the BIP-32 origin of a watch-only key derived from an extended public key.
+	KeyOrigin *Record_KeyOrigin `protobuf:"bytes,1,opt,name=key_origin,json=keyOrigin,proto3" json:"key_origin,omitempty"`
 }
 
 func (x *Record_Offline) Reset() {
@@ -2788,6 +3483,70 @@ func (*Record_Offline) Descriptor() ([]byte, []int) {
 	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0, 3}
 }
 
+func (x *Record_Offline) GetKeyOrigin() *Record_KeyOrigin {
+	if x != nil {
+		return x.KeyOrigin
+	}
+	return nil
+}
+
+// KeyOrigin is the BIP-32 origin of a key, used to export it as an extended
+// public key or an output descriptor.
+type Record_KeyOrigin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// fingerprint is the fingerprint of the master key, zero if unknown.
+	Fingerprint uint32 `protobuf:"fixed32,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// path is the derivation path of the key from the master key, e.g. m/86'/0'/0'/0/0.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// account_xpub is the serialized extended public key of the account the key
+	// is derived from by the last two levels of path, empty if unknown.
+	AccountXpub string `protobuf:"bytes,3,opt,name=account_xpub,json=accountXpub,proto3" json:"account_xpub,omitempty"`
+}
+
+func (x *Record_KeyOrigin) Reset() {
+	*x = Record_KeyOrigin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Record_KeyOrigin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record_KeyOrigin) ProtoMessage() {}
+
+// Deprecated: Use Record_KeyOrigin.ProtoReflect.Descriptor instead.
+func (*Record_KeyOrigin) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *Record_KeyOrigin) GetFingerprint() uint32 {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return 0
+}
+
+func (x *Record_KeyOrigin) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Record_KeyOrigin) GetAccountXpub() string {
+	if x != nil {
+		return x.AccountXpub
+	}
+	return ""
+}
+
 var File_cosmos_crypto_keyring_v1_record_proto protoreflect.FileDescriptor
 
 var file_cosmos_crypto_keyring_v1_record_proto_rawDesc = []byte{
@@ -2800,7 +3559,7 @@ var file_cosmos_crypto_keyring_v1_record_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x79, 0x70, 0x74,
 	0x6f, 0x2f, 0x68, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xea, 0x03, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x22, 0xe7, 0x05, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
 	0x2d, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
@@ -2821,32 +3580,47 @@ var file_cosmos_crypto_keyring_v1_record_proto_rawDesc = []byte{
 	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70,
 	0x74, 0x6f, 0x2e, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
 	0x63, 0x6f, 0x72, 0x64, 0x2e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x48, 0x00, 0x52, 0x07,
-	0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x1a, 0x38, 0x0a, 0x05, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
-	0x12, 0x2f, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x76, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x4b, 0x65,
-	0x79, 0x1a, 0x3e, 0x0a, 0x06, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x68, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x49, 0x50, 0x34, 0x34, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x1a, 0x07, 0x0a, 0x05, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x1a, 0x09, 0x0a, 0x07, 0x4f, 0x66,
-	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x42, 0xeb, 0x01,
-	0xc8, 0xe1, 0x1e, 0x00, 0x98, 0xe3, 0x1e, 0x00, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x6b, 0x65, 0x79, 0x72,
-	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2f, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31,
-	0x3b, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x4b,
-	0xaa, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x2e, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x18, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5c, 0x4b, 0x65, 0x79, 0x72,
-	0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x24, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
-	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5c, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x3a, 0x3a,
-	0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x1a, 0x83, 0x01, 0x0a, 0x05, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x12, 0x2f, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x76, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x4b,
+	0x65, 0x79, 0x12, 0x49, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x4b, 0x65, 0x79, 0x4f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x1a, 0x3e, 0x0a,
+	0x06, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x68, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x49, 0x50, 0x34,
+	0x34, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x1a, 0x07, 0x0a,
+	0x05, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x1a, 0x54, 0x0a, 0x07, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x49, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x4b, 0x65, 0x79, 0x4f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x1a, 0x64, 0x0a, 0x09,
+	0x4b, 0x65, 0x79, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x07, 0x52, 0x0b,
+	0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x78, 0x70, 0x75, 0x62, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x58, 0x70,
+	0x75, 0x62, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x42, 0xeb, 0x01, 0xc8, 0xe1, 0x1e,
+	0x00, 0x98, 0xe3, 0x1e, 0x00, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x33, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x2f, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6b, 0x65,
+	0x79, 0x72, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x4b, 0xaa, 0x02, 0x18,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x4b, 0x65,
+	0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x5c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5c, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x24, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x5c, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x3a, 0x3a, 0x4b, 0x65, 0x79,
+	0x72, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2861,29 +3635,32 @@ func file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP() []byte {
 	return file_cosmos_crypto_keyring_v1_record_proto_rawDescData
 }
 
-var file_cosmos_crypto_keyring_v1_record_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_cosmos_crypto_keyring_v1_record_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_cosmos_crypto_keyring_v1_record_proto_goTypes = []interface{}{
-	(*Record)(nil),         // 0: cosmos.crypto.keyring.v1.Record
-	(*Record_Local)(nil),   // 1: cosmos.crypto.keyring.v1.Record.Local
-	(*Record_Ledger)(nil),  // 2: cosmos.crypto.keyring.v1.Record.Ledger
-	(*Record_Multi)(nil),   // 3: cosmos.crypto.keyring.v1.Record.Multi
-	(*Record_Offline)(nil), // 4: cosmos.crypto.keyring.v1.Record.Offline
-	(*anypb.Any)(nil),      // 5: google.protobuf.Any
-	(*v1.BIP44Params)(nil), // 6: cosmos.crypto.hd.v1.BIP44Params
+	(*Record)(nil),           // 0: cosmos.crypto.keyring.v1.Record
+	(*Record_Local)(nil),     // 1: cosmos.crypto.keyring.v1.Record.Local
+	(*Record_Ledger)(nil),    // 2: cosmos.crypto.keyring.v1.Record.Ledger
+	(*Record_Multi)(nil),     // 3: cosmos.crypto.keyring.v1.Record.Multi
+	(*Record_Offline)(nil),   // 4: cosmos.crypto.keyring.v1.Record.Offline
+	(*Record_KeyOrigin)(nil), // 5: cosmos.crypto.keyring.v1.Record.KeyOrigin
+	(*anypb.Any)(nil),        // 6: google.protobuf.Any
+	(*v1.BIP44Params)(nil),   // 7: cosmos.crypto.hd.v1.BIP44Params
 }
 var file_cosmos_crypto_keyring_v1_record_proto_depIdxs = []int32{
-	5, // 0: cosmos.crypto.keyring.v1.Record.pub_key:type_name -> google.protobuf.Any
+	6, // 0: cosmos.crypto.keyring.v1.Record.pub_key:type_name -> google.protobuf.Any
 	1, // 1: cosmos.crypto.keyring.v1.Record.local:type_name -> cosmos.crypto.keyring.v1.Record.Local
 	2, // 2: cosmos.crypto.keyring.v1.Record.ledger:type_name -> cosmos.crypto.keyring.v1.Record.Ledger
 	3, // 3: cosmos.crypto.keyring.v1.Record.multi:type_name -> cosmos.crypto.keyring.v1.Record.Multi
 	4, // 4: cosmos.crypto.keyring.v1.Record.offline:type_name -> cosmos.crypto.keyring.v1.Record.Offline
-	5, // 5: cosmos.crypto.keyring.v1.Record.Local.priv_key:type_name -> google.protobuf.Any
-	6, // 6: cosmos.crypto.keyring.v1.Record.Ledger.path:type_name -> cosmos.crypto.hd.v1.BIP44Params
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	6, // 5: cosmos.crypto.keyring.v1.Record.Local.priv_key:type_name -> google.protobuf.Any
+	5, // 6: cosmos.crypto.keyring.v1.Record.Local.key_origin:type_name -> cosmos.crypto.keyring.v1.Record.KeyOrigin
+	7, // 7: cosmos.crypto.keyring.v1.Record.Ledger.path:type_name -> cosmos.crypto.hd.v1.BIP44Params
+	5, // 8: cosmos.crypto.keyring.v1.Record.Offline.key_origin:type_name -> cosmos.crypto.keyring.v1.Record.KeyOrigin
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_crypto_keyring_v1_record_proto_init() }
@@ -2952,6 +3729,18 @@ func file_cosmos_crypto_keyring_v1_record_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_crypto_keyring_v1_record_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Record_KeyOrigin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_cosmos_crypto_keyring_v1_record_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Record_Local_)(nil),
@@ -2965,7 +3754,7 @@ func file_cosmos_crypto_keyring_v1_record_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_crypto_keyring_v1_record_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
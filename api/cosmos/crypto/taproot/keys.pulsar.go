@@ -858,6 +858,1574 @@ func (x *fastReflection_PrivKey) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_MuSig2PubKey_1_list)(nil)
+
+type _MuSig2PubKey_1_list struct {
+	list *[]*PubKey
+}
+
+func (x *_MuSig2PubKey_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MuSig2PubKey_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MuSig2PubKey_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PubKey)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MuSig2PubKey_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PubKey)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MuSig2PubKey_1_list) AppendMutable() protoreflect.Value {
+	v := new(PubKey)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MuSig2PubKey_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MuSig2PubKey_1_list) NewElement() protoreflect.Value {
+	v := new(PubKey)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MuSig2PubKey_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MuSig2PubKey             protoreflect.MessageDescriptor
+	fd_MuSig2PubKey_public_keys protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_crypto_taproot_keys_proto_init()
+	md_MuSig2PubKey = File_cosmos_crypto_taproot_keys_proto.Messages().ByName("MuSig2PubKey")
+	fd_MuSig2PubKey_public_keys = md_MuSig2PubKey.Fields().ByName("public_keys")
+}
+
+var _ protoreflect.Message = (*fastReflection_MuSig2PubKey)(nil)
+
+type fastReflection_MuSig2PubKey MuSig2PubKey
+
+func (x *MuSig2PubKey) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MuSig2PubKey)(x)
+}
+
+func (x *MuSig2PubKey) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crypto_taproot_keys_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MuSig2PubKey_messageType fastReflection_MuSig2PubKey_messageType
+var _ protoreflect.MessageType = fastReflection_MuSig2PubKey_messageType{}
+
+type fastReflection_MuSig2PubKey_messageType struct{}
+
+func (x fastReflection_MuSig2PubKey_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MuSig2PubKey)(nil)
+}
+func (x fastReflection_MuSig2PubKey_messageType) New() protoreflect.Message {
+	return new(fastReflection_MuSig2PubKey)
+}
+func (x fastReflection_MuSig2PubKey_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MuSig2PubKey
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MuSig2PubKey) Descriptor() protoreflect.MessageDescriptor {
+	return md_MuSig2PubKey
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MuSig2PubKey) Type() protoreflect.MessageType {
+	return _fastReflection_MuSig2PubKey_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MuSig2PubKey) New() protoreflect.Message {
+	return new(fastReflection_MuSig2PubKey)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MuSig2PubKey) Interface() protoreflect.ProtoMessage {
+	return (*MuSig2PubKey)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MuSig2PubKey) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.PublicKeys) != 0 {
+		value := protoreflect.ValueOfList(&_MuSig2PubKey_1_list{list: &x.PublicKeys})
+		if !f(fd_MuSig2PubKey_public_keys, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MuSig2PubKey) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crypto.taproot.MuSig2PubKey.public_keys":
+		return len(x.PublicKeys) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.taproot.MuSig2PubKey"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.taproot.MuSig2PubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MuSig2PubKey) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crypto.taproot.MuSig2PubKey.public_keys":
+		x.PublicKeys = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.taproot.MuSig2PubKey"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.taproot.MuSig2PubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MuSig2PubKey) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crypto.taproot.MuSig2PubKey.public_keys":
+		if len(x.PublicKeys) == 0 {
+			return protoreflect.ValueOfList(&_MuSig2PubKey_1_list{})
+		}
+		listValue := &_MuSig2PubKey_1_list{list: &x.PublicKeys}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.taproot.MuSig2PubKey"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.taproot.MuSig2PubKey does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MuSig2PubKey) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crypto.taproot.MuSig2PubKey.public_keys":
+		lv := value.List()
+		clv := lv.(*_MuSig2PubKey_1_list)
+		x.PublicKeys = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.taproot.MuSig2PubKey"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.taproot.MuSig2PubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MuSig2PubKey) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.taproot.MuSig2PubKey.public_keys":
+		if x.PublicKeys == nil {
+			x.PublicKeys = []*PubKey{}
+		}
+		value := &_MuSig2PubKey_1_list{list: &x.PublicKeys}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.taproot.MuSig2PubKey"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.taproot.MuSig2PubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MuSig2PubKey) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.taproot.MuSig2PubKey.public_keys":
+		list := []*PubKey{}
+		return protoreflect.ValueOfList(&_MuSig2PubKey_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.taproot.MuSig2PubKey"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.taproot.MuSig2PubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MuSig2PubKey) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.taproot.MuSig2PubKey", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MuSig2PubKey) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MuSig2PubKey) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MuSig2PubKey) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MuSig2PubKey) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MuSig2PubKey)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.PublicKeys) > 0 {
+			for _, e := range x.PublicKeys {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MuSig2PubKey)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PublicKeys) > 0 {
+			for iNdEx := len(x.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PublicKeys[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MuSig2PubKey)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MuSig2PubKey: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MuSig2PubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PublicKeys = append(x.PublicKeys, &PubKey{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PublicKeys[len(x.PublicKeys)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TapscriptPubKey_2_list)(nil)
+
+type _TapscriptPubKey_2_list struct {
+	list *[][]byte
+}
+
+func (x *_TapscriptPubKey_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TapscriptPubKey_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_TapscriptPubKey_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TapscriptPubKey_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TapscriptPubKey_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TapscriptPubKey at list field LeafScripts as it is not of Message kind"))
+}
+
+func (x *_TapscriptPubKey_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TapscriptPubKey_2_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_TapscriptPubKey_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TapscriptPubKey              protoreflect.MessageDescriptor
+	fd_TapscriptPubKey_internal_key protoreflect.FieldDescriptor
+	fd_TapscriptPubKey_leaf_scripts protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_crypto_taproot_keys_proto_init()
+	md_TapscriptPubKey = File_cosmos_crypto_taproot_keys_proto.Messages().ByName("TapscriptPubKey")
+	fd_TapscriptPubKey_internal_key = md_TapscriptPubKey.Fields().ByName("internal_key")
+	fd_TapscriptPubKey_leaf_scripts = md_TapscriptPubKey.Fields().ByName("leaf_scripts")
+}
+
+var _ protoreflect.Message = (*fastReflection_TapscriptPubKey)(nil)
+
+type fastReflection_TapscriptPubKey TapscriptPubKey
+
+func (x *TapscriptPubKey) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TapscriptPubKey)(x)
+}
+
+func (x *TapscriptPubKey) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crypto_taproot_keys_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TapscriptPubKey_messageType fastReflection_TapscriptPubKey_messageType
+var _ protoreflect.MessageType = fastReflection_TapscriptPubKey_messageType{}
+
+type fastReflection_TapscriptPubKey_messageType struct{}
+
+func (x fastReflection_TapscriptPubKey_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TapscriptPubKey)(nil)
+}
+func (x fastReflection_TapscriptPubKey_messageType) New() protoreflect.Message {
+	return new(fastReflection_TapscriptPubKey)
+}
+func (x fastReflection_TapscriptPubKey_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TapscriptPubKey
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TapscriptPubKey) Descriptor() protoreflect.MessageDescriptor {
+	return md_TapscriptPubKey
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TapscriptPubKey) Type() protoreflect.MessageType {
+	return _fastReflection_TapscriptPubKey_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TapscriptPubKey) New() protoreflect.Message {
+	return new(fastReflection_TapscriptPubKey)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TapscriptPubKey) Interface() protoreflect.ProtoMessage {
+	return (*TapscriptPubKey)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TapscriptPubKey) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.InternalKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.InternalKey)
+		if !f(fd_TapscriptPubKey_internal_key, value) {
+			return
+		}
+	}
+	if len(x.LeafScripts) != 0 {
+		value := protoreflect.ValueOfList(&_TapscriptPubKey_2_list{list: &x.LeafScripts})
+		if !f(fd_TapscriptPubKey_leaf_scripts, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TapscriptPubKey) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crypto.taproot.TapscriptPubKey.internal_key":
+		return len(x.InternalKey) != 0
+	case "cosmos.crypto.taproot.TapscriptPubKey.leaf_scripts":
+		return len(x.LeafScripts) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.taproot.TapscriptPubKey"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.taproot.TapscriptPubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TapscriptPubKey) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crypto.taproot.TapscriptPubKey.internal_key":
+		x.InternalKey = nil
+	case "cosmos.crypto.taproot.TapscriptPubKey.leaf_scripts":
+		x.LeafScripts = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.taproot.TapscriptPubKey"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.taproot.TapscriptPubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TapscriptPubKey) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crypto.taproot.TapscriptPubKey.internal_key":
+		value := x.InternalKey
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.crypto.taproot.TapscriptPubKey.leaf_scripts":
+		if len(x.LeafScripts) == 0 {
+			return protoreflect.ValueOfList(&_TapscriptPubKey_2_list{})
+		}
+		listValue := &_TapscriptPubKey_2_list{list: &x.LeafScripts}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.taproot.TapscriptPubKey"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.taproot.TapscriptPubKey does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TapscriptPubKey) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crypto.taproot.TapscriptPubKey.internal_key":
+		x.InternalKey = value.Bytes()
+	case "cosmos.crypto.taproot.TapscriptPubKey.leaf_scripts":
+		lv := value.List()
+		clv := lv.(*_TapscriptPubKey_2_list)
+		x.LeafScripts = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.taproot.TapscriptPubKey"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.taproot.TapscriptPubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TapscriptPubKey) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.taproot.TapscriptPubKey.leaf_scripts":
+		if x.LeafScripts == nil {
+			x.LeafScripts = [][]byte{}
+		}
+		value := &_TapscriptPubKey_2_list{list: &x.LeafScripts}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.crypto.taproot.TapscriptPubKey.internal_key":
+		panic(fmt.Errorf("field internal_key of message cosmos.crypto.taproot.TapscriptPubKey is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.taproot.TapscriptPubKey"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.taproot.TapscriptPubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TapscriptPubKey) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.taproot.TapscriptPubKey.internal_key":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.crypto.taproot.TapscriptPubKey.leaf_scripts":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_TapscriptPubKey_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.taproot.TapscriptPubKey"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.taproot.TapscriptPubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TapscriptPubKey) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.taproot.TapscriptPubKey", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TapscriptPubKey) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TapscriptPubKey) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TapscriptPubKey) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TapscriptPubKey) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TapscriptPubKey)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.InternalKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.LeafScripts) > 0 {
+			for _, b := range x.LeafScripts {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TapscriptPubKey)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.LeafScripts) > 0 {
+			for iNdEx := len(x.LeafScripts) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.LeafScripts[iNdEx])
+				copy(dAtA[i:], x.LeafScripts[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LeafScripts[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.InternalKey) > 0 {
+			i -= len(x.InternalKey)
+			copy(dAtA[i:], x.InternalKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InternalKey)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TapscriptPubKey)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TapscriptPubKey: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TapscriptPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InternalKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InternalKey = append(x.InternalKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.InternalKey == nil {
+					x.InternalKey = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeafScripts", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LeafScripts = append(x.LeafScripts, make([]byte, postIndex-iNdEx))
+				copy(x.LeafScripts[len(x.LeafScripts)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TapscriptSignature_1_list)(nil)
+
+type _TapscriptSignature_1_list struct {
+	list *[][]byte
+}
+
+func (x *_TapscriptSignature_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TapscriptSignature_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_TapscriptSignature_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TapscriptSignature_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TapscriptSignature_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TapscriptSignature at list field Witness as it is not of Message kind"))
+}
+
+func (x *_TapscriptSignature_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TapscriptSignature_1_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_TapscriptSignature_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TapscriptSignature           protoreflect.MessageDescriptor
+	fd_TapscriptSignature_witness   protoreflect.FieldDescriptor
+	fd_TapscriptSignature_lock_time protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_crypto_taproot_keys_proto_init()
+	md_TapscriptSignature = File_cosmos_crypto_taproot_keys_proto.Messages().ByName("TapscriptSignature")
+	fd_TapscriptSignature_witness = md_TapscriptSignature.Fields().ByName("witness")
+	fd_TapscriptSignature_lock_time = md_TapscriptSignature.Fields().ByName("lock_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_TapscriptSignature)(nil)
+
+type fastReflection_TapscriptSignature TapscriptSignature
+
+func (x *TapscriptSignature) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TapscriptSignature)(x)
+}
+
+func (x *TapscriptSignature) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crypto_taproot_keys_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TapscriptSignature_messageType fastReflection_TapscriptSignature_messageType
+var _ protoreflect.MessageType = fastReflection_TapscriptSignature_messageType{}
+
+type fastReflection_TapscriptSignature_messageType struct{}
+
+func (x fastReflection_TapscriptSignature_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TapscriptSignature)(nil)
+}
+func (x fastReflection_TapscriptSignature_messageType) New() protoreflect.Message {
+	return new(fastReflection_TapscriptSignature)
+}
+func (x fastReflection_TapscriptSignature_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TapscriptSignature
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TapscriptSignature) Descriptor() protoreflect.MessageDescriptor {
+	return md_TapscriptSignature
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TapscriptSignature) Type() protoreflect.MessageType {
+	return _fastReflection_TapscriptSignature_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TapscriptSignature) New() protoreflect.Message {
+	return new(fastReflection_TapscriptSignature)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TapscriptSignature) Interface() protoreflect.ProtoMessage {
+	return (*TapscriptSignature)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TapscriptSignature) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Witness) != 0 {
+		value := protoreflect.ValueOfList(&_TapscriptSignature_1_list{list: &x.Witness})
+		if !f(fd_TapscriptSignature_witness, value) {
+			return
+		}
+	}
+	if x.LockTime != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.LockTime)
+		if !f(fd_TapscriptSignature_lock_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TapscriptSignature) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crypto.taproot.TapscriptSignature.witness":
+		return len(x.Witness) != 0
+	case "cosmos.crypto.taproot.TapscriptSignature.lock_time":
+		return x.LockTime != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.taproot.TapscriptSignature"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.taproot.TapscriptSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TapscriptSignature) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crypto.taproot.TapscriptSignature.witness":
+		x.Witness = nil
+	case "cosmos.crypto.taproot.TapscriptSignature.lock_time":
+		x.LockTime = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.taproot.TapscriptSignature"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.taproot.TapscriptSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TapscriptSignature) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crypto.taproot.TapscriptSignature.witness":
+		if len(x.Witness) == 0 {
+			return protoreflect.ValueOfList(&_TapscriptSignature_1_list{})
+		}
+		listValue := &_TapscriptSignature_1_list{list: &x.Witness}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.crypto.taproot.TapscriptSignature.lock_time":
+		value := x.LockTime
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.taproot.TapscriptSignature"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.taproot.TapscriptSignature does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TapscriptSignature) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crypto.taproot.TapscriptSignature.witness":
+		lv := value.List()
+		clv := lv.(*_TapscriptSignature_1_list)
+		x.Witness = *clv.list
+	case "cosmos.crypto.taproot.TapscriptSignature.lock_time":
+		x.LockTime = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.taproot.TapscriptSignature"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.taproot.TapscriptSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TapscriptSignature) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.taproot.TapscriptSignature.witness":
+		if x.Witness == nil {
+			x.Witness = [][]byte{}
+		}
+		value := &_TapscriptSignature_1_list{list: &x.Witness}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.crypto.taproot.TapscriptSignature.lock_time":
+		panic(fmt.Errorf("field lock_time of message cosmos.crypto.taproot.TapscriptSignature is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.taproot.TapscriptSignature"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.taproot.TapscriptSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TapscriptSignature) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.taproot.TapscriptSignature.witness":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_TapscriptSignature_1_list{list: &list})
+	case "cosmos.crypto.taproot.TapscriptSignature.lock_time":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.taproot.TapscriptSignature"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.taproot.TapscriptSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TapscriptSignature) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.taproot.TapscriptSignature", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TapscriptSignature) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TapscriptSignature) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TapscriptSignature) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TapscriptSignature) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TapscriptSignature)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Witness) > 0 {
+			for _, b := range x.Witness {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LockTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.LockTime))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TapscriptSignature)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LockTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LockTime))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Witness) > 0 {
+			for iNdEx := len(x.Witness) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Witness[iNdEx])
+				copy(dAtA[i:], x.Witness[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Witness[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TapscriptSignature)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TapscriptSignature: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TapscriptSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Witness", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Witness = append(x.Witness, make([]byte, postIndex-iNdEx))
+				copy(x.Witness[len(x.Witness)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockTime", wireType)
+				}
+				x.LockTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LockTime |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -948,6 +2516,147 @@ func (x *PrivKey) GetKey() []byte {
 	return nil
 }
 
+// MuSig2PubKey defines a Taproot public key aggregated from the keys of several
+// co-signers with MuSig2 (BIP-327). The aggregate key is tweaked as specified
+// in BIP-86, so it is a regular P2TR output key: the account address is its
+// x-only form and a single BIP-340 or BIP-322 signature produced jointly by all
+// co-signers verifies against it.
+type MuSig2PubKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// public_keys are the taproot keys of the co-signers, in aggregation order.
+	PublicKeys []*PubKey `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+}
+
+func (x *MuSig2PubKey) Reset() {
+	*x = MuSig2PubKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_taproot_keys_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuSig2PubKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuSig2PubKey) ProtoMessage() {}
+
+// Deprecated: Use MuSig2PubKey.ProtoReflect.Descriptor instead.
+func (*MuSig2PubKey) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_taproot_keys_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MuSig2PubKey) GetPublicKeys() []*PubKey {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+// TapscriptPubKey defines a Taproot output key committing to a tree of
+// tapscript leaves as specified in BIP-341. The account address is the x-only
+// output key. It can be spent either with the key path, by the holder of the
+// internal key, or with the script path, by satisfying one of the leaf scripts.
+type TapscriptPubKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// internal_key is the 32-byte x-only internal key.
+	InternalKey []byte `protobuf:"bytes,1,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+	// leaf_scripts are the scripts of the tree leaves, with leaf version 0xc0.
+	LeafScripts [][]byte `protobuf:"bytes,2,rep,name=leaf_scripts,json=leafScripts,proto3" json:"leaf_scripts,omitempty"`
+}
+
+func (x *TapscriptPubKey) Reset() {
+	*x = TapscriptPubKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_taproot_keys_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TapscriptPubKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TapscriptPubKey) ProtoMessage() {}
+
+// Deprecated: Use TapscriptPubKey.ProtoReflect.Descriptor instead.
+func (*TapscriptPubKey) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_taproot_keys_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TapscriptPubKey) GetInternalKey() []byte {
+	if x != nil {
+		return x.InternalKey
+	}
+	return nil
+}
+
+func (x *TapscriptPubKey) GetLeafScripts() [][]byte {
+	if x != nil {
+		return x.LeafScripts
+	}
+	return nil
+}
+
+// TapscriptSignature defines a signature of a TapscriptPubKey, which is
+// verified as the witness of the BIP-322 to_sign transaction with the btcd
+// script engine.
+type TapscriptSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// witness is the witness stack. A script path spend ends with the leaf
+	// script and its control block.
+	Witness [][]byte `protobuf:"bytes,1,rep,name=witness,proto3" json:"witness,omitempty"`
+	// lock_time is the nLockTime of the to_sign transaction, checked by
+	// OP_CHECKLOCKTIMEVERIFY. The ante handler only accepts the signature once
+	// the block height, or block time, reached it.
+	LockTime uint32 `protobuf:"varint,2,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
+}
+
+func (x *TapscriptSignature) Reset() {
+	*x = TapscriptSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_taproot_keys_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TapscriptSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TapscriptSignature) ProtoMessage() {}
+
+// Deprecated: Use TapscriptSignature.ProtoReflect.Descriptor instead.
+func (*TapscriptSignature) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_taproot_keys_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TapscriptSignature) GetWitness() [][]byte {
+	if x != nil {
+		return x.Witness
+	}
+	return nil
+}
+
+func (x *TapscriptSignature) GetLockTime() uint32 {
+	if x != nil {
+		return x.LockTime
+	}
+	return 0
+}
+
 var File_cosmos_crypto_taproot_keys_proto protoreflect.FileDescriptor
 
 var file_cosmos_crypto_taproot_keys_proto_rawDesc = []byte{
@@ -966,20 +2675,42 @@ var file_cosmos_crypto_taproot_keys_proto_rawDesc = []byte{
 	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x3a, 0x2e,
 	0x8a, 0xe7, 0xb0, 0x2a, 0x1b, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f,
 	0x50, 0x72, 0x69, 0x76, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31,
-	0x92, 0xe7, 0xb0, 0x2a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0xc4,
-	0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x6f, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x42, 0x09, 0x4b, 0x65,
-	0x79, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f,
-	0x74, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x54, 0xaa, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0xca,
-	0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5c,
-	0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0xe2, 0x02, 0x21, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x5c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5c, 0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x3a, 0x3a, 0x54, 0x61,
-	0x70, 0x72, 0x6f, 0x6f, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x92, 0xe7, 0xb0, 0x2a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x84,
+	0x01, 0x0a, 0x0c, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12,
+	0x4b, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x6f, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2e, 0x50, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x42, 0x0b, 0xe2, 0xde, 0x1f, 0x07, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73,
+	0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x3a, 0x27, 0x98, 0xa0,
+	0x1f, 0x00, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x74, 0x2f, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x4d,
+	0x75, 0x53, 0x69, 0x67, 0x32, 0x22, 0x80, 0x01, 0x0a, 0x0f, 0x54, 0x61, 0x70, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c,
+	0x6c, 0x65, 0x61, 0x66, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x66, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x3a,
+	0x27, 0x98, 0xa0, 0x1f, 0x00, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x54, 0x61, 0x70, 0x72, 0x6f,
+	0x6f, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x4b, 0x0a, 0x12, 0x54, 0x61, 0x70, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x07, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x42, 0xc4, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x6f, 0x6f, 0x74, 0x42, 0x09, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x2f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x54, 0xaa, 0x02,
+	0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x54,
+	0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0xca, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5c, 0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0xe2, 0x02,
+	0x21, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5c, 0x54,
+	0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x43, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x3a, 0x3a, 0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -994,17 +2725,21 @@ func file_cosmos_crypto_taproot_keys_proto_rawDescGZIP() []byte {
 	return file_cosmos_crypto_taproot_keys_proto_rawDescData
 }
 
-var file_cosmos_crypto_taproot_keys_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cosmos_crypto_taproot_keys_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_cosmos_crypto_taproot_keys_proto_goTypes = []interface{}{
-	(*PubKey)(nil),  // 0: cosmos.crypto.taproot.PubKey
-	(*PrivKey)(nil), // 1: cosmos.crypto.taproot.PrivKey
+	(*PubKey)(nil),             // 0: cosmos.crypto.taproot.PubKey
+	(*PrivKey)(nil),            // 1: cosmos.crypto.taproot.PrivKey
+	(*MuSig2PubKey)(nil),       // 2: cosmos.crypto.taproot.MuSig2PubKey
+	(*TapscriptPubKey)(nil),    // 3: cosmos.crypto.taproot.TapscriptPubKey
+	(*TapscriptSignature)(nil), // 4: cosmos.crypto.taproot.TapscriptSignature
 }
 var file_cosmos_crypto_taproot_keys_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: cosmos.crypto.taproot.MuSig2PubKey.public_keys:type_name -> cosmos.crypto.taproot.PubKey
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_crypto_taproot_keys_proto_init() }
@@ -1037,6 +2772,42 @@ func file_cosmos_crypto_taproot_keys_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_crypto_taproot_keys_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MuSig2PubKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_crypto_taproot_keys_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TapscriptPubKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_crypto_taproot_keys_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TapscriptSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1044,7 +2815,7 @@ func file_cosmos_crypto_taproot_keys_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_crypto_taproot_keys_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
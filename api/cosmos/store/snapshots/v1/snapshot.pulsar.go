@@ -705,15 +705,67 @@ func (x *_Metadata_1_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Metadata_3_list)(nil)
+
+type _Metadata_3_list struct {
+	list *[]uint32
+}
+
+func (x *_Metadata_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Metadata_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint32((*x.list)[i])
+}
+
+func (x *_Metadata_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Metadata_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Metadata_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Metadata at list field ChunkGroups as it is not of Message kind"))
+}
+
+func (x *_Metadata_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Metadata_3_list) NewElement() protoreflect.Value {
+	v := uint32(0)
+	return protoreflect.ValueOfUint32(v)
+}
+
+func (x *_Metadata_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Metadata              protoreflect.MessageDescriptor
 	fd_Metadata_chunk_hashes protoreflect.FieldDescriptor
+	fd_Metadata_base_height  protoreflect.FieldDescriptor
+	fd_Metadata_chunk_groups protoreflect.FieldDescriptor
+	fd_Metadata_app_hash     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_store_snapshots_v1_snapshot_proto_init()
 	md_Metadata = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("Metadata")
 	fd_Metadata_chunk_hashes = md_Metadata.Fields().ByName("chunk_hashes")
+	fd_Metadata_base_height = md_Metadata.Fields().ByName("base_height")
+	fd_Metadata_chunk_groups = md_Metadata.Fields().ByName("chunk_groups")
+	fd_Metadata_app_hash = md_Metadata.Fields().ByName("app_hash")
 }
 
 var _ protoreflect.Message = (*fastReflection_Metadata)(nil)
@@ -787,6 +839,24 @@ func (x *fastReflection_Metadata) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.BaseHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BaseHeight)
+		if !f(fd_Metadata_base_height, value) {
+			return
+		}
+	}
+	if len(x.ChunkGroups) != 0 {
+		value := protoreflect.ValueOfList(&_Metadata_3_list{list: &x.ChunkGroups})
+		if !f(fd_Metadata_chunk_groups, value) {
+			return
+		}
+	}
+	if len(x.AppHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.AppHash)
+		if !f(fd_Metadata_app_hash, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -804,6 +874,12 @@ func (x *fastReflection_Metadata) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "cosmos.store.snapshots.v1.Metadata.chunk_hashes":
 		return len(x.ChunkHashes) != 0
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		return x.BaseHeight != uint64(0)
+	case "cosmos.store.snapshots.v1.Metadata.chunk_groups":
+		return len(x.ChunkGroups) != 0
+	case "cosmos.store.snapshots.v1.Metadata.app_hash":
+		return len(x.AppHash) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -822,6 +898,12 @@ func (x *fastReflection_Metadata) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "cosmos.store.snapshots.v1.Metadata.chunk_hashes":
 		x.ChunkHashes = nil
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		x.BaseHeight = uint64(0)
+	case "cosmos.store.snapshots.v1.Metadata.chunk_groups":
+		x.ChunkGroups = nil
+	case "cosmos.store.snapshots.v1.Metadata.app_hash":
+		x.AppHash = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -844,6 +926,18 @@ func (x *fastReflection_Metadata) Get(descriptor protoreflect.FieldDescriptor) p
 		}
 		listValue := &_Metadata_1_list{list: &x.ChunkHashes}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		value := x.BaseHeight
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.store.snapshots.v1.Metadata.chunk_groups":
+		if len(x.ChunkGroups) == 0 {
+			return protoreflect.ValueOfList(&_Metadata_3_list{})
+		}
+		listValue := &_Metadata_3_list{list: &x.ChunkGroups}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.store.snapshots.v1.Metadata.app_hash":
+		value := x.AppHash
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -868,6 +962,14 @@ func (x *fastReflection_Metadata) Set(fd protoreflect.FieldDescriptor, value pro
 		lv := value.List()
 		clv := lv.(*_Metadata_1_list)
 		x.ChunkHashes = *clv.list
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		x.BaseHeight = value.Uint()
+	case "cosmos.store.snapshots.v1.Metadata.chunk_groups":
+		lv := value.List()
+		clv := lv.(*_Metadata_3_list)
+		x.ChunkGroups = *clv.list
+	case "cosmos.store.snapshots.v1.Metadata.app_hash":
+		x.AppHash = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -894,6 +996,16 @@ func (x *fastReflection_Metadata) Mutable(fd protoreflect.FieldDescriptor) proto
 		}
 		value := &_Metadata_1_list{list: &x.ChunkHashes}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.store.snapshots.v1.Metadata.chunk_groups":
+		if x.ChunkGroups == nil {
+			x.ChunkGroups = []uint32{}
+		}
+		value := &_Metadata_3_list{list: &x.ChunkGroups}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		panic(fmt.Errorf("field base_height of message cosmos.store.snapshots.v1.Metadata is not mutable"))
+	case "cosmos.store.snapshots.v1.Metadata.app_hash":
+		panic(fmt.Errorf("field app_hash of message cosmos.store.snapshots.v1.Metadata is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -910,6 +1022,13 @@ func (x *fastReflection_Metadata) NewField(fd protoreflect.FieldDescriptor) prot
 	case "cosmos.store.snapshots.v1.Metadata.chunk_hashes":
 		list := [][]byte{}
 		return protoreflect.ValueOfList(&_Metadata_1_list{list: &list})
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.store.snapshots.v1.Metadata.chunk_groups":
+		list := []uint32{}
+		return protoreflect.ValueOfList(&_Metadata_3_list{list: &list})
+	case "cosmos.store.snapshots.v1.Metadata.app_hash":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -985,6 +1104,20 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.BaseHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseHeight))
+		}
+		if len(x.ChunkGroups) > 0 {
+			l = 0
+			for _, e := range x.ChunkGroups {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		l = len(x.AppHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1014,6 +1147,38 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.AppHash) > 0 {
+			i -= len(x.AppHash)
+			copy(dAtA[i:], x.AppHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AppHash)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ChunkGroups) > 0 {
+			var pksize2 int
+			for _, num := range x.ChunkGroups {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.ChunkGroups {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BaseHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseHeight))
+			i--
+			dAtA[i] = 0x10
+		}
 		if len(x.ChunkHashes) > 0 {
 			for iNdEx := len(x.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.ChunkHashes[iNdEx])
@@ -1104,6 +1269,135 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				x.ChunkHashes = append(x.ChunkHashes, make([]byte, postIndex-iNdEx))
 				copy(x.ChunkHashes[len(x.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseHeight", wireType)
+				}
+				x.BaseHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType == 0 {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.ChunkGroups = append(x.ChunkGroups, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.ChunkGroups) == 0 {
+						x.ChunkGroups = make([]uint32, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint32
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint32(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.ChunkGroups = append(x.ChunkGroups, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChunkGroups", wireType)
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AppHash = append(x.AppHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.AppHash == nil {
+					x.AppHash = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1145,6 +1439,7 @@ var (
 	fd_SnapshotItem_iavl              protoreflect.FieldDescriptor
 	fd_SnapshotItem_extension         protoreflect.FieldDescriptor
 	fd_SnapshotItem_extension_payload protoreflect.FieldDescriptor
+	fd_SnapshotItem_iavl_subtree      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1154,6 +1449,7 @@ func init() {
 	fd_SnapshotItem_iavl = md_SnapshotItem.Fields().ByName("iavl")
 	fd_SnapshotItem_extension = md_SnapshotItem.Fields().ByName("extension")
 	fd_SnapshotItem_extension_payload = md_SnapshotItem.Fields().ByName("extension_payload")
+	fd_SnapshotItem_iavl_subtree = md_SnapshotItem.Fields().ByName("iavl_subtree")
 }
 
 var _ protoreflect.Message = (*fastReflection_SnapshotItem)(nil)
@@ -1247,6 +1543,12 @@ func (x *fastReflection_SnapshotItem) Range(f func(protoreflect.FieldDescriptor,
 			if !f(fd_SnapshotItem_extension_payload, value) {
 				return
 			}
+		case *SnapshotItem_IavlSubtree:
+			v := o.IavlSubtree
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SnapshotItem_iavl_subtree, value) {
+				return
+			}
 		}
 	}
 }
@@ -1296,6 +1598,14 @@ func (x *fastReflection_SnapshotItem) Has(fd protoreflect.FieldDescriptor) bool
 		} else {
 			return false
 		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl_subtree":
+		if x.Item == nil {
+			return false
+		} else if _, ok := x.Item.(*SnapshotItem_IavlSubtree); ok {
+			return true
+		} else {
+			return false
+		}
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
@@ -1320,6 +1630,8 @@ func (x *fastReflection_SnapshotItem) Clear(fd protoreflect.FieldDescriptor) {
 		x.Item = nil
 	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
 		x.Item = nil
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl_subtree":
+		x.Item = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
@@ -1368,6 +1680,14 @@ func (x *fastReflection_SnapshotItem) Get(descriptor protoreflect.FieldDescripto
 		} else {
 			return protoreflect.ValueOfMessage((*SnapshotExtensionPayload)(nil).ProtoReflect())
 		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl_subtree":
+		if x.Item == nil {
+			return protoreflect.ValueOfMessage((*SnapshotIAVLSubtreeItem)(nil).ProtoReflect())
+		} else if v, ok := x.Item.(*SnapshotItem_IavlSubtree); ok {
+			return protoreflect.ValueOfMessage(v.IavlSubtree.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*SnapshotIAVLSubtreeItem)(nil).ProtoReflect())
+		}
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
@@ -1400,6 +1720,9 @@ func (x *fastReflection_SnapshotItem) Set(fd protoreflect.FieldDescriptor, value
 	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
 		cv := value.Message().Interface().(*SnapshotExtensionPayload)
 		x.Item = &SnapshotItem_ExtensionPayload{ExtensionPayload: cv}
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl_subtree":
+		cv := value.Message().Interface().(*SnapshotIAVLSubtreeItem)
+		x.Item = &SnapshotItem_IavlSubtree{IavlSubtree: cv}
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
@@ -1484,6 +1807,22 @@ func (x *fastReflection_SnapshotItem) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Item = oneofValue
 			return protoreflect.ValueOfMessage(value.ProtoReflect())
 		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl_subtree":
+		if x.Item == nil {
+			value := &SnapshotIAVLSubtreeItem{}
+			oneofValue := &SnapshotItem_IavlSubtree{IavlSubtree: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Item.(type) {
+		case *SnapshotItem_IavlSubtree:
+			return protoreflect.ValueOfMessage(m.IavlSubtree.ProtoReflect())
+		default:
+			value := &SnapshotIAVLSubtreeItem{}
+			oneofValue := &SnapshotItem_IavlSubtree{IavlSubtree: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
@@ -1509,6 +1848,9 @@ func (x *fastReflection_SnapshotItem) NewField(fd protoreflect.FieldDescriptor)
 	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
 		value := &SnapshotExtensionPayload{}
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl_subtree":
+		value := &SnapshotIAVLSubtreeItem{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
@@ -1535,6 +1877,8 @@ func (x *fastReflection_SnapshotItem) WhichOneof(d protoreflect.OneofDescriptor)
 			return x.Descriptor().Fields().ByName("extension")
 		case *SnapshotItem_ExtensionPayload:
 			return x.Descriptor().Fields().ByName("extension_payload")
+		case *SnapshotItem_IavlSubtree:
+			return x.Descriptor().Fields().ByName("iavl_subtree")
 		}
 	default:
 		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotItem", d.FullName()))
@@ -1617,6 +1961,12 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 			}
 			l = options.Size(x.ExtensionPayload)
 			n += 1 + l + runtime.Sov(uint64(l))
+		case *SnapshotItem_IavlSubtree:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.IavlSubtree)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1700,6 +2050,19 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x22
+		case *SnapshotItem_IavlSubtree:
+			encoded, err := options.Marshal(x.IavlSubtree)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1890,6 +2253,41 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 				}
 				x.Item = &SnapshotItem_ExtensionPayload{v}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IavlSubtree", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotIAVLSubtreeItem{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_IavlSubtree{v}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2930,27 +3328,31 @@ func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SnapshotExtensionMeta        protoreflect.MessageDescriptor
-	fd_SnapshotExtensionMeta_name   protoreflect.FieldDescriptor
-	fd_SnapshotExtensionMeta_format protoreflect.FieldDescriptor
+	md_SnapshotIAVLSubtreeItem           protoreflect.MessageDescriptor
+	fd_SnapshotIAVLSubtreeItem_first_key protoreflect.FieldDescriptor
+	fd_SnapshotIAVLSubtreeItem_key       protoreflect.FieldDescriptor
+	fd_SnapshotIAVLSubtreeItem_version   protoreflect.FieldDescriptor
+	fd_SnapshotIAVLSubtreeItem_height    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_store_snapshots_v1_snapshot_proto_init()
-	md_SnapshotExtensionMeta = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotExtensionMeta")
-	fd_SnapshotExtensionMeta_name = md_SnapshotExtensionMeta.Fields().ByName("name")
-	fd_SnapshotExtensionMeta_format = md_SnapshotExtensionMeta.Fields().ByName("format")
+	md_SnapshotIAVLSubtreeItem = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotIAVLSubtreeItem")
+	fd_SnapshotIAVLSubtreeItem_first_key = md_SnapshotIAVLSubtreeItem.Fields().ByName("first_key")
+	fd_SnapshotIAVLSubtreeItem_key = md_SnapshotIAVLSubtreeItem.Fields().ByName("key")
+	fd_SnapshotIAVLSubtreeItem_version = md_SnapshotIAVLSubtreeItem.Fields().ByName("version")
+	fd_SnapshotIAVLSubtreeItem_height = md_SnapshotIAVLSubtreeItem.Fields().ByName("height")
 }
 
-var _ protoreflect.Message = (*fastReflection_SnapshotExtensionMeta)(nil)
+var _ protoreflect.Message = (*fastReflection_SnapshotIAVLSubtreeItem)(nil)
 
-type fastReflection_SnapshotExtensionMeta SnapshotExtensionMeta
+type fastReflection_SnapshotIAVLSubtreeItem SnapshotIAVLSubtreeItem
 
-func (x *SnapshotExtensionMeta) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SnapshotExtensionMeta)(x)
+func (x *SnapshotIAVLSubtreeItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotIAVLSubtreeItem)(x)
 }
 
-func (x *SnapshotExtensionMeta) slowProtoReflect() protoreflect.Message {
+func (x *SnapshotIAVLSubtreeItem) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2962,43 +3364,43 @@ func (x *SnapshotExtensionMeta) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SnapshotExtensionMeta_messageType fastReflection_SnapshotExtensionMeta_messageType
-var _ protoreflect.MessageType = fastReflection_SnapshotExtensionMeta_messageType{}
+var _fastReflection_SnapshotIAVLSubtreeItem_messageType fastReflection_SnapshotIAVLSubtreeItem_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotIAVLSubtreeItem_messageType{}
 
-type fastReflection_SnapshotExtensionMeta_messageType struct{}
+type fastReflection_SnapshotIAVLSubtreeItem_messageType struct{}
 
-func (x fastReflection_SnapshotExtensionMeta_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SnapshotExtensionMeta)(nil)
+func (x fastReflection_SnapshotIAVLSubtreeItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotIAVLSubtreeItem)(nil)
 }
-func (x fastReflection_SnapshotExtensionMeta_messageType) New() protoreflect.Message {
-	return new(fastReflection_SnapshotExtensionMeta)
+func (x fastReflection_SnapshotIAVLSubtreeItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotIAVLSubtreeItem)
 }
-func (x fastReflection_SnapshotExtensionMeta_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotExtensionMeta
+func (x fastReflection_SnapshotIAVLSubtreeItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotIAVLSubtreeItem
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SnapshotExtensionMeta) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotExtensionMeta
+func (x *fastReflection_SnapshotIAVLSubtreeItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotIAVLSubtreeItem
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SnapshotExtensionMeta) Type() protoreflect.MessageType {
-	return _fastReflection_SnapshotExtensionMeta_messageType
+func (x *fastReflection_SnapshotIAVLSubtreeItem) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotIAVLSubtreeItem_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SnapshotExtensionMeta) New() protoreflect.Message {
-	return new(fastReflection_SnapshotExtensionMeta)
+func (x *fastReflection_SnapshotIAVLSubtreeItem) New() protoreflect.Message {
+	return new(fastReflection_SnapshotIAVLSubtreeItem)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SnapshotExtensionMeta) Interface() protoreflect.ProtoMessage {
-	return (*SnapshotExtensionMeta)(x)
+func (x *fastReflection_SnapshotIAVLSubtreeItem) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotIAVLSubtreeItem)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3006,16 +3408,28 @@ func (x *fastReflection_SnapshotExtensionMeta) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SnapshotExtensionMeta) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_SnapshotExtensionMeta_name, value) {
+func (x *fastReflection_SnapshotIAVLSubtreeItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.FirstKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.FirstKey)
+		if !f(fd_SnapshotIAVLSubtreeItem_first_key, value) {
 			return
 		}
 	}
-	if x.Format != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Format)
-		if !f(fd_SnapshotExtensionMeta_format, value) {
+	if len(x.Key) != 0 {
+		value := protoreflect.ValueOfBytes(x.Key)
+		if !f(fd_SnapshotIAVLSubtreeItem_key, value) {
+			return
+		}
+	}
+	if x.Version != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Version)
+		if !f(fd_SnapshotIAVLSubtreeItem_version, value) {
+			return
+		}
+	}
+	if x.Height != int32(0) {
+		value := protoreflect.ValueOfInt32(x.Height)
+		if !f(fd_SnapshotIAVLSubtreeItem_height, value) {
 			return
 		}
 	}
@@ -3032,17 +3446,21 @@ func (x *fastReflection_SnapshotExtensionMeta) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SnapshotExtensionMeta) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SnapshotIAVLSubtreeItem) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
-		return x.Name != ""
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
-		return x.Format != uint32(0)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.first_key":
+		return len(x.FirstKey) != 0
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.key":
+		return len(x.Key) != 0
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.version":
+		return x.Version != int64(0)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.height":
+		return x.Height != int32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3052,17 +3470,581 @@ func (x *fastReflection_SnapshotExtensionMeta) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotExtensionMeta) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SnapshotIAVLSubtreeItem) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
-		x.Name = ""
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
-		x.Format = uint32(0)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.first_key":
+		x.FirstKey = nil
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.key":
+		x.Key = nil
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.version":
+		x.Version = int64(0)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.height":
+		x.Height = int32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SnapshotIAVLSubtreeItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.first_key":
+		value := x.FirstKey
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.key":
+		value := x.Key
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.version":
+		value := x.Version
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.height":
+		value := x.Height
+		return protoreflect.ValueOfInt32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotIAVLSubtreeItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.first_key":
+		x.FirstKey = value.Bytes()
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.key":
+		x.Key = value.Bytes()
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.version":
+		x.Version = value.Int()
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.height":
+		x.Height = int32(value.Int())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotIAVLSubtreeItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.first_key":
+		panic(fmt.Errorf("field first_key of message cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.key":
+		panic(fmt.Errorf("field key of message cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.version":
+		panic(fmt.Errorf("field version of message cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.height":
+		panic(fmt.Errorf("field height of message cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SnapshotIAVLSubtreeItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.first_key":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.key":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.version":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem.height":
+		return protoreflect.ValueOfInt32(int32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SnapshotIAVLSubtreeItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SnapshotIAVLSubtreeItem) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotIAVLSubtreeItem) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SnapshotIAVLSubtreeItem) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SnapshotIAVLSubtreeItem) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SnapshotIAVLSubtreeItem)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.FirstKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Key)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotIAVLSubtreeItem)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Key) > 0 {
+			i -= len(x.Key)
+			copy(dAtA[i:], x.Key)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.FirstKey) > 0 {
+			i -= len(x.FirstKey)
+			copy(dAtA[i:], x.FirstKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FirstKey)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotIAVLSubtreeItem)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotIAVLSubtreeItem: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotIAVLSubtreeItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FirstKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FirstKey = append(x.FirstKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.FirstKey == nil {
+					x.FirstKey = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Key = append(x.Key[:0], dAtA[iNdEx:postIndex]...)
+				if x.Key == nil {
+					x.Key = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SnapshotExtensionMeta        protoreflect.MessageDescriptor
+	fd_SnapshotExtensionMeta_name   protoreflect.FieldDescriptor
+	fd_SnapshotExtensionMeta_format protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_store_snapshots_v1_snapshot_proto_init()
+	md_SnapshotExtensionMeta = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotExtensionMeta")
+	fd_SnapshotExtensionMeta_name = md_SnapshotExtensionMeta.Fields().ByName("name")
+	fd_SnapshotExtensionMeta_format = md_SnapshotExtensionMeta.Fields().ByName("format")
+}
+
+var _ protoreflect.Message = (*fastReflection_SnapshotExtensionMeta)(nil)
+
+type fastReflection_SnapshotExtensionMeta SnapshotExtensionMeta
+
+func (x *SnapshotExtensionMeta) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotExtensionMeta)(x)
+}
+
+func (x *SnapshotExtensionMeta) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SnapshotExtensionMeta_messageType fastReflection_SnapshotExtensionMeta_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotExtensionMeta_messageType{}
+
+type fastReflection_SnapshotExtensionMeta_messageType struct{}
+
+func (x fastReflection_SnapshotExtensionMeta_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotExtensionMeta)(nil)
+}
+func (x fastReflection_SnapshotExtensionMeta_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotExtensionMeta)
+}
+func (x fastReflection_SnapshotExtensionMeta_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotExtensionMeta
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SnapshotExtensionMeta) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotExtensionMeta
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SnapshotExtensionMeta) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotExtensionMeta_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SnapshotExtensionMeta) New() protoreflect.Message {
+	return new(fastReflection_SnapshotExtensionMeta)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SnapshotExtensionMeta) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotExtensionMeta)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SnapshotExtensionMeta) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_SnapshotExtensionMeta_name, value) {
+			return
+		}
+	}
+	if x.Format != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Format)
+		if !f(fd_SnapshotExtensionMeta_format, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SnapshotExtensionMeta) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
+		return x.Name != ""
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
+		return x.Format != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotExtensionMeta) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
+		x.Name = ""
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
+		x.Format = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3417,7 +4399,7 @@ func (x *SnapshotExtensionPayload) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SnapshotExtensionPayload) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6]
+	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3907,6 +4889,15 @@ type Metadata struct {
 	unknownFields protoimpl.UnknownFields
 
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"` // SHA-256 chunk hashes
+	// base_height is the height of the snapshot a delta snapshot is applied on,
+	// it's 0 for a full snapshot.
+	BaseHeight uint64 `protobuf:"varint,2,opt,name=base_height,json=baseHeight,proto3" json:"base_height,omitempty"`
+	// chunk_groups is the number of chunks of each chunk group, an independently
+	// compressed stream of snapshot items, in the snapshot formats using them.
+	ChunkGroups []uint32 `protobuf:"varint,3,rep,packed,name=chunk_groups,json=chunkGroups,proto3" json:"chunk_groups,omitempty"`
+	// app_hash is the app hash at the snapshot height, which is verified once the
+	// snapshot is restored.
+	AppHash []byte `protobuf:"bytes,4,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
 }
 
 func (x *Metadata) Reset() {
@@ -3936,6 +4927,27 @@ func (x *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (x *Metadata) GetBaseHeight() uint64 {
+	if x != nil {
+		return x.BaseHeight
+	}
+	return 0
+}
+
+func (x *Metadata) GetChunkGroups() []uint32 {
+	if x != nil {
+		return x.ChunkGroups
+	}
+	return nil
+}
+
+func (x *Metadata) GetAppHash() []byte {
+	if x != nil {
+		return x.AppHash
+	}
+	return nil
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 type SnapshotItem struct {
 	state         protoimpl.MessageState
@@ -3950,6 +4962,7 @@ type SnapshotItem struct {
 	//	*SnapshotItem_Iavl
 	//	*SnapshotItem_Extension
 	//	*SnapshotItem_ExtensionPayload
+	//	*SnapshotItem_IavlSubtree
 	Item isSnapshotItem_Item `protobuf_oneof:"item"`
 }
 
@@ -4008,6 +5021,13 @@ func (x *SnapshotItem) GetExtensionPayload() *SnapshotExtensionPayload {
 	return nil
 }
 
+func (x *SnapshotItem) GetIavlSubtree() *SnapshotIAVLSubtreeItem {
+	if x, ok := x.GetItem().(*SnapshotItem_IavlSubtree); ok {
+		return x.IavlSubtree
+	}
+	return nil
+}
+
 type isSnapshotItem_Item interface {
 	isSnapshotItem_Item()
 }
@@ -4028,6 +5048,10 @@ type SnapshotItem_ExtensionPayload struct {
 	ExtensionPayload *SnapshotExtensionPayload `protobuf:"bytes,4,opt,name=extension_payload,json=extensionPayload,proto3,oneof"`
 }
 
+type SnapshotItem_IavlSubtree struct {
+	IavlSubtree *SnapshotIAVLSubtreeItem `protobuf:"bytes,5,opt,name=iavl_subtree,json=iavlSubtree,proto3,oneof"`
+}
+
 func (*SnapshotItem_Store) isSnapshotItem_Item() {}
 
 func (*SnapshotItem_Iavl) isSnapshotItem_Item() {}
@@ -4036,6 +5060,8 @@ func (*SnapshotItem_Extension) isSnapshotItem_Item() {}
 
 func (*SnapshotItem_ExtensionPayload) isSnapshotItem_Item() {}
 
+func (*SnapshotItem_IavlSubtree) isSnapshotItem_Item() {}
+
 // SnapshotStoreItem contains metadata about a snapshotted store.
 type SnapshotStoreItem struct {
 	state         protoimpl.MessageState
@@ -4134,6 +5160,69 @@ func (x *SnapshotIAVLItem) GetHeight() int32 {
 	return 0
 }
 
+// SnapshotIAVLSubtreeItem references a subtree of the IAVL tree of the base
+// snapshot of a delta snapshot, which is unchanged in the exported tree.
+type SnapshotIAVLSubtreeItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// first_key is the key of the leftmost leaf of the subtree.
+	FirstKey []byte `protobuf:"bytes,1,opt,name=first_key,json=firstKey,proto3" json:"first_key,omitempty"`
+	// key, version and height are the ones of the root node of the subtree.
+	Key     []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Version int64  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Height  int32  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *SnapshotIAVLSubtreeItem) Reset() {
+	*x = SnapshotIAVLSubtreeItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotIAVLSubtreeItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotIAVLSubtreeItem) ProtoMessage() {}
+
+// Deprecated: Use SnapshotIAVLSubtreeItem.ProtoReflect.Descriptor instead.
+func (*SnapshotIAVLSubtreeItem) Descriptor() ([]byte, []int) {
+	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SnapshotIAVLSubtreeItem) GetFirstKey() []byte {
+	if x != nil {
+		return x.FirstKey
+	}
+	return nil
+}
+
+func (x *SnapshotIAVLSubtreeItem) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *SnapshotIAVLSubtreeItem) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SnapshotIAVLSubtreeItem) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
 // SnapshotExtensionMeta contains metadata about an external snapshotter.
 type SnapshotExtensionMeta struct {
 	state         protoimpl.MessageState
@@ -4147,7 +5236,7 @@ type SnapshotExtensionMeta struct {
 func (x *SnapshotExtensionMeta) Reset() {
 	*x = SnapshotExtensionMeta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[5]
+		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4161,7 +5250,7 @@ func (*SnapshotExtensionMeta) ProtoMessage() {}
 
 // Deprecated: Use SnapshotExtensionMeta.ProtoReflect.Descriptor instead.
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SnapshotExtensionMeta) GetName() string {
@@ -4190,7 +5279,7 @@ type SnapshotExtensionPayload struct {
 func (x *SnapshotExtensionPayload) Reset() {
 	*x = SnapshotExtensionPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6]
+		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4204,7 +5293,7 @@ func (*SnapshotExtensionPayload) ProtoMessage() {}
 
 // Deprecated: Use SnapshotExtensionPayload.ProtoReflect.Descriptor instead.
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SnapshotExtensionPayload) GetPayload() []byte {
@@ -4235,10 +5324,16 @@ var file_cosmos_store_snapshots_v1_snapshot_proto_rawDesc = []byte{
 	0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
 	0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
 	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x48,
-	0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0xf4, 0x02, 0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x8c, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x61, 0x73,
+	0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0b, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70,
+	0x70, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x70,
+	0x70, 0x48, 0x61, 0x73, 0x68, 0x22, 0xde, 0x03, 0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
 	0x6f, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x44, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73,
 	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76,
@@ -4259,47 +5354,62 @@ var file_cosmos_store_snapshots_v1_snapshot_proto_rawDesc = []byte{
 	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e,
 	0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e,
 	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x10, 0x65,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x3a,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x68, 0x0a, 0x0c, 0x69, 0x61, 0x76, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x53, 0x75,
+	0x62, 0x74, 0x72, 0x65, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x0f, 0xe2, 0xde, 0x1f, 0x0b, 0x49,
+	0x41, 0x56, 0x4c, 0x53, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x61,
+	0x76, 0x6c, 0x53, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x36, 0x42, 0x06,
+	0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x3c, 0x0a, 0x11, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a,
 	0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20,
-	0x30, 0x2e, 0x34, 0x36, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x3c, 0x0a, 0x11,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x36, 0x22, 0x81, 0x01, 0x0a, 0x10, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x36, 0x22, 0x58,
-	0x0a, 0x15, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
-	0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x36, 0x22, 0x49, 0x0a, 0x18, 0x53, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x3a, 0x13,
-	0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30,
-	0x2e, 0x34, 0x36, 0x42, 0xed, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2f,
-	0x76, 0x31, 0x3b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x43, 0x53, 0x53, 0xaa, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25, 0x43,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x30, 0x2e, 0x34, 0x36, 0x22, 0x81, 0x01, 0x0a, 0x10, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x49, 0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x36, 0x22, 0x7a, 0x0a, 0x17, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x53, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4b, 0x65, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x58, 0x0a, 0x15, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x36, 0x22, 0x49,
+	0x0a, 0x18, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x36, 0x42, 0xed, 0x01, 0x0a, 0x1d, 0x63, 0x6f,
+	0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x53, 0xaa, 0x02, 0x19, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x25, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -4314,27 +5424,29 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP() []byte {
 	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescData
 }
 
-var file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_cosmos_store_snapshots_v1_snapshot_proto_goTypes = []interface{}{
 	(*Snapshot)(nil),                 // 0: cosmos.store.snapshots.v1.Snapshot
 	(*Metadata)(nil),                 // 1: cosmos.store.snapshots.v1.Metadata
 	(*SnapshotItem)(nil),             // 2: cosmos.store.snapshots.v1.SnapshotItem
 	(*SnapshotStoreItem)(nil),        // 3: cosmos.store.snapshots.v1.SnapshotStoreItem
 	(*SnapshotIAVLItem)(nil),         // 4: cosmos.store.snapshots.v1.SnapshotIAVLItem
-	(*SnapshotExtensionMeta)(nil),    // 5: cosmos.store.snapshots.v1.SnapshotExtensionMeta
-	(*SnapshotExtensionPayload)(nil), // 6: cosmos.store.snapshots.v1.SnapshotExtensionPayload
+	(*SnapshotIAVLSubtreeItem)(nil),  // 5: cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem
+	(*SnapshotExtensionMeta)(nil),    // 6: cosmos.store.snapshots.v1.SnapshotExtensionMeta
+	(*SnapshotExtensionPayload)(nil), // 7: cosmos.store.snapshots.v1.SnapshotExtensionPayload
 }
 var file_cosmos_store_snapshots_v1_snapshot_proto_depIdxs = []int32{
 	1, // 0: cosmos.store.snapshots.v1.Snapshot.metadata:type_name -> cosmos.store.snapshots.v1.Metadata
 	3, // 1: cosmos.store.snapshots.v1.SnapshotItem.store:type_name -> cosmos.store.snapshots.v1.SnapshotStoreItem
 	4, // 2: cosmos.store.snapshots.v1.SnapshotItem.iavl:type_name -> cosmos.store.snapshots.v1.SnapshotIAVLItem
-	5, // 3: cosmos.store.snapshots.v1.SnapshotItem.extension:type_name -> cosmos.store.snapshots.v1.SnapshotExtensionMeta
-	6, // 4: cosmos.store.snapshots.v1.SnapshotItem.extension_payload:type_name -> cosmos.store.snapshots.v1.SnapshotExtensionPayload
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	6, // 3: cosmos.store.snapshots.v1.SnapshotItem.extension:type_name -> cosmos.store.snapshots.v1.SnapshotExtensionMeta
+	7, // 4: cosmos.store.snapshots.v1.SnapshotItem.extension_payload:type_name -> cosmos.store.snapshots.v1.SnapshotExtensionPayload
+	5, // 5: cosmos.store.snapshots.v1.SnapshotItem.iavl_subtree:type_name -> cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_store_snapshots_v1_snapshot_proto_init() }
@@ -4404,7 +5516,7 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotExtensionMeta); i {
+			switch v := v.(*SnapshotIAVLSubtreeItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4416,6 +5528,18 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotExtensionMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SnapshotExtensionPayload); i {
 			case 0:
 				return &v.state
@@ -4433,6 +5557,7 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_init() {
 		(*SnapshotItem_Iavl)(nil),
 		(*SnapshotItem_Extension)(nil),
 		(*SnapshotItem_ExtensionPayload)(nil),
+		(*SnapshotItem_IavlSubtree)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4440,7 +5565,7 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_store_snapshots_v1_snapshot_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -1649,6 +1650,209 @@ func TestABCI_PrepareProposal_BadEncoding(t *testing.T) {
 	require.Equal(t, 1, len(resPrepareProposal.Txs))
 }
 
+func TestABCI_MempoolJournal(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+	}
+	journalPath := filepath.Join(t.TempDir(), "mempool.wal")
+	db, paramDB := dbm.NewMemDB(), dbm.NewMemDB()
+
+	newApp := func() (*BaseAppSuite, mempool.Mempool, *mempool.Journal) {
+		journal, err := mempool.OpenJournal(journalPath)
+		require.NoError(t, err)
+		pool := mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(5000))
+		suite := newBaseAppSuiteWithDB(t, db, paramDB, anteOpt, baseapp.SetMempool(pool), baseapp.SetMempoolJournal(journal))
+		baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+		require.NoError(t, suite.baseApp.LoadLatestVersion())
+		return suite, pool, journal
+	}
+	encode := func(suite *BaseAppSuite, tx sdk.Tx) []byte {
+		txBytes, err := suite.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	suite, pool, journal := newApp()
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+	tx0 := encode(suite, newTxCounter(t, suite.txConfig, 0, 1))
+	tx1 := encode(suite, newTxCounter(t, suite.txConfig, 1, 1))
+	for _, txBytes := range [][]byte{tx0, tx1} {
+		res, err := suite.baseApp.CheckTx(&abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_New})
+		require.NoError(t, err)
+		require.True(t, res.IsOK(), res.Log)
+	}
+	require.Equal(t, [][]byte{tx0, tx1}, journal.Txs())
+
+	// the delivered tx is removed from the journal
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: [][]byte{tx0}})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+	require.Equal(t, 1, pool.CountTx())
+	require.Equal(t, [][]byte{tx1}, journal.Txs())
+
+	// a tx which is no longer valid
+	_, _, addr := testdata.KeyTestPubAddr()
+	builder := suite.txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgCounter{Counter: 2, Signer: addr.String()}))
+	builder.SetMemo("counter=2&failOnAnte=true")
+	setTxSignature(t, builder, 2)
+	invalidTx := encode(suite, builder.GetTx())
+	require.NoError(t, journal.Insert(invalidTx))
+	require.NoError(t, suite.baseApp.Close())
+
+	// on restart, the txs of the journal are checked again against the
+	// latest block once it's loaded
+	suite, pool, journal = newApp()
+	require.Equal(t, int64(1), suite.baseApp.LastBlockHeight())
+	require.Equal(t, 1, pool.CountTx())
+	require.Equal(t, [][]byte{tx1}, journal.Txs())
+	require.NoError(t, suite.baseApp.Close())
+
+	journal, err = mempool.OpenJournal(journalPath)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{tx1}, journal.Txs())
+	require.NoError(t, journal.Close())
+}
+
+// counterSignerExtractor makes the counter of a tx its signer and nonce.
+type counterSignerExtractor struct {
+	t *testing.T
+}
+
+func (e counterSignerExtractor) GetSigners(tx sdk.Tx) ([]mempool.SignerData, error) {
+	counter, _ := parseTxMemo(e.t, tx)
+	signer := sdk.AccAddress(bytes.Repeat([]byte{byte(counter + 1)}, 20))
+	return []mempool.SignerData{mempool.NewSignerData(signer, uint64(counter))}, nil
+}
+
+func TestABCI_MempoolJournal_Eviction(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+	}
+	journal, err := mempool.OpenJournal(filepath.Join(t.TempDir(), "mempool.wal"))
+	require.NoError(t, err)
+
+	// the txs are prioritized by counter, each from its own sender
+	txPriority := mempool.NewDefaultTxPriority()
+	txPriority.GetTxPriority = func(_ context.Context, tx sdk.Tx) int64 {
+		counter, _ := parseTxMemo(t, tx)
+		return counter
+	}
+	pool := mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
+		TxPriority:            txPriority,
+		MaxTx:                 1,
+		EvictLowerPriorityTxs: true,
+		SignerExtractor:       counterSignerExtractor{t: t},
+	})
+
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetMempoolJournal(journal), baseapp.SetMempool(pool))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// the evicted txs are removed by the hash of their original bytes, they
+	// aren't encoded again
+	suite.baseApp.SetTxEncoder(func(sdk.Tx) ([]byte, error) {
+		return nil, errors.New("unexpected tx encoding")
+	})
+
+	var txs [][]byte
+	for i := int64(0); i < 2; i++ {
+		txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, i, 1))
+		require.NoError(t, err)
+		res, err := suite.baseApp.CheckTx(&abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_New})
+		require.NoError(t, err)
+		require.True(t, res.IsOK(), res.Log)
+		txs = append(txs, txBytes)
+	}
+
+	// the first tx was evicted by the second one
+	require.Equal(t, 1, pool.CountTx())
+	require.Equal(t, [][]byte{txs[1]}, journal.Txs())
+	require.NoError(t, suite.baseApp.Close())
+}
+
+// removeCountingMempool counts the removals of transactions.
+type removeCountingMempool struct {
+	mempool.Mempool
+	removed int
+}
+
+func (mp *removeCountingMempool) Remove(tx sdk.Tx) error {
+	mp.removed++
+	return mp.Mempool.Remove(tx)
+}
+
+func TestABCI_TxExecutor_RemovesTxsFromMempoolOnce(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+	}
+	journal, err := mempool.OpenJournal(filepath.Join(t.TempDir(), "mempool.wal"))
+	require.NoError(t, err)
+	pool := &removeCountingMempool{Mempool: mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(5000))}
+
+	// every tx is executed on a discarded branch before its final execution
+	executor := func(
+		ctx context.Context,
+		block [][]byte,
+		cms storetypes.MultiStore,
+		deliverTx func(int, storetypes.MultiStore) *abci.ExecTxResult,
+	) ([]*abci.ExecTxResult, error) {
+		txResults := make([]*abci.ExecTxResult, len(block))
+		for i := range block {
+			deliverTx(i, cms.CacheMultiStore())
+			txResults[i] = deliverTx(i, cms)
+		}
+		return txResults, nil
+	}
+
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetMempool(pool), baseapp.SetMempoolJournal(journal), baseapp.SetTxExecutor(executor))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	var txs [][]byte
+	for i := int64(0); i < 2; i++ {
+		txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, i, 1))
+		require.NoError(t, err)
+		res, err := suite.baseApp.CheckTx(&abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_New})
+		require.NoError(t, err)
+		require.True(t, res.IsOK(), res.Log)
+		txs = append(txs, txBytes)
+	}
+
+	// a tx whose ante handler fails in the block is left in the mempool
+	_, _, addr := testdata.KeyTestPubAddr()
+	builder := suite.txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgCounter{Counter: 2, Signer: addr.String()}))
+	builder.SetMemo("counter=2&failOnAnte=true")
+	setTxSignature(t, builder, 2)
+	require.NoError(t, pool.Insert(sdk.Context{}, builder.GetTx()))
+	failingBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: append(txs, failingBytes)})
+	require.NoError(t, err)
+	require.True(t, res.TxResults[0].IsOK(), res.TxResults[0].Log)
+	require.True(t, res.TxResults[1].IsOK(), res.TxResults[1].Log)
+	require.Contains(t, res.TxResults[2].Log, "ante handler failure")
+	require.Equal(t, 2, pool.removed)
+	require.Equal(t, 1, pool.CountTx())
+	require.Empty(t, journal.Txs())
+	require.NoError(t, suite.baseApp.Close())
+}
+
 func TestABCI_PrepareProposal_OverGasUnderBytes(t *testing.T) {
 	pool := mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(5000))
 	suite := NewBaseAppSuite(t, baseapp.SetMempool(pool))
@@ -3,6 +3,7 @@ package baseapp
 import (
 	"context"
 	"fmt"
+	"io"
 	"maps"
 	"math"
 	"slices"
@@ -67,6 +68,7 @@ type BaseApp struct {
 	logger            log.Logger
 	name              string                      // application name from abci.BlockInfo
 	db                dbm.DB                      // common DB backend
+	archiveDB         dbm.DB                      // optional DB backend of the pruned versions
 	cms               storetypes.CommitMultiStore // Main (uncached) state
 	qms               storetypes.MultiStore       // Optional alternative multistore for querying only.
 	storeLoader       StoreLoader                 // function to handle store loading, may be overridden with SetStoreLoader()
@@ -80,6 +82,13 @@ type BaseApp struct {
 	anteHandler sdk.AnteHandler // ante handler for fee and auth
 	postHandler sdk.PostHandler // post handler, optional
 
+	// mempoolJournal optionally persists the mempool, its transactions are
+	// replayed once the latest version is loaded.
+	mempoolJournal *mempool.Journal
+	// mempoolTxHashes tracks the hashes of the journaled transactions of an
+	// EvictingMempool, to remove the evicted ones from the journal.
+	mempoolTxHashes *mempoolTxHashes
+
 	checkTxHandler     sdk.CheckTxHandler             // ABCI CheckTx handler
 	initChainer        sdk.InitChainer                // ABCI InitChain handler
 	preBlocker         sdk.PreBlocker                 // logic to run before BeginBlocker
@@ -198,6 +207,10 @@ type BaseApp struct {
 	//
 	// SAFETY: it's safe to do if validators validate the total gas wanted in the `ProcessProposal`, which is the case in the default handler.
 	disableBlockGasMeter bool
+
+	// txExecutor executes the transactions of FinalizeBlock, they are executed
+	// sequentially if it is nil.
+	txExecutor TxExecutor
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -370,7 +383,12 @@ func (app *BaseApp) LoadLatestVersion() error {
 		return fmt.Errorf("failed to load latest version: %w", err)
 	}
 
-	return app.Init()
+	if err := app.Init(); err != nil {
+		return err
+	}
+
+	app.replayMempoolJournal()
+	return nil
 }
 
 // DefaultStoreLoader will be used by default and loads the latest version
@@ -673,8 +691,9 @@ func (app *BaseApp) getBlockGasMeter(ctx sdk.Context) storetypes.GasMeter {
 	return storetypes.NewInfiniteGasMeter()
 }
 
-// retrieve the context for the tx w/ txBytes and other memoized values.
-func (app *BaseApp) getContextForTx(mode execMode, txBytes []byte) sdk.Context {
+// retrieve the context for the tx w/ txBytes and other memoized values. If ms
+// is not nil, it replaces the multistore of the state of the mode.
+func (app *BaseApp) getContextForTx(mode execMode, txBytes []byte, ms storetypes.MultiStore) sdk.Context {
 	app.mu.Lock()
 	defer app.mu.Unlock()
 
@@ -685,6 +704,9 @@ func (app *BaseApp) getContextForTx(mode execMode, txBytes []byte) sdk.Context {
 	ctx := modeState.Context().
 		WithTxBytes(txBytes).
 		WithGasMeter(storetypes.NewInfiniteGasMeter())
+	if ms != nil {
+		ctx = ctx.WithMultiStore(ms)
+	}
 	// WithVoteInfos(app.voteInfos) // TODO: identify if this is needed
 
 	ctx = ctx.WithIsSigverifyTx(app.sigverifyTx)
@@ -770,40 +792,46 @@ func (app *BaseApp) beginBlock(_ *abci.RequestFinalizeBlock) (sdk.BeginBlock, er
 }
 
 func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
-	gInfo := sdk.GasInfo{}
-	resultStr := "successful"
-
-	var resp *abci.ExecTxResult
-
-	defer func() {
-		telemetry.IncrCounter(1, "tx", "count")
-		telemetry.IncrCounter(1, "tx", resultStr)
-		telemetry.SetGauge(float32(gInfo.GasUsed), "tx", "gas", "used")
-		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
-	}()
+	resp, _ := app.deliverTxWithContext(app.getContextForTx(execModeFinalize, tx, nil), tx, nil)
+	recordTxTelemetry(resp)
+	return resp
+}
 
-	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx, nil)
+// deliverTxWithContext executes a transaction of the block in the given
+// context, whose multistore may differ from the one of finalizeBlockState. It
+// also returns whether the transaction passed its ante handler.
+func (app *BaseApp) deliverTxWithContext(ctx sdk.Context, txBytes []byte, tx sdk.Tx) (*abci.ExecTxResult, bool) {
+	gInfo, result, anteEvents, anteOK, err := app.runTxWithContext(ctx, execModeFinalize, txBytes, tx)
 	if err != nil {
-		resultStr = "failed"
-		resp = sdkerrors.ResponseExecTxResultWithEvents(
+		return sdkerrors.ResponseExecTxResultWithEvents(
 			err,
 			gInfo.GasWanted,
 			gInfo.GasUsed,
 			sdk.MarkEventsToIndex(anteEvents, app.indexEvents),
 			app.trace,
-		)
-		return resp
+		), anteOK
 	}
 
-	resp = &abci.ExecTxResult{
+	return &abci.ExecTxResult{
 		GasWanted: int64(gInfo.GasWanted),
 		GasUsed:   int64(gInfo.GasUsed),
 		Log:       result.Log,
 		Data:      result.Data,
 		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
+	}, anteOK
+}
+
+// recordTxTelemetry records the metrics of a delivered transaction.
+func recordTxTelemetry(resp *abci.ExecTxResult) {
+	resultStr := "successful"
+	if resp.Code != abci.CodeTypeOK {
+		resultStr = "failed"
 	}
 
-	return resp
+	telemetry.IncrCounter(1, "tx", "count")
+	telemetry.IncrCounter(1, "tx", resultStr)
+	telemetry.SetGauge(float32(uint64(resp.GasUsed)), "tx", "gas", "used")
+	telemetry.SetGauge(float32(uint64(resp.GasWanted)), "tx", "gas", "wanted")
 }
 
 // endBlock is an application-defined function that is called after transactions
@@ -842,17 +870,25 @@ func (app *BaseApp) endBlock(_ context.Context) (sdk.EndBlock, error) {
 // both txbytes and the decoded tx are passed to runTx to avoid the state machine encoding the tx and decoding the transaction twice
 // passing the decoded tx to runTX is optional, it will be decoded if the tx is nil
 func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	gInfo, result, anteEvents, _, err = app.runTxWithContext(app.getContextForTx(mode, txBytes, nil), mode, txBytes, tx)
+	return gInfo, result, anteEvents, err
+}
+
+// runTxWithContext is runTx in a context returned by getContextForTx, or
+// derived from it. It also returns whether the transaction passed its ante
+// handler, in which case a transaction of the block is removed from the
+// mempool.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, anteOK bool, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
 	if mode == execModeFinalize && ctx.BlockGasMeter().IsOutOfGas() {
-		return gInfo, nil, nil, errorsmod.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
+		return gInfo, nil, nil, anteOK, errorsmod.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
 	}
 
 	defer func() {
@@ -893,19 +929,19 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.G
 	if tx == nil {
 		tx, err = app.txDecoder(txBytes)
 		if err != nil {
-			return sdk.GasInfo{GasUsed: 0, GasWanted: 0}, nil, nil, sdkerrors.ErrTxDecode.Wrap(err.Error())
+			return sdk.GasInfo{GasUsed: 0, GasWanted: 0}, nil, nil, anteOK, sdkerrors.ErrTxDecode.Wrap(err.Error())
 		}
 	}
 
 	msgs := tx.GetMsgs()
 	if err := validateBasicTxMsgs(msgs); err != nil {
-		return sdk.GasInfo{}, nil, nil, err
+		return sdk.GasInfo{}, nil, nil, anteOK, err
 	}
 
 	for _, msg := range msgs {
 		handler := app.msgServiceRouter.Handler(msg)
 		if handler == nil {
-			return sdk.GasInfo{}, nil, nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "no message handler found for %T", msg)
+			return sdk.GasInfo{}, nil, nil, anteOK, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "no message handler found for %T", msg)
 		}
 	}
 
@@ -945,28 +981,27 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.G
 			if mode == execModeReCheck {
 				// if the ante handler fails on recheck, we want to remove the tx from the mempool
 				if mempoolErr := app.mempool.Remove(tx); mempoolErr != nil {
-					return gInfo, nil, anteEvents, errors.Join(err, mempoolErr)
+					return gInfo, nil, anteEvents, anteOK, errors.Join(err, mempoolErr)
 				}
+				app.journalMempoolRemove(txBytes)
 			}
-			return gInfo, nil, nil, err
+			return gInfo, nil, nil, anteOK, err
 		}
 
 		msCache.Write()
 		anteEvents = events.ToABCIEvents()
 	}
 
-	switch mode {
-	case execModeCheck:
+	// Transactions of a block which passed their ante handler are removed from
+	// the mempool by executeTxs, once their final results are known, as a
+	// TxExecutor may execute them several times.
+	anteOK = true
+	if mode == execModeCheck {
 		err = app.mempool.Insert(ctx, tx)
 		if err != nil {
-			return gInfo, nil, anteEvents, err
-		}
-	case execModeFinalize:
-		err = app.mempool.Remove(tx)
-		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
-			return gInfo, nil, anteEvents,
-				fmt.Errorf("failed to remove tx from mempool: %w", err)
+			return gInfo, nil, anteEvents, anteOK, err
 		}
+		app.journalMempoolInsert(tx, txBytes)
 	}
 
 	// Create a new Context based off of the existing Context with a MultiStore branch
@@ -995,10 +1030,10 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.G
 		if errPostHandler != nil {
 			if err == nil {
 				// when the msg was handled successfully, return the post handler error only
-				return gInfo, nil, anteEvents, errPostHandler
+				return gInfo, nil, anteEvents, anteOK, errPostHandler
 			}
 			// otherwise append to the msg error so that we keep the original error code for better user experience
-			return gInfo, nil, anteEvents, errorsmod.Wrapf(err, "postHandler: %s", errPostHandler)
+			return gInfo, nil, anteEvents, anteOK, errorsmod.Wrapf(err, "postHandler: %s", errPostHandler)
 		}
 
 		// we don't want runTx to panic if runMsgs has failed earlier
@@ -1022,7 +1057,7 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.G
 		}
 	}
 
-	return gInfo, result, anteEvents, err
+	return gInfo, result, anteEvents, anteOK, err
 }
 
 // runMsgs iterates through a list of messages and executes them with the provided
@@ -1189,6 +1224,34 @@ func (app *BaseApp) Close() error {
 		}
 	}
 
+	// Close the streaming listeners which hold resources, such as the files
+	// of the file streaming service
+	for _, listener := range app.streamingManager.ABCIListeners {
+		if closer, ok := listener.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	// Close app.archiveDB (opened by cosmos-sdk/server/util.go call to GetArchiveDB)
+	if app.archiveDB != nil {
+		app.logger.Info("Closing archive.db")
+		if cms, ok := app.cms.(storetypes.ArchivingMultiStore); ok {
+			cms.CloseArchive()
+		}
+		if err := app.archiveDB.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if app.mempoolJournal != nil {
+		app.logger.Info("Closing mempool journal")
+		if err := app.mempoolJournal.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	// Close app.snapshotManager
 	// - opened when app chains use cosmos-sdk/server/util.go/DefaultBaseappOptions (boilerplate)
 	// - which calls cosmos-sdk/server/util.go/GetSnapshotStore
@@ -66,11 +66,24 @@ type (
 func NewBaseAppSuite(t *testing.T, opts ...func(*baseapp.BaseApp)) *BaseAppSuite {
 	t.Helper()
 
+	suite := newBaseAppSuiteWithDB(t, dbm.NewMemDB(), dbm.NewMemDB(), opts...)
+
+	// mount stores and seal
+	require.Nil(t, suite.baseApp.LoadLatestVersion())
+
+	return suite
+}
+
+// newBaseAppSuiteWithDB is NewBaseAppSuite on the given application and
+// consensus params databases, whose state a restarted app loads back. The app
+// isn't loaded, so that services are registered before its latest version is.
+func newBaseAppSuiteWithDB(t *testing.T, db, paramDB *dbm.MemDB, opts ...func(*baseapp.BaseApp)) *BaseAppSuite {
+	t.Helper()
+
 	cdc := codectestutil.CodecOptions{}.NewCodec()
 	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
 
 	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
-	db := dbm.NewMemDB()
 	logBuffer := new(bytes.Buffer)
 	logger := log.NewLogger(logBuffer, log.ColorOption(false))
 
@@ -80,13 +93,10 @@ func NewBaseAppSuite(t *testing.T, opts ...func(*baseapp.BaseApp)) *BaseAppSuite
 	app.SetInterfaceRegistry(cdc.InterfaceRegistry())
 	app.MsgServiceRouter().SetInterfaceRegistry(cdc.InterfaceRegistry())
 	app.MountStores(capKey1, capKey2)
-	app.SetParamStore(paramStore{db: dbm.NewMemDB()})
+	app.SetParamStore(paramStore{db: paramDB})
 	app.SetTxDecoder(txConfig.TxDecoder())
 	app.SetTxEncoder(txConfig.TxEncoder())
 
-	// mount stores and seal
-	require.Nil(t, app.LoadLatestVersion())
-
 	return &BaseAppSuite{
 		baseApp:   app,
 		cdc:       cdc,
@@ -0,0 +1,161 @@
+// Package blockstm implements a baseapp.TxExecutor executing the transactions
+// of a block in parallel, in the fashion of Block-STM.
+//
+// The transactions are executed optimistically on a multi-version memory,
+// which holds the writes of the last execution of every transaction. A
+// transaction reads the writes of the closest preceding transaction which
+// wrote the key, or the state of the block, and its reads are recorded. An
+// execution is valid if its reads are the same in the current state of the
+// memory: by induction on the transactions, if all the executions are valid
+// they are the ones of the sequential execution of the block. The invalid
+// executions are executed again, in parallel while it quickly reduces their
+// number and in the order of the block otherwise.
+package blockstm
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// NewTxExecutor returns a TxExecutor executing the transactions of a block on
+// workers goroutines, or GOMAXPROCS goroutines if workers isn't positive.
+func NewTxExecutor(workers int) baseapp.TxExecutor {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	return func(
+		ctx context.Context,
+		block [][]byte,
+		cms storetypes.MultiStore,
+		deliverTx func(int, storetypes.MultiStore) *abci.ExecTxResult,
+	) ([]*abci.ExecTxResult, error) {
+		e := &executor{
+			workers:    workers,
+			deliverTx:  deliverTx,
+			mv:         newMVMemory(cms),
+			executions: make([]*multiStore, len(block)),
+			txResults:  make([]*abci.ExecTxResult, len(block)),
+		}
+		if err := e.run(ctx); err != nil {
+			return nil, err
+		}
+
+		e.mv.flush()
+		return e.txResults, nil
+	}
+}
+
+// executor is the execution of a block.
+type executor struct {
+	workers   int
+	deliverTx func(int, storetypes.MultiStore) *abci.ExecTxResult
+	mv        *mvMemory
+
+	// executions are the stores of the last execution of the transactions,
+	// and txResults their results.
+	executions []*multiStore
+	txResults  []*abci.ExecTxResult
+}
+
+func (e *executor) run(ctx context.Context) error {
+	// Execute the invalid transactions in parallel while their number at least
+	// halves: the conflicts between independent transactions are solved in a
+	// few rounds, but a chain of dependencies would only be solved one
+	// transaction per round.
+	invalid := e.all()
+	for len(invalid) > 0 {
+		e.parallel(invalid, e.execute)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		valid := make([]bool, len(e.executions))
+		e.parallel(e.all(), func(txIndex int) {
+			valid[txIndex] = e.executions[txIndex].validate()
+		})
+
+		var next []int
+		for txIndex, ok := range valid {
+			if !ok {
+				next = append(next, txIndex)
+			}
+		}
+
+		halved := len(next) <= len(invalid)/2
+		invalid = next
+		if !halved {
+			break
+		}
+	}
+
+	if len(invalid) == 0 {
+		return nil
+	}
+
+	// The transactions preceding the first invalid one are valid, validate and
+	// execute the following ones in order: each is then valid once executed.
+	for txIndex := invalid[0]; txIndex < len(e.executions); txIndex++ {
+		if !e.executions[txIndex].validate() {
+			e.execute(txIndex)
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// execute executes the transaction at txIndex and writes its writes to the
+// multi-version memory.
+func (e *executor) execute(txIndex int) {
+	ms := newMultiStore(e.mv, txIndex)
+	txResult := e.deliverTx(txIndex, ms)
+
+	e.mv.write(txIndex, e.executions[txIndex], ms)
+	e.executions[txIndex] = ms
+	e.txResults[txIndex] = txResult
+}
+
+// parallel calls fn for the transactions on the workers, by increasing index.
+func (e *executor) parallel(txIndexes []int, fn func(txIndex int)) {
+	var (
+		wg   sync.WaitGroup
+		next atomic.Int64
+	)
+
+	for range min(e.workers, len(txIndexes)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= len(txIndexes) {
+					return
+				}
+				fn(txIndexes[i])
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func (e *executor) all() []int {
+	txIndexes := make([]int, len(e.executions))
+	for i := range txIndexes {
+		txIndexes[i] = i
+	}
+
+	return txIndexes
+}
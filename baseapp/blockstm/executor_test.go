@@ -0,0 +1,233 @@
+package blockstm_test
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/blockstm"
+)
+
+var (
+	keyA = storetypes.NewKVStoreKey("a")
+	keyB = storetypes.NewKVStoreKey("b")
+)
+
+// op is an operation of a test transaction on a store.
+type op struct {
+	store storetypes.StoreKey
+	kind  int
+	key   []byte
+}
+
+const (
+	opIncrement  = iota // adds the value of the previous read to the key
+	opSum               // reads the sum of the keys of the store
+	opReverseSum        // same, in reverse order
+	opDelete
+	numOps
+)
+
+// newStore returns a branch of a multistore with stores a and b, holding the
+// keys 0 to 9 of value 1.
+func newStore(t *testing.T) storetypes.CacheMultiStore {
+	t.Helper()
+
+	cms := store.NewCommitMultiStore(dbm.NewMemDB(), log.NewNopLogger(), storemetrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(keyA, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(keyB, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	for _, key := range []storetypes.StoreKey{keyA, keyB} {
+		for i := range 10 {
+			cms.GetKVStore(key).Set(testKey(i), binary.BigEndian.AppendUint64(nil, 1))
+		}
+	}
+	cms.Commit()
+
+	return cms.CacheMultiStore()
+}
+
+func testKey(i int) []byte {
+	return []byte(fmt.Sprintf("k%d", i))
+}
+
+func getUint64(s storetypes.KVStore, key []byte) uint64 {
+	if bz := s.Get(key); bz != nil {
+		return binary.BigEndian.Uint64(bz)
+	}
+	return 0
+}
+
+// randomBlock returns the operations of the transactions of a block, on few
+// keys so that the transactions conflict.
+func randomBlock(r *rand.Rand, size int) [][]op {
+	block := make([][]op, size)
+	for i := range block {
+		for range 1 + r.Intn(4) {
+			s := storetypes.StoreKey(keyA)
+			if r.Intn(2) == 0 {
+				s = keyB
+			}
+			block[i] = append(block[i], op{store: s, kind: r.Intn(numOps), key: testKey(r.Intn(12))})
+		}
+	}
+
+	return block
+}
+
+// deliverTx executes the operations of a transaction on a branch of ms, as
+// baseapp does, and returns the values it read as its result. The first
+// execution of the transactions is delayed by decreasing durations, so that
+// concurrent executions read stale values.
+func deliverTx(block [][]op, executions *atomic.Int64) func(int, storetypes.MultiStore) *abci.ExecTxResult {
+	executed := make([]atomic.Bool, len(block))
+	return func(txIndex int, ms storetypes.MultiStore) *abci.ExecTxResult {
+		executions.Add(1)
+		if !executed[txIndex].Swap(true) {
+			time.Sleep(time.Duration(len(block)-txIndex) * 20 * time.Microsecond)
+		}
+
+		msCache := ms.CacheMultiStore()
+		var last uint64
+		var data []byte
+		for _, o := range block[txIndex] {
+			s := msCache.GetKVStore(o.store)
+			switch o.kind {
+			case opIncrement:
+				value := getUint64(s, o.key) + last + 1
+				s.Set(o.key, binary.BigEndian.AppendUint64(nil, value))
+				last = value
+
+			case opSum, opReverseSum:
+				var it storetypes.Iterator
+				if o.kind == opSum {
+					it = s.Iterator(nil, o.key)
+				} else {
+					it = s.ReverseIterator(testKey(2), nil)
+				}
+				last = 0
+				for ; it.Valid(); it.Next() {
+					data = append(data, it.Key()...)
+					last += binary.BigEndian.Uint64(it.Value())
+				}
+				if err := it.Close(); err != nil {
+					panic(err)
+				}
+
+			case opDelete:
+				s.Delete(o.key)
+			}
+			data = binary.BigEndian.AppendUint64(data, last)
+		}
+		msCache.Write()
+
+		return &abci.ExecTxResult{Data: data, GasUsed: int64(len(data))}
+	}
+}
+
+func storeEntries(t *testing.T, ms storetypes.MultiStore) [][]byte {
+	t.Helper()
+
+	var entries [][]byte
+	for _, key := range []storetypes.StoreKey{keyA, keyB} {
+		it := ms.GetKVStore(key).Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			entries = append(entries, it.Key(), it.Value())
+		}
+		require.NoError(t, it.Close())
+	}
+
+	return entries
+}
+
+func TestTxExecutor(t *testing.T) {
+	var size, executions int64
+	for seed := range int64(20) {
+		r := rand.New(rand.NewSource(seed))
+		block := randomBlock(r, 1+r.Intn(100))
+
+		var seqExecutions atomic.Int64
+		expStore := newStore(t)
+		expResults, err := baseapp.SequentialTxExecutor(context.Background(), make([][]byte, len(block)), expStore, deliverTx(block, &seqExecutions))
+		require.NoError(t, err)
+		require.Equal(t, int64(len(block)), seqExecutions.Load())
+
+		for _, workers := range []int{1, 4, 16} {
+			t.Run(fmt.Sprintf("seed=%d/workers=%d", seed, workers), func(t *testing.T) {
+				var stmExecutions atomic.Int64
+				cms := newStore(t)
+				results, err := blockstm.NewTxExecutor(workers)(context.Background(), make([][]byte, len(block)), cms, deliverTx(block, &stmExecutions))
+				require.NoError(t, err)
+				require.Equal(t, expResults, results)
+				require.Equal(t, storeEntries(t, expStore), storeEntries(t, cms))
+				require.GreaterOrEqual(t, stmExecutions.Load(), int64(len(block)))
+				if workers > 1 {
+					size += int64(len(block))
+					executions += stmExecutions.Load()
+				}
+			})
+		}
+	}
+
+	// the conflicts were detected and the transactions executed again
+	require.Greater(t, executions, size)
+}
+
+func TestTxExecutorConflicts(t *testing.T) {
+	// every transaction increments the same key: each depends on the previous
+	block := make([][]op, 50)
+	for i := range block {
+		block[i] = []op{{store: keyA, kind: opIncrement, key: testKey(0)}}
+	}
+
+	var executions atomic.Int64
+	cms := newStore(t)
+	results, err := blockstm.NewTxExecutor(8)(context.Background(), make([][]byte, len(block)), cms, deliverTx(block, &executions))
+	require.NoError(t, err)
+	require.Equal(t, uint64(51), getUint64(cms.GetKVStore(keyA), testKey(0)))
+	require.Equal(t, binary.BigEndian.AppendUint64(nil, 51), results[49].Data)
+	// the parallel rounds execute at most 2n transactions, and the conflicts
+	// left are solved in order
+	require.LessOrEqual(t, executions.Load(), int64(3*len(block)))
+
+	// independent transactions are executed once
+	for i := range block {
+		block[i] = []op{{store: keyB, kind: opIncrement, key: []byte(fmt.Sprintf("new%d", i))}}
+	}
+	executions.Store(0)
+	_, err = blockstm.NewTxExecutor(8)(context.Background(), make([][]byte, len(block)), newStore(t), deliverTx(block, &executions))
+	require.NoError(t, err)
+	require.Equal(t, int64(len(block)), executions.Load())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = blockstm.NewTxExecutor(8)(ctx, make([][]byte, len(block)), newStore(t), deliverTx(block, &executions))
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestTxExecutorVersionedBranch(t *testing.T) {
+	// the multistore of a transaction can't be branched with a version
+	_, err := blockstm.NewTxExecutor(2)(context.Background(), make([][]byte, 2), newStore(t), func(_ int, ms storetypes.MultiStore) *abci.ExecTxResult {
+		_, err := ms.CacheMultiStoreWithVersion(1)
+		require.ErrorContains(t, err, "cannot branch")
+		_, err = ms.CacheMultiStore().CacheMultiStoreWithVersion(1)
+		require.ErrorContains(t, err, "cannot branch")
+		return &abci.ExecTxResult{}
+	})
+	require.NoError(t, err)
+}
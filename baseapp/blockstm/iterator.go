@@ -0,0 +1,174 @@
+package blockstm
+
+import (
+	"bytes"
+	"errors"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+var _ storetypes.Iterator = (*mergeIterator)(nil)
+
+// mergeIterator merges the entries of a parent iterator with entries written
+// on top of it, sorted in the order of the iteration. The written entries
+// override the ones of the parent with the same key, and their nil values are
+// deletions.
+type mergeIterator struct {
+	parent    storetypes.Iterator
+	entries   []kvPair
+	ascending bool
+
+	// fromParent is whether the iterator is positioned on the parent.
+	fromParent bool
+}
+
+func newMergeIterator(parent storetypes.Iterator, entries []kvPair, ascending bool) *mergeIterator {
+	it := &mergeIterator{
+		parent:    parent,
+		entries:   entries,
+		ascending: ascending,
+	}
+	it.seek()
+
+	return it
+}
+
+// seek positions the iterator on the next entry, skipping the deletions and
+// the entries of the parent which are overridden.
+func (it *mergeIterator) seek() {
+	for len(it.entries) > 0 {
+		entry := it.entries[0]
+		if it.parent.Valid() {
+			cmp := bytes.Compare(entry.key, it.parent.Key())
+			if !it.ascending {
+				cmp = -cmp
+			}
+			if cmp > 0 {
+				it.fromParent = true
+				return
+			}
+			if cmp == 0 {
+				it.parent.Next()
+			}
+		}
+
+		if entry.value != nil {
+			it.fromParent = false
+			return
+		}
+		it.entries = it.entries[1:]
+	}
+
+	it.fromParent = true
+}
+
+func (it *mergeIterator) Domain() (start, end []byte) {
+	return it.parent.Domain()
+}
+
+func (it *mergeIterator) Valid() bool {
+	return !it.fromParent || it.parent.Valid()
+}
+
+func (it *mergeIterator) Next() {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+
+	if it.fromParent {
+		it.parent.Next()
+	} else {
+		it.entries = it.entries[1:]
+	}
+	it.seek()
+}
+
+func (it *mergeIterator) Key() []byte {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+
+	if it.fromParent {
+		return it.parent.Key()
+	}
+	return it.entries[0].key
+}
+
+func (it *mergeIterator) Value() []byte {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+
+	if it.fromParent {
+		return it.parent.Value()
+	}
+	return it.entries[0].value
+}
+
+func (it *mergeIterator) Error() error {
+	return it.parent.Error()
+}
+
+func (it *mergeIterator) Close() error {
+	return it.parent.Close()
+}
+
+// iteration is an iteration of a transaction over the multi-version memory,
+// recorded for the validation of its execution.
+type iteration struct {
+	start, end []byte
+	ascending  bool
+
+	// entries are the entries the iterator was positioned on, and exhausted
+	// whether it was positioned past the last one.
+	entries   []kvPair
+	exhausted bool
+}
+
+// validate returns whether the iteration is the same in the current state of
+// the multi-version memory.
+func (i *iteration) validate(s *mvStore, txIndex int) (valid bool) {
+	it := s.iterator(txIndex, i.start, i.end, i.ascending)
+	defer func() {
+		valid = errors.Join(it.Error(), it.Close()) == nil && valid
+	}()
+
+	for _, entry := range i.entries {
+		if !it.Valid() || !bytes.Equal(entry.key, it.Key()) || !bytes.Equal(entry.value, it.Value()) {
+			return false
+		}
+		it.Next()
+	}
+
+	return !i.exhausted || !it.Valid()
+}
+
+// recordingIterator records the entries an iterator is positioned on.
+type recordingIterator struct {
+	storetypes.Iterator
+	iteration *iteration
+}
+
+func newRecordingIterator(parent storetypes.Iterator, iteration *iteration) *recordingIterator {
+	it := &recordingIterator{Iterator: parent, iteration: iteration}
+	it.record()
+
+	return it
+}
+
+func (it *recordingIterator) record() {
+	if !it.Iterator.Valid() {
+		it.iteration.exhausted = true
+		return
+	}
+
+	it.iteration.entries = append(it.iteration.entries, kvPair{
+		key:   bytes.Clone(it.Iterator.Key()),
+		value: bytes.Clone(it.Iterator.Value()),
+	})
+}
+
+func (it *recordingIterator) Next() {
+	it.Iterator.Next()
+	it.record()
+}
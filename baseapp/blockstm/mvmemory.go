@@ -0,0 +1,228 @@
+package blockstm
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/tidwall/btree"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// kvPair is an entry of a store, its value is nil for a deletion.
+type kvPair struct {
+	key, value []byte
+}
+
+// version is the value of a key written by a transaction, nil for a deletion.
+type version struct {
+	txIndex int
+	value   []byte
+}
+
+// mvKey is the versions of a key, by increasing transaction index.
+type mvKey struct {
+	key      []byte
+	versions []version
+}
+
+// search returns the index of the first version of a transaction at or after
+// txIndex.
+func (k *mvKey) search(txIndex int) int {
+	return sort.Search(len(k.versions), func(i int) bool { return k.versions[i].txIndex >= txIndex })
+}
+
+// mvMemory is the multi-version memory of the execution of a block. For every
+// store, it holds the writes of the last execution of the transactions on top
+// of the store of the block.
+type mvMemory struct {
+	cms storetypes.MultiStore
+
+	mtx    sync.Mutex
+	stores map[storetypes.StoreKey]*mvStore
+}
+
+func newMVMemory(cms storetypes.MultiStore) *mvMemory {
+	return &mvMemory{
+		cms:    cms,
+		stores: make(map[storetypes.StoreKey]*mvStore),
+	}
+}
+
+func (m *mvMemory) store(key storetypes.StoreKey) *mvStore {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	s, ok := m.stores[key]
+	if !ok {
+		s = &mvStore{
+			base: m.cms.GetKVStore(key),
+			keys: btree.NewBTreeGOptions(func(a, b *mvKey) bool {
+				return bytes.Compare(a.key, b.key) < 0
+			}, btree.Options{NoLocks: true}),
+		}
+		m.stores[key] = s
+	}
+
+	return s
+}
+
+// write replaces the writes of the previous execution of the transaction at
+// txIndex by the ones of its new execution.
+func (m *mvMemory) write(txIndex int, prev, next *multiStore) {
+	if prev != nil {
+		for key, v := range prev.views {
+			var writes map[string][]byte
+			if nv, ok := next.views[key]; ok {
+				writes = nv.writes
+			}
+			v.store.write(txIndex, v.writes, writes)
+		}
+	}
+
+	for key, v := range next.views {
+		if prev != nil {
+			if _, ok := prev.views[key]; ok {
+				continue
+			}
+		}
+		v.store.write(txIndex, nil, v.writes)
+	}
+}
+
+// flush writes the last version of every key to the stores of the block.
+func (m *mvMemory) flush() {
+	for _, s := range m.stores {
+		s.keys.Scan(func(k *mvKey) bool {
+			if len(k.versions) == 0 {
+				return true
+			}
+			if value := k.versions[len(k.versions)-1].value; value != nil {
+				s.base.Set(k.key, value)
+			} else {
+				s.base.Delete(k.key)
+			}
+			return true
+		})
+	}
+}
+
+// mvStore is the multi-version memory of a store.
+type mvStore struct {
+	base storetypes.KVStore
+
+	mtx  sync.RWMutex
+	keys *btree.BTreeG[*mvKey]
+}
+
+// get returns the value of key seen by the transaction at txIndex: the one
+// written by the closest preceding transaction, or the one of the block.
+func (s *mvStore) get(txIndex int, key []byte) []byte {
+	s.mtx.RLock()
+	if k, ok := s.keys.Get(&mvKey{key: key}); ok {
+		if i := k.search(txIndex); i > 0 {
+			value := k.versions[i-1].value
+			s.mtx.RUnlock()
+			return value
+		}
+	}
+	s.mtx.RUnlock()
+
+	return s.base.Get(key)
+}
+
+// write replaces the writes prev of the transaction at txIndex by next.
+func (s *mvStore) write(txIndex int, prev, next map[string][]byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for key := range prev {
+		if _, ok := next[key]; ok {
+			continue
+		}
+
+		k, ok := s.keys.Get(&mvKey{key: []byte(key)})
+		if !ok {
+			continue
+		}
+		if i := k.search(txIndex); i < len(k.versions) && k.versions[i].txIndex == txIndex {
+			k.versions = append(k.versions[:i], k.versions[i+1:]...)
+		}
+		if len(k.versions) == 0 {
+			s.keys.Delete(k)
+		}
+	}
+
+	for key, value := range next {
+		k, ok := s.keys.Get(&mvKey{key: []byte(key)})
+		if !ok {
+			k = &mvKey{key: []byte(key)}
+			s.keys.Set(k)
+		}
+
+		i := k.search(txIndex)
+		if i < len(k.versions) && k.versions[i].txIndex == txIndex {
+			k.versions[i].value = value
+			continue
+		}
+		k.versions = append(k.versions, version{})
+		copy(k.versions[i+1:], k.versions[i:])
+		k.versions[i] = version{txIndex: txIndex, value: value}
+	}
+}
+
+// entries returns the entries of the domain [start, end) written before the
+// transaction at txIndex, in the order of the iteration.
+func (s *mvStore) entries(txIndex int, start, end []byte, ascending bool) []kvPair {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	var entries []kvPair
+	add := func(k *mvKey) bool {
+		if i := k.search(txIndex); i > 0 {
+			entries = append(entries, kvPair{key: k.key, value: k.versions[i-1].value})
+		}
+		return true
+	}
+
+	if ascending {
+		s.keys.Ascend(&mvKey{key: start}, func(k *mvKey) bool {
+			if end != nil && bytes.Compare(k.key, end) >= 0 {
+				return false
+			}
+			return add(k)
+		})
+		return entries
+	}
+
+	descend := func(k *mvKey) bool {
+		if start != nil && bytes.Compare(k.key, start) < 0 {
+			return false
+		}
+		if end != nil && bytes.Equal(k.key, end) {
+			return true
+		}
+		return add(k)
+	}
+	if end == nil {
+		s.keys.Reverse(descend)
+	} else {
+		s.keys.Descend(&mvKey{key: end}, descend)
+	}
+
+	return entries
+}
+
+// iterator returns an iterator over the domain [start, end) of the store seen
+// by the transaction at txIndex.
+func (s *mvStore) iterator(txIndex int, start, end []byte, ascending bool) storetypes.Iterator {
+	var parent storetypes.Iterator
+	if ascending {
+		parent = s.base.Iterator(start, end)
+	} else {
+		parent = s.base.ReverseIterator(start, end)
+	}
+
+	return newMergeIterator(parent, s.entries(txIndex, start, end, ascending), ascending)
+}
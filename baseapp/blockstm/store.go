@@ -0,0 +1,282 @@
+package blockstm
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"sort"
+
+	"cosmossdk.io/store/cachekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// errVersionedBranch is returned when the multistore of a transaction is
+// branched with a version, the transaction only sees the latest writes.
+var errVersionedBranch = errors.New("cannot branch the multistore of a transaction with a version")
+
+var (
+	_ storetypes.KVStore         = (*view)(nil)
+	_ storetypes.MultiStore      = (*multiStore)(nil)
+	_ storetypes.CacheMultiStore = (*cacheMultiStore)(nil)
+)
+
+// read is a read of a key by a transaction, value is nil if it was absent.
+type read struct {
+	key, value []byte
+}
+
+// view is a store seen by an execution of a transaction. It reads the writes
+// of the execution, then the multi-version memory whose reads it records, and
+// holds the writes of the execution.
+type view struct {
+	store   *mvStore
+	txIndex int
+
+	// writes are the writes of the execution, with nil values for deletions.
+	writes     map[string][]byte
+	reads      []read
+	iterations []*iteration
+}
+
+func newView(store *mvStore, txIndex int) *view {
+	return &view{
+		store:   store,
+		txIndex: txIndex,
+		writes:  make(map[string][]byte),
+	}
+}
+
+// validate returns whether the reads of the execution are the same in the
+// current state of the multi-version memory.
+func (v *view) validate() bool {
+	for _, r := range v.reads {
+		value := v.store.get(v.txIndex, r.key)
+		if (value == nil) != (r.value == nil) || !bytes.Equal(value, r.value) {
+			return false
+		}
+	}
+
+	for _, i := range v.iterations {
+		if !i.validate(v.store, v.txIndex) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *view) GetStoreType() storetypes.StoreType {
+	return v.store.base.GetStoreType()
+}
+
+func (v *view) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(v)
+}
+
+func (v *view) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(v)
+}
+
+func (v *view) Get(key []byte) []byte {
+	storetypes.AssertValidKey(key)
+
+	if value, ok := v.writes[string(key)]; ok {
+		return value
+	}
+
+	value := v.store.get(v.txIndex, key)
+	v.reads = append(v.reads, read{key: bytes.Clone(key), value: value})
+
+	return value
+}
+
+func (v *view) Has(key []byte) bool {
+	return v.Get(key) != nil
+}
+
+func (v *view) Set(key, value []byte) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValue(value)
+
+	v.writes[string(key)] = value
+}
+
+func (v *view) Delete(key []byte) {
+	storetypes.AssertValidKey(key)
+
+	v.writes[string(key)] = nil
+}
+
+func (v *view) Iterator(start, end []byte) storetypes.Iterator {
+	return v.iterator(start, end, true)
+}
+
+func (v *view) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return v.iterator(start, end, false)
+}
+
+func (v *view) iterator(start, end []byte, ascending bool) storetypes.Iterator {
+	i := &iteration{start: bytes.Clone(start), end: bytes.Clone(end), ascending: ascending}
+	v.iterations = append(v.iterations, i)
+	parent := newRecordingIterator(v.store.iterator(v.txIndex, start, end, ascending), i)
+
+	var writes []kvPair
+	for key, value := range v.writes {
+		if (start == nil || key >= string(start)) && (end == nil || key < string(end)) {
+			writes = append(writes, kvPair{key: []byte(key), value: value})
+		}
+	}
+	sort.Slice(writes, func(i, j int) bool {
+		return (bytes.Compare(writes[i].key, writes[j].key) < 0) == ascending
+	})
+
+	return newMergeIterator(parent, writes, ascending)
+}
+
+// multiStore is the multistore of an execution of a transaction, whose stores
+// are views of the multi-version memory.
+type multiStore struct {
+	mv      *mvMemory
+	txIndex int
+	views   map[storetypes.StoreKey]*view
+}
+
+func newMultiStore(mv *mvMemory, txIndex int) *multiStore {
+	return &multiStore{
+		mv:      mv,
+		txIndex: txIndex,
+		views:   make(map[storetypes.StoreKey]*view),
+	}
+}
+
+// validate returns whether the reads of the execution are the same in the
+// current state of the multi-version memory.
+func (ms *multiStore) validate() bool {
+	for _, v := range ms.views {
+		if !v.validate() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (ms *multiStore) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+func (ms *multiStore) CacheWrap() storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+func (ms *multiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+func (ms *multiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return newCacheMultiStore(ms)
+}
+
+func (ms *multiStore) CacheMultiStoreWithVersion(int64) (storetypes.CacheMultiStore, error) {
+	return nil, errVersionedBranch
+}
+
+func (ms *multiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.GetKVStore(key)
+}
+
+func (ms *multiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	v, ok := ms.views[key]
+	if !ok {
+		v = newView(ms.mv.store(key), ms.txIndex)
+		ms.views[key] = v
+	}
+
+	return v
+}
+
+func (ms *multiStore) TracingEnabled() bool {
+	return false
+}
+
+func (ms *multiStore) SetTracer(io.Writer) storetypes.MultiStore {
+	return ms
+}
+
+func (ms *multiStore) SetTracingContext(storetypes.TraceContext) storetypes.MultiStore {
+	return ms
+}
+
+func (ms *multiStore) LatestVersion() int64 {
+	return ms.mv.cms.LatestVersion()
+}
+
+// cacheMultiStore is a branch of the multistore of a transaction, whose
+// stores are branched with cachekv when they are first used.
+type cacheMultiStore struct {
+	parent storetypes.MultiStore
+	stores map[storetypes.StoreKey]storetypes.CacheKVStore
+}
+
+func newCacheMultiStore(parent storetypes.MultiStore) *cacheMultiStore {
+	return &cacheMultiStore{
+		parent: parent,
+		stores: make(map[storetypes.StoreKey]storetypes.CacheKVStore),
+	}
+}
+
+func (cms *cacheMultiStore) Write() {
+	for _, store := range cms.stores {
+		store.Write()
+	}
+}
+
+func (cms *cacheMultiStore) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+func (cms *cacheMultiStore) CacheWrap() storetypes.CacheWrap {
+	return cms.CacheMultiStore()
+}
+
+func (cms *cacheMultiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return cms.CacheMultiStore()
+}
+
+func (cms *cacheMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return newCacheMultiStore(cms)
+}
+
+func (cms *cacheMultiStore) CacheMultiStoreWithVersion(int64) (storetypes.CacheMultiStore, error) {
+	return nil, errVersionedBranch
+}
+
+func (cms *cacheMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return cms.GetKVStore(key)
+}
+
+func (cms *cacheMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store, ok := cms.stores[key]
+	if !ok {
+		store = cachekv.NewStore(cms.parent.GetKVStore(key))
+		cms.stores[key] = store
+	}
+
+	return store
+}
+
+func (cms *cacheMultiStore) TracingEnabled() bool {
+	return false
+}
+
+func (cms *cacheMultiStore) SetTracer(io.Writer) storetypes.MultiStore {
+	return cms
+}
+
+func (cms *cacheMultiStore) SetTracingContext(storetypes.TraceContext) storetypes.MultiStore {
+	return cms
+}
+
+func (cms *cacheMultiStore) LatestVersion() int64 {
+	return cms.parent.LatestVersion()
+}
@@ -1,10 +1,15 @@
 package baseapp
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io"
 	"strconv"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpcrecovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
@@ -59,13 +64,31 @@ func (app *BaseApp) RegisterGRPCServerWithSkipCheckHeader(server gogogrpc.Server
 			}
 		}
 
-		// Create the sdk.Context. Passing false as 2nd arg, as we can't
-		// actually support proofs with gRPC right now.
-		sdkCtx, err := app.CreateQueryContextWithCheckHeader(height, false, !skipCheckHeader)
+		// Get prove header from the request context, if present.
+		var prove bool
+		if proveHeaders := md.Get(grpctypes.GRPCQueryProveHeader); len(proveHeaders) == 1 {
+			prove, err = strconv.ParseBool(proveHeaders[0])
+			if err != nil {
+				return nil, errorsmod.Wrapf(
+					sdkerrors.ErrInvalidRequest,
+					"Baseapp.RegisterGRPCServer: invalid prove header %q: %v", grpctypes.GRPCQueryProveHeader, err)
+			}
+		}
+
+		// Create the sdk.Context.
+		sdkCtx, err := app.CreateQueryContextWithCheckHeader(height, prove, !skipCheckHeader)
 		if err != nil {
 			return nil, err
 		}
 
+		// Trace the reads of the query handler, which are proven once it
+		// returns.
+		var reads *bytes.Buffer
+		if prove {
+			reads = new(bytes.Buffer)
+			sdkCtx = sdkCtx.WithMultiStore(sdkCtx.MultiStore().SetTracer(reads).CacheMultiStore())
+		}
+
 		// Add relevant gRPC headers
 		if height == 0 {
 			height = sdkCtx.BlockHeight() // If height was not set in the request, set it to the latest
@@ -93,7 +116,22 @@ func (app *BaseApp) RegisterGRPCServerWithSkipCheckHeader(server gogogrpc.Server
 			}
 		}()
 
-		return handler(grpcCtx, req)
+		resp, err = handler(grpcCtx, req)
+		if err != nil || !prove {
+			return resp, err
+		}
+
+		proofs, err := app.queryProofs(reads, height)
+		if err != nil {
+			return nil, err
+		}
+		// the proofs are only known once the handler has run, after the
+		// headers may have been sent, so they're sent in the trailers
+		if err = grpc.SetTrailer(grpcCtx, metadata.MD{grpctypes.GRPCQueryProofsTrailer: proofs}); err != nil {
+			app.logger.Error("failed to set gRPC trailer", "err", err)
+		}
+
+		return resp, nil
 	}
 
 	// Loop through all services and methods, add the interceptor, and register
@@ -126,3 +164,82 @@ func (app *BaseApp) RegisterGRPCServerWithSkipCheckHeader(server gogogrpc.Server
 		server.RegisterService(newDesc, data.handler)
 	}
 }
+
+// MaxQueryProofs is the maximum number of keys proven by a query, a query
+// reading more keys fails when its proofs are requested.
+const MaxQueryProofs = 256
+
+// queryProofs returns the proofs at the given height of the keys traced in
+// the reads of a query, each encoded as an abci.ResponseQuery holding the key,
+// its value and their proof ops. The proofs are built from the multi-store
+// which served the query. The reads of a store which can't be proven, as one
+// which is not an IAVL store, and the reads of more than MaxQueryProofs keys
+// fail the query.
+//
+// The keys of an iterator are only proven to exist, the proofs don't prove
+// that no other key is in its range.
+func (app *BaseApp) queryProofs(reads io.Reader, height int64) ([]string, error) {
+	queryable, ok := app.queryMultiStore().(storetypes.Queryable)
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "multi-store does not support queries")
+	}
+
+	type storeKey struct {
+		store string
+		key   string
+	}
+
+	var (
+		proofs []string
+		proven = make(map[storeKey]bool)
+		dec    = json.NewDecoder(reads)
+	)
+	for {
+		var op struct {
+			Operation string         `json:"operation"`
+			Key       string         `json:"key"`
+			Metadata  map[string]any `json:"metadata"`
+		}
+		if err := dec.Decode(&op); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to decode traced read: %v", err)
+		}
+		if op.Operation != "read" && op.Operation != "iterKey" {
+			continue
+		}
+
+		storeName, _ := op.Metadata["store_name"].(string)
+		key, err := base64.StdEncoding.DecodeString(op.Key)
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to decode traced key: %v", err)
+		}
+		if proven[storeKey{storeName, string(key)}] {
+			continue
+		}
+		if len(proven) == MaxQueryProofs {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "query reads more than %d keys, the maximum number of keys proven by a query", MaxQueryProofs)
+		}
+		proven[storeKey{storeName, string(key)}] = true
+
+		res, err := queryable.Query(&storetypes.RequestQuery{
+			Path:   "/" + storeName + "/key",
+			Data:   key,
+			Height: height,
+			Prove:  true,
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to prove key %X of store %s", key, storeName)
+		}
+		res.Height = height
+
+		abciRes := abci.ResponseQuery(*res)
+		bz, err := abciRes.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		proofs = append(proofs, string(bz))
+	}
+
+	return proofs, nil
+}
@@ -0,0 +1,164 @@
+package baseapp
+
+import (
+	"crypto/sha256"
+	"reflect"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/store/rootmulti"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// mempoolTxHashes are the hashes of the bytes of the transactions of an
+// EvictingMempool, by transaction and the other way around, so that the evicted
+// transactions are removed from the mempool journal by their original bytes.
+// Only the transactions of comparable types are tracked.
+type mempoolTxHashes struct {
+	mtx    sync.Mutex
+	hashes map[sdk.Tx][sha256.Size]byte
+	txs    map[[sha256.Size]byte]sdk.Tx
+}
+
+func newMempoolTxHashes() *mempoolTxHashes {
+	return &mempoolTxHashes{
+		hashes: make(map[sdk.Tx][sha256.Size]byte),
+		txs:    make(map[[sha256.Size]byte]sdk.Tx),
+	}
+}
+
+func (h *mempoolTxHashes) add(tx sdk.Tx, hash [sha256.Size]byte) {
+	if !reflect.TypeOf(tx).Comparable() {
+		return
+	}
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if old, ok := h.txs[hash]; ok {
+		delete(h.hashes, old)
+	}
+	h.hashes[tx] = hash
+	h.txs[hash] = tx
+}
+
+func (h *mempoolTxHashes) remove(hash [sha256.Size]byte) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if tx, ok := h.txs[hash]; ok {
+		delete(h.hashes, tx)
+		delete(h.txs, hash)
+	}
+}
+
+func (h *mempoolTxHashes) removeTx(tx sdk.Tx) (hash [sha256.Size]byte, ok bool) {
+	if !reflect.TypeOf(tx).Comparable() {
+		return hash, false
+	}
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if hash, ok = h.hashes[tx]; ok {
+		delete(h.hashes, tx)
+		delete(h.txs, hash)
+	}
+
+	return hash, ok
+}
+
+// journalMempoolInsert records the insertion of a transaction in the mempool in
+// the mempool journal, if any. A failure to write the journal only loses the
+// transaction on a restart, so it's logged and not returned.
+func (app *BaseApp) journalMempoolInsert(tx sdk.Tx, txBytes []byte) {
+	if app.mempoolJournal == nil || len(txBytes) == 0 {
+		return
+	}
+
+	if err := app.mempoolJournal.Insert(txBytes); err != nil {
+		app.logger.Error("failed to write the mempool journal", "err", err)
+		return
+	}
+	if app.mempoolTxHashes != nil {
+		app.mempoolTxHashes.add(tx, sha256.Sum256(txBytes))
+	}
+}
+
+// journalMempoolRemove records the removal of a transaction from the mempool
+// in the mempool journal, if any.
+func (app *BaseApp) journalMempoolRemove(txBytes []byte) {
+	if app.mempoolJournal == nil || len(txBytes) == 0 {
+		return
+	}
+
+	app.journalMempoolRemoveHash(sha256.Sum256(txBytes))
+}
+
+func (app *BaseApp) journalMempoolRemoveHash(hash [sha256.Size]byte) {
+	if app.mempoolTxHashes != nil {
+		app.mempoolTxHashes.remove(hash)
+	}
+	if err := app.mempoolJournal.RemoveHash(hash); err != nil {
+		app.logger.Error("failed to write the mempool journal", "err", err)
+	}
+}
+
+// journalMempoolEvictions records the removal of the transactions evicted from
+// the mempool in the mempool journal, if the mempool may evict transactions.
+// The evicted transactions are found in the journal by the hash of the bytes
+// they were inserted with.
+func (app *BaseApp) journalMempoolEvictions() {
+	mp, ok := app.mempool.(mempool.EvictingMempool)
+	if !ok || app.mempoolJournal == nil {
+		return
+	}
+
+	app.mempoolTxHashes = newMempoolTxHashes()
+	mp.SetOnEvict(func(tx sdk.Tx) {
+		if hash, ok := app.mempoolTxHashes.removeTx(tx); ok {
+			app.journalMempoolRemoveHash(hash)
+		}
+	})
+}
+
+// replayMempoolJournal inserts the transactions of the mempool journal in the
+// mempool through CheckTx, against the state of the latest block, and drops
+// the ones which are no longer valid from the journal. The journal is left as
+// is until a block is committed, as its transactions can't be checked before.
+func (app *BaseApp) replayMempoolJournal() {
+	height := app.LastBlockHeight()
+	if app.mempoolJournal == nil || height == 0 {
+		return
+	}
+
+	header := cmtproto.Header{ChainID: app.chainID, Height: height}
+	if rms, ok := app.cms.(*rootmulti.Store); ok {
+		if cInfo, err := rms.GetCommitInfo(height); cInfo != nil && err == nil {
+			header.Time = cInfo.Timestamp
+		}
+	}
+	app.setState(execModeCheck, header)
+
+	txs := app.mempoolJournal.Txs()
+	var dropped int
+	for _, txBytes := range txs {
+		res, err := app.CheckTx(&abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_New})
+		if err == nil && res.Code == abci.CodeTypeOK {
+			continue
+		}
+
+		dropped++
+		app.journalMempoolRemove(txBytes)
+	}
+
+	if err := app.mempoolJournal.Compact(); err != nil {
+		app.logger.Error("failed to compact the mempool journal", "err", err)
+	}
+
+	app.logger.Info("replayed the mempool journal", "txs", len(txs)-dropped, "dropped", dropped)
+}
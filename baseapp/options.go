@@ -91,6 +91,21 @@ func SetIAVLSyncPruning(syncPruning bool) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLSyncPruning(syncPruning) }
 }
 
+// SetArchiveDB provides a BaseApp option function that sets the archive database
+// of the versions pruned from the IAVL stores, which remain queryable. The
+// database is closed along with the BaseApp. It panics if the commit multi-store
+// doesn't implement storetypes.ArchivingMultiStore.
+func SetArchiveDB(db dbm.DB) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		cms, ok := bapp.cms.(storetypes.ArchivingMultiStore)
+		if !ok {
+			panic(fmt.Sprintf("commit multi-store %T doesn't support an archive database", bapp.cms))
+		}
+		cms.SetArchiveDB(db)
+		bapp.archiveDB = db
+	}
+}
+
 // SetInterBlockCache provides a BaseApp option function that sets the
 // inter-block cache.
 func SetInterBlockCache(cache storetypes.MultiStorePersistentCache) func(*BaseApp) {
@@ -107,6 +122,11 @@ func SetMempool(mempool mempool.Mempool) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetMempool(mempool) }
 }
 
+// SetMempoolJournal sets the journal persisting the mempool of BaseApp.
+func SetMempoolJournal(journal *mempool.Journal) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetMempoolJournal(journal) }
+}
+
 // SetChainID sets the chain ID in BaseApp.
 func SetChainID(chainID string) func(*BaseApp) {
 	return func(app *BaseApp) { app.chainID = chainID }
@@ -124,6 +144,11 @@ func SetOptimisticExecution(opts ...func(*oe.OptimisticExecution)) func(*BaseApp
 	}
 }
 
+// SetTxExecutor sets the executor of the transactions of FinalizeBlock.
+func SetTxExecutor(executor TxExecutor) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetTxExecutor(executor) }
+}
+
 // DisableBlockGasMeter disables the block gas meter.
 func DisableBlockGasMeter() func(*BaseApp) {
 	return func(app *BaseApp) { app.SetDisableBlockGasMeter(true) }
@@ -331,6 +356,21 @@ func (app *BaseApp) SetMempool(mempool mempool.Mempool) {
 		panic("SetMempool() on sealed BaseApp")
 	}
 	app.mempool = mempool
+	app.journalMempoolEvictions()
+}
+
+// SetMempoolJournal sets the journal recording the transactions inserted in
+// and removed from the mempool of the BaseApp. The transactions of the journal
+// are inserted again through CheckTx by LoadLatestVersion, against the state of
+// the latest block, and the ones which are no longer valid are dropped. The
+// transactions evicted by a mempool.EvictingMempool are removed from the
+// journal.
+func (app *BaseApp) SetMempoolJournal(journal *mempool.Journal) {
+	if app.sealed {
+		panic("SetMempoolJournal() on sealed BaseApp")
+	}
+	app.mempoolJournal = journal
+	app.journalMempoolEvictions()
 }
 
 // SetProcessProposal sets the process proposal function for the BaseApp.
@@ -394,6 +434,12 @@ func (app *BaseApp) SetDisableBlockGasMeter(disableBlockGasMeter bool) {
 	app.disableBlockGasMeter = disableBlockGasMeter
 }
 
+// SetTxExecutor sets the executor of the transactions of FinalizeBlock. By
+// default, they are executed sequentially.
+func (app *BaseApp) SetTxExecutor(executor TxExecutor) {
+	app.txExecutor = executor
+}
+
 // SetMsgServiceRouter sets the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) SetMsgServiceRouter(msgServiceRouter *MsgServiceRouter) {
 	app.msgServiceRouter = msgServiceRouter
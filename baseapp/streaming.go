@@ -1,14 +1,24 @@
 package baseapp
 
 import (
+	"context"
 	"fmt"
+	"path/filepath"
 	"slices"
 	"sort"
 	"strings"
+	"sync"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/spf13/cast"
 
+	"cosmossdk.io/log"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/decoding"
+	"cosmossdk.io/schema/indexer"
 	"cosmossdk.io/store/streaming"
+	"cosmossdk.io/store/streaming/file"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -21,6 +31,15 @@ const (
 	StreamingABCIPluginTomlKey        = "plugin"
 	StreamingABCIKeysTomlKey          = "keys"
 	StreamingABCIStopNodeOnErrTomlKey = "stop-node-on-err"
+
+	StreamingFileTomlKey          = "file"
+	StreamingFileDirTomlKey       = "dir"
+	StreamingFileMaxBlocksTomlKey = "max-blocks"
+	StreamingFileMaxBytesTomlKey  = "max-bytes"
+	StreamingFileCompressTomlKey  = "compress"
+	StreamingFileFsyncTomlKey     = "fsync"
+
+	IndexerTomlKey = "indexer"
 )
 
 // RegisterStreamingServices registers streaming services with the BaseApp.
@@ -42,6 +61,43 @@ func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, ke
 		}
 	}
 
+	// @nubit: register the file streaming service, which is enabled by its directory
+	fileDirKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingFileTomlKey, StreamingFileDirTomlKey)
+	if fileDir := strings.TrimSpace(cast.ToString(appOpts.Get(fileDirKey))); len(fileDir) > 0 {
+		if err := app.registerFileListener(appOpts, keys, fileDir); err != nil {
+			return fmt.Errorf("failed to register file streaming service: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// registerFileListener registers the file ABCIListener with the BaseApp. A
+// relative directory is resolved from the node home.
+func (app *BaseApp) registerFileListener(
+	appOpts servertypes.AppOptions,
+	keys map[string]*storetypes.KVStoreKey,
+	dir string,
+) error {
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), dir)
+	}
+
+	optKey := func(key string) string {
+		return fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingFileTomlKey, key)
+	}
+	listener, err := file.NewListener(file.Config{
+		Dir:       dir,
+		MaxBlocks: cast.ToInt64(appOpts.Get(optKey(StreamingFileMaxBlocksTomlKey))),
+		MaxBytes:  cast.ToInt64(appOpts.Get(optKey(StreamingFileMaxBytesTomlKey))),
+		Compress:  cast.ToBool(appOpts.Get(optKey(StreamingFileCompressTomlKey))),
+		Fsync:     cast.ToBool(appOpts.Get(optKey(StreamingFileFsyncTomlKey))),
+	})
+	if err != nil {
+		return err
+	}
+
+	app.registerABCIListener(appOpts, keys, StreamingFileTomlKey, listener)
 	return nil
 }
 
@@ -66,16 +122,28 @@ func (app *BaseApp) registerABCIListenerPlugin(
 	keys map[string]*storetypes.KVStoreKey,
 	abciListener storetypes.ABCIListener,
 ) {
-	stopNodeOnErrKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingABCITomlKey, StreamingABCIStopNodeOnErrTomlKey)
+	app.registerABCIListener(appOpts, keys, StreamingABCITomlKey, abciListener)
+}
+
+// registerABCIListener adds the ABCIListener of a streaming service to the
+// streaming manager, and listens to the store keys of the service. The change
+// sets passed to the listeners hold the keys of all the streaming services.
+func (app *BaseApp) registerABCIListener(
+	appOpts servertypes.AppOptions,
+	keys map[string]*storetypes.KVStoreKey,
+	service string,
+	abciListener storetypes.ABCIListener,
+) {
+	stopNodeOnErrKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, service, StreamingABCIStopNodeOnErrTomlKey)
 	stopNodeOnErr := cast.ToBool(appOpts.Get(stopNodeOnErrKey))
-	keysKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingABCITomlKey, StreamingABCIKeysTomlKey)
+	keysKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, service, StreamingABCIKeysTomlKey)
 	exposeKeysStr := cast.ToStringSlice(appOpts.Get(keysKey))
 	exposedKeys := exposeStoreKeysSorted(exposeKeysStr, keys)
 	app.cms.AddListeners(exposedKeys)
 	app.SetStreamingManager(
 		storetypes.StreamingManager{
-			ABCIListeners: []storetypes.ABCIListener{abciListener},
-			StopNodeOnErr: stopNodeOnErr,
+			ABCIListeners: append(app.streamingManager.ABCIListeners, abciListener),
+			StopNodeOnErr: app.streamingManager.StopNodeOnErr || stopNodeOnErr,
 		},
 	)
 }
@@ -106,3 +174,149 @@ func exposeStoreKeysSorted(keysStr []string, keys map[string]*storetypes.KVStore
 
 	return exposeStoreKeys
 }
+
+// EnableIndexer enables the built-in state indexer with the provided options,
+// usually the indexer section of app.toml, which configures its targets. The
+// state changes of a KV store are decoded into object updates by the app
+// module keyed by the name of the store in appModules, if it implements
+// schema.HasModuleCodec.
+func (app *BaseApp) EnableIndexer(indexerOpts any, keys map[string]*storetypes.KVStoreKey, appModules map[string]any) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+
+	opts := indexer.IndexingOptions{
+		Config:        indexerOpts,
+		Resolver:      decoding.ModuleSetDecoderResolver(appModules),
+		Logger:        app.logger.With(log.ModuleKey, "indexer"),
+		Context:       ctx,
+		DoneWaitGroup: wg,
+	}
+	if app.interfaceRegistry != nil {
+		opts.AddressCodec = app.interfaceRegistry.SigningContext().AddressCodec()
+	}
+
+	target, err := indexer.StartIndexing(opts)
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	exposedKeys := exposeStoreKeysSorted([]string{"*"}, keys)
+	app.cms.AddListeners(exposedKeys)
+	app.SetStreamingManager(
+		storetypes.StreamingManager{
+			ABCIListeners: append(app.streamingManager.ABCIListeners, &indexerListener{
+				listener: target.Listener,
+				cancel:   cancel,
+				done:     wg,
+			}),
+			StopNodeOnErr: true,
+		},
+	)
+
+	return nil
+}
+
+var _ storetypes.ABCIListener = (*indexerListener)(nil)
+
+// indexerListener is the ABCIListener which passes the blocks and the state
+// changes to the app data listener of the indexer.
+type indexerListener struct {
+	listener appdata.Listener
+	cancel   context.CancelFunc
+	done     *sync.WaitGroup
+}
+
+func (l *indexerListener) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	height := uint64(req.Height)
+	if l.listener.StartBlock != nil {
+		if err := l.listener.StartBlock(appdata.StartBlockData{Height: height}); err != nil {
+			return err
+		}
+	}
+
+	if l.listener.OnTx != nil {
+		for i, tx := range req.Txs {
+			err := l.listener.OnTx(appdata.TxData{
+				BlockNumber: height,
+				TxIndex:     int32(i),
+				Bytes:       func() ([]byte, error) { return tx, nil },
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if l.listener.OnEvent != nil {
+		var events []appdata.Event
+		events = appendIndexerEvents(events, height, 0, res.Events)
+		for i, txResult := range res.TxResults {
+			events = appendIndexerEvents(events, height, int32(i+1), txResult.Events)
+		}
+		if err := l.listener.OnEvent(appdata.EventData{Events: events}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l *indexerListener) ListenCommit(_ context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	if l.listener.OnKVPair != nil {
+		updates := make([]appdata.ActorKVPairUpdate, len(changeSet))
+		for i, pair := range changeSet {
+			updates[i] = appdata.ActorKVPairUpdate{
+				Actor: []byte(pair.StoreKey),
+				StateChanges: []schema.KVPairUpdate{{
+					Key:    pair.Key,
+					Value:  pair.Value,
+					Remove: pair.Delete,
+				}},
+			}
+		}
+		if err := l.listener.OnKVPair(appdata.KVPairData{Updates: updates}); err != nil {
+			return err
+		}
+	}
+
+	if l.listener.Commit != nil {
+		// wait for the indexer targets to commit the block
+		completion, err := l.listener.Commit(appdata.CommitData{})
+		if err != nil {
+			return err
+		}
+		if completion != nil {
+			return completion()
+		}
+	}
+
+	return nil
+}
+
+// Close stops the indexer targets.
+func (l *indexerListener) Close() error {
+	l.cancel()
+	l.done.Wait()
+	return nil
+}
+
+// appendIndexerEvents appends the ABCI events of a block, or of the tx at the
+// 1-based index txIndex, to events.
+func appendIndexerEvents(events []appdata.Event, height uint64, txIndex int32, abciEvents []abci.Event) []appdata.Event {
+	for i, event := range abciEvents {
+		attributes := make([]appdata.EventAttribute, len(event.Attributes))
+		for j, attr := range event.Attributes {
+			attributes[j] = appdata.EventAttribute{Key: attr.Key, Value: attr.Value}
+		}
+
+		events = append(events, appdata.Event{
+			BlockNumber: height,
+			TxIndex:     txIndex,
+			EventIndex:  int32(i + 1),
+			Type:        event.Type,
+			Attributes:  func() ([]appdata.EventAttribute, error) { return attributes, nil },
+		})
+	}
+	return events
+}
@@ -9,10 +9,16 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/indexer"
+	"cosmossdk.io/store/streaming/file"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/indexer/memory"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var _ storetypes.ABCIListener = (*MockABCIListener)(nil)
@@ -149,3 +155,149 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestFileStreamingService(t *testing.T) {
+	dir := t.TempDir()
+	appOpts := simtestutil.AppOptionsMap{
+		"streaming.file.dir":        dir,
+		"streaming.file.keys":       []string{distKey1.Name()},
+		"streaming.file.max-blocks": 2,
+	}
+	distOpt := func(bapp *baseapp.BaseApp) { bapp.MountStores(distKey1) }
+	streamingOpt := func(bapp *baseapp.BaseApp) {
+		keys := map[string]*storetypes.KVStoreKey{distKey1.Name(): distKey1}
+		require.NoError(t, bapp.RegisterStreamingServices(appOpts, keys))
+	}
+	endBlockerOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetEndBlocker(func(ctx sdk.Context) (sdk.EndBlock, error) {
+			ctx.KVStore(distKey1).Set([]byte("key"), fmt.Appendf(nil, "value%d", ctx.BlockHeight()))
+			return sdk.EndBlock{}, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, distOpt, streamingOpt, endBlockerOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	nBlocks := 3
+	for blockN := range nBlocks {
+		_, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: int64(blockN) + 1})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+	require.NoError(t, suite.baseApp.Close())
+
+	files, err := file.Files(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	var blocks []*file.Block
+	require.NoError(t, file.Replay(dir, 0, func(block *file.Block) error {
+		blocks = append(blocks, block)
+		return nil
+	}))
+	require.Len(t, blocks, nBlocks)
+	for i, block := range blocks {
+		height := int64(i) + 1
+		require.Equal(t, height, block.Height)
+		require.Equal(t, []*storetypes.StoreKVPair{{
+			StoreKey: distKey1.Name(),
+			Key:      []byte("key"),
+			Value:    fmt.Appendf(nil, "value%d", height),
+		}}, block.ChangeSet)
+	}
+}
+
+// testIndexerModule decodes the state changes of distKey1 into the values
+// object type.
+type testIndexerModule struct{}
+
+func (testIndexerModule) ModuleCodec() (schema.ModuleCodec, error) {
+	return schema.ModuleCodec{
+		Schema: schema.MustCompileModuleSchema(schema.StateObjectType{
+			Name:        "values",
+			KeyFields:   []schema.Field{{Name: "key", Kind: schema.StringKind}},
+			ValueFields: []schema.Field{{Name: "value", Kind: schema.StringKind}},
+		}),
+		KVDecoder: func(update schema.KVPairUpdate) ([]schema.StateObjectUpdate, error) {
+			return []schema.StateObjectUpdate{{
+				TypeName: "values",
+				Key:      string(update.Key),
+				Value:    string(update.Value),
+				Delete:   update.Remove,
+			}}, nil
+		},
+	}, nil
+}
+
+// testIndexer is the memory indexer of the test indexer type.
+var testIndexer = memory.NewIndexer()
+
+func init() {
+	indexer.Register("baseapp_test", indexer.Initializer{
+		InitFunc: func(indexer.InitParams) (indexer.InitResult, error) {
+			return indexer.InitResult{Listener: testIndexer.Listener(), View: testIndexer}, nil
+		},
+		ConfigType: memory.Config{},
+	})
+}
+
+func TestEnableIndexer(t *testing.T) {
+	indexerOpts := map[string]any{
+		"target": map[string]any{
+			"test": map[string]any{"type": "baseapp_test", "config": map[string]any{}},
+		},
+	}
+	distOpt := func(bapp *baseapp.BaseApp) { bapp.MountStores(distKey1) }
+	indexerOpt := func(bapp *baseapp.BaseApp) {
+		keys := map[string]*storetypes.KVStoreKey{distKey1.Name(): distKey1}
+		modules := map[string]any{distKey1.Name(): testIndexerModule{}}
+		require.NoError(t, bapp.EnableIndexer(indexerOpts, keys, modules))
+	}
+	endBlockerOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetEndBlocker(func(ctx sdk.Context) (sdk.EndBlock, error) {
+			store := ctx.KVStore(distKey1)
+			store.Set(fmt.Appendf(nil, "key%d", ctx.BlockHeight()), fmt.Appendf(nil, "value%d", ctx.BlockHeight()))
+			store.Delete(fmt.Appendf(nil, "key%d", ctx.BlockHeight()-2))
+			return sdk.EndBlock{}, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, distOpt, indexerOpt, endBlockerOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	nBlocks := 4
+	for blockN := range nBlocks {
+		_, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: int64(blockN) + 1})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+
+		// the block is indexed when it's committed
+		height, err := testIndexer.BlockNum()
+		require.NoError(t, err)
+		require.Equal(t, uint64(blockN)+1, height)
+	}
+	require.NoError(t, suite.baseApp.Close())
+
+	mod, err := testIndexer.AppState().GetModule(distKey1.Name())
+	require.NoError(t, err)
+	values, err := mod.GetObjectCollection("values")
+	require.NoError(t, err)
+	var objects []schema.StateObjectUpdate
+	values.AllState(func(obj schema.StateObjectUpdate, err error) bool {
+		require.NoError(t, err)
+		objects = append(objects, obj)
+		return true
+	})
+	require.Equal(t, []schema.StateObjectUpdate{
+		{TypeName: "values", Key: "key3", Value: "value3"},
+		{TypeName: "values", Key: "key4", Value: "value4"},
+	}, objects)
+}
@@ -0,0 +1,226 @@
+package baseapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// TxExecutor executes the transactions of a block on cms and returns their
+// results, in the order of the block.
+//
+// deliverTx executes the transaction at txIndex of the block on ms, which is
+// either cms or a store branched from it, and returns its result. It may be
+// called concurrently for different transactions and several times for the
+// same transaction, the result of a transaction is then the one of its last
+// execution. The executor must write the writes of the last execution of every
+// transaction to cms.
+type TxExecutor func(
+	ctx context.Context,
+	block [][]byte,
+	cms storetypes.MultiStore,
+	deliverTx func(txIndex int, ms storetypes.MultiStore) *abci.ExecTxResult,
+) ([]*abci.ExecTxResult, error)
+
+// SequentialTxExecutor is the default TxExecutor, it executes the transactions
+// one after the other on cms.
+func SequentialTxExecutor(
+	ctx context.Context,
+	block [][]byte,
+	cms storetypes.MultiStore,
+	deliverTx func(txIndex int, ms storetypes.MultiStore) *abci.ExecTxResult,
+) ([]*abci.ExecTxResult, error) {
+	txResults := make([]*abci.ExecTxResult, 0, len(block))
+	for i := range block {
+		txResults = append(txResults, deliverTx(i, cms))
+
+		// check after every tx if we should abort
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			// continue
+		}
+	}
+
+	return txResults, nil
+}
+
+// executeTxs executes the transactions of the block with the TxExecutor of the
+// app, on finalizeBlockState.
+//
+// The block gas meter isn't safe for concurrent use, and it orders the
+// transactions: once it runs out of gas the following transactions fail. So
+// the transactions run by a TxExecutor get their own block gas meter, and
+// are executed on a branch of finalizeBlockState. The block gas of the
+// transactions is then consumed in order, and the branch is only written if
+// no transaction ran out of block gas. Otherwise, the block is executed again
+// sequentially.
+//
+// The transactions are decoded once. The ones which passed their ante handler
+// in their last execution are removed from the mempool once their final
+// results are known, whatever the number of times they were executed.
+func (app *BaseApp) executeTxs(ctx context.Context, txs [][]byte) ([]*abci.ExecTxResult, error) {
+	decodedTxs := app.decodeBlockTxs(txs)
+	anteOK := make([]bool, len(txs))
+
+	txResults, err := app.executeDecodedTxs(ctx, txs, decodedTxs, anteOK)
+	if err != nil {
+		return nil, err
+	}
+
+	app.removeBlockTxsFromMempool(txs, decodedTxs, anteOK)
+
+	return txResults, nil
+}
+
+func (app *BaseApp) executeDecodedTxs(ctx context.Context, txs [][]byte, decodedTxs []sdk.Tx, anteOK []bool) ([]*abci.ExecTxResult, error) {
+	if app.txExecutor == nil {
+		return app.executeTxsSequentially(ctx, txs, decodedTxs, anteOK)
+	}
+
+	blockGas := make([]uint64, len(txs))
+	branch := app.finalizeBlockState.ms.CacheMultiStore()
+	txResults, err := app.txExecutor(ctx, txs, branch, app.blockTxDeliverer(txs, decodedTxs, anteOK, blockGas))
+	if err != nil {
+		return nil, err
+	}
+	if len(txResults) != len(txs) {
+		return nil, fmt.Errorf("tx executor returned %d results for %d txs", len(txResults), len(txs))
+	}
+
+	if !consumeBlockGas(app.finalizeBlockState.Context().BlockGasMeter(), blockGas) {
+		app.logger.Info("block gas limit reached, executing the block sequentially", "height", app.finalizeBlockState.Context().BlockHeight())
+
+		gasMeter := app.getBlockGasMeter(app.finalizeBlockState.Context())
+		app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().WithBlockGasMeter(gasMeter))
+		return app.executeTxsSequentially(ctx, txs, decodedTxs, anteOK)
+	}
+
+	branch.Write()
+	for _, txResult := range txResults {
+		recordTxTelemetry(txResult)
+	}
+
+	return txResults, nil
+}
+
+func (app *BaseApp) executeTxsSequentially(ctx context.Context, txs [][]byte, decodedTxs []sdk.Tx, anteOK []bool) ([]*abci.ExecTxResult, error) {
+	txResults, err := SequentialTxExecutor(ctx, txs, app.finalizeBlockState.ms, app.blockTxDeliverer(txs, decodedTxs, anteOK, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, txResult := range txResults {
+		recordTxTelemetry(txResult)
+	}
+
+	return txResults, nil
+}
+
+// decodeBlockTxs decodes the transactions of the block, a transaction which
+// can't be decoded is nil.
+func (app *BaseApp) decodeBlockTxs(txs [][]byte) []sdk.Tx {
+	decodedTxs := make([]sdk.Tx, len(txs))
+	for i, txBytes := range txs {
+		tx, err := app.txDecoder(txBytes)
+		if err != nil {
+			continue
+		}
+		decodedTxs[i] = tx
+	}
+
+	return decodedTxs
+}
+
+// removeBlockTxsFromMempool removes the decoded transactions of the block which
+// passed their ante handler from the mempool and the mempool journal. A failure
+// to remove a transaction doesn't change the results of the block, so it's
+// logged and not returned.
+func (app *BaseApp) removeBlockTxsFromMempool(txs [][]byte, decodedTxs []sdk.Tx, anteOK []bool) {
+	for i, tx := range decodedTxs {
+		if tx == nil || !anteOK[i] {
+			continue
+		}
+
+		err := app.mempool.Remove(tx)
+		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+			app.logger.Error("failed to remove tx from mempool", "err", err)
+			continue
+		}
+		app.journalMempoolRemove(txs[i])
+	}
+}
+
+// blockTxDeliverer returns the deliverTx function given to a TxExecutor for
+// the block. Whether the last execution of a transaction passed its ante
+// handler is recorded at its index in anteOK. If blockGas is not nil, every
+// execution has its own block gas meter and event manager, and the block gas
+// consumed by the last execution of a transaction is recorded at its index in
+// blockGas.
+func (app *BaseApp) blockTxDeliverer(txs [][]byte, decodedTxs []sdk.Tx, anteOK []bool, blockGas []uint64) func(int, storetypes.MultiStore) *abci.ExecTxResult {
+	return func(txIndex int, ms storetypes.MultiStore) *abci.ExecTxResult {
+		txBytes := txs[txIndex]
+
+		tx := decodedTxs[txIndex]
+		if tx == nil {
+			if blockGas != nil {
+				blockGas[txIndex] = 0
+			}
+
+			// In the case where a transaction included in a block proposal is malformed,
+			// we still want to return a default response to comet. This is because comet
+			// expects a response for each transaction included in a block proposal.
+			return sdkerrors.ResponseExecTxResultWithEvents(
+				sdkerrors.ErrTxDecode,
+				0,
+				0,
+				nil,
+				false,
+			)
+		}
+
+		ctx := app.getContextForTx(execModeFinalize, txBytes, ms)
+		if blockGas == nil {
+			txResult, ok := app.deliverTxWithContext(ctx, txBytes, tx)
+			anteOK[txIndex] = ok
+			return txResult
+		}
+
+		gasMeter := storetypes.NewInfiniteGasMeter()
+		ctx = ctx.WithBlockGasMeter(gasMeter).WithEventManager(sdk.NewEventManager())
+		txResult, ok := app.deliverTxWithContext(ctx, txBytes, tx)
+		anteOK[txIndex] = ok
+		blockGas[txIndex] = gasMeter.GasConsumed()
+
+		return txResult
+	}
+}
+
+// consumeBlockGas consumes the block gas of the transactions in order, and
+// returns false if a transaction would have found the meter out of gas, or
+// would have run it out of gas.
+func consumeBlockGas(gasMeter storetypes.GasMeter, blockGas []uint64) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+
+	for _, gas := range blockGas {
+		if gasMeter.IsOutOfGas() {
+			return false
+		}
+		gasMeter.ConsumeGas(gas, "block gas meter")
+	}
+
+	return true
+}
@@ -89,7 +89,7 @@ func GenesisStateWithSingleValidator(t *testing.T, codec codec.Codec, builder *r
 func makeMinimalConfig() depinject.Config {
 	var (
 		mempoolOpt            = baseapp.SetMempool(mempool.NewSenderNonceMempool())
-		addressCodec          = func() address.Codec { return addresscodec.NewTaprootCodec(&sdk.BitcoinNetParams) }
+		addressCodec          = func() address.Codec { return addresscodec.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams()) }
 		validatorAddressCodec = func() runtime.ValidatorAddressCodec { return addresscodec.NewBech32Codec("cosmosvaloper") }
 		consensusAddressCodec = func() runtime.ConsensusAddressCodec { return addresscodec.NewBech32Codec("cosmosvalcons") }
 	)
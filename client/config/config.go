@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultConfig returns default config for the client.toml
@@ -27,6 +28,7 @@ type ClientConfig struct {
 	Output                string `mapstructure:"output" json:"output"`
 	Node                  string `mapstructure:"node" json:"node"`
 	BroadcastMode         string `mapstructure:"broadcast-mode" json:"broadcast-mode"`
+	BitcoinNetwork        string `mapstructure:"bitcoin-network" json:"bitcoin-network"`
 }
 
 func (c *ClientConfig) SetChainID(chainID string) {
@@ -49,6 +51,10 @@ func (c *ClientConfig) SetBroadcastMode(broadcastMode string) {
 	c.BroadcastMode = broadcastMode
 }
 
+func (c *ClientConfig) SetBitcoinNetwork(bitcoinNetwork string) {
+	c.BitcoinNetwork = bitcoinNetwork
+}
+
 // ReadDefaultValuesFromDefaultClientConfig reads default values from default client.toml file and updates them in client.Context
 // The client.toml is then discarded.
 func ReadDefaultValuesFromDefaultClientConfig(ctx client.Context) (client.Context, error) {
@@ -94,6 +100,13 @@ func ReadFromClientConfig(ctx client.Context) (client.Context, error) {
 	if err != nil {
 		return ctx, fmt.Errorf("couldn't get client config: %w", err)
 	}
+
+	// the Bitcoin network must be selected before the SDK config is sealed
+	if conf.BitcoinNetwork != "" {
+		if err := sdk.GetConfig().SetBitcoinNetwork(conf.BitcoinNetwork); err != nil {
+			return ctx, fmt.Errorf("couldn't set bitcoin network: %w", err)
+		}
+	}
 	// we need to update KeyringDir field on Client Context first cause it is used in NewKeyringFromBackend
 	ctx = ctx.WithOutputFormat(conf.Output).
 		WithChainID(conf.ChainID).
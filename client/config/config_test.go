@@ -3,6 +3,7 @@ package config_test
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -14,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -94,3 +96,28 @@ func TestConfigCmdEnvFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestReadFromClientConfigBitcoinNetwork(t *testing.T) {
+	home := t.TempDir()
+	clientCtx := client.Context{}.
+		WithHomeDir(home).
+		WithViper("").
+		WithCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
+
+	configDir := filepath.Join(home, "config")
+	require.NoError(t, os.MkdirAll(configDir, 0o755))
+	writeConfig := func(network string) {
+		content := fmt.Sprintf("keyring-backend = \"test\"\nbitcoin-network = %q\n", network)
+		require.NoError(t, os.WriteFile(filepath.Join(configDir, "client.toml"), []byte(content), 0o600))
+	}
+
+	writeConfig("unknown")
+	_, err := config.ReadFromClientConfig(clientCtx)
+	require.ErrorContains(t, err, "unknown bitcoin network")
+
+	network := sdk.GetConfig().GetBitcoinNetParams().Name
+	writeConfig(network)
+	_, err = config.ReadFromClientConfig(clientCtx.WithViper(""))
+	require.NoError(t, err)
+	require.Equal(t, network, sdk.GetConfig().GetBitcoinNetParams().Name)
+}
@@ -27,6 +27,9 @@ output = "{{ .Output }}"
 node = "{{ .Node }}"
 # Transaction broadcasting mode (sync|async)
 broadcast-mode = "{{ .BroadcastMode }}"
+# Bitcoin network of Taproot addresses and BIP-322 signatures (mainnet|testnet3|signet|regtest|simnet).
+# An empty string keeps the network selected by the application, mainnet by default.
+bitcoin-network = "{{ .BitcoinNetwork }}"
 `
 
 // writeConfigToFile parses defaultConfigTemplate, renders config using the template and writes it to
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/spf13/cobra"
 
 	errorsmod "cosmossdk.io/errors"
@@ -245,25 +246,34 @@ func PubkeyRawCmd() *cobra.Command {
 func AddrCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "addr [address]",
-		Short: "Convert an address between hex and bech32",
-		Long: fmt.Sprintf(`Convert an address between hex encoding and bech32.
+		Short: "Convert an address between hex, bech32 and taproot",
+		Long: fmt.Sprintf(`Convert an address between hex encoding, bech32 and taproot.
+
+The address is parsed in any of these encodings, whatever the address formats of the chain.
 
 Example:
 $ %s debug addr cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg
-			`, version.AppName),
+$ %s debug addr bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0
+			`, version.AppName, version.AppName),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			addrString := args[0]
-			// try hex, then bech32
+			config := sdk.GetConfig()
+			// try hex, then taproot, then bech32
 			var (
 				addr []byte
 				err  error
 			)
 			decodeFns := []func(text string) ([]byte, error){
 				hex.DecodeString,
-				func(text string) ([]byte, error) { return sdk.AccAddressFromBech32(text) },
-				func(text string) ([]byte, error) { return sdk.ValAddressFromBech32(text) },
-				func(text string) ([]byte, error) { return sdk.ConsAddressFromBech32(text) },
+				func(text string) ([]byte, error) { return taprootAddressBytes(text, config.GetBitcoinNetParams()) },
+				func(text string) ([]byte, error) { return sdk.GetFromBech32(text, config.GetBech32AccountAddrPrefix()) },
+				func(text string) ([]byte, error) {
+					return sdk.GetFromBech32(text, config.GetBech32ValidatorAddrPrefix())
+				},
+				func(text string) ([]byte, error) {
+					return sdk.GetFromBech32(text, config.GetBech32ConsensusAddrPrefix())
+				},
 			}
 			errs := make([]any, 0, len(decodeFns))
 			for _, fn := range decodeFns {
@@ -274,7 +284,7 @@ $ %s debug addr cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg
 			}
 			if len(errs) == len(decodeFns) {
 				errTags := []string{
-					"hex", "bech32 acc", "bech32 val", "bech32 con",
+					"hex", "taproot", "bech32 acc", "bech32 val", "bech32 con",
 				}
 				format := ""
 				for i := range errs {
@@ -283,19 +293,34 @@ $ %s debug addr cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg
 					}
 					format += errTags[i] + ": %w"
 				}
-				return fmt.Errorf("expected hex or bech32. Got errors: "+format, errs...)
+				return fmt.Errorf("expected hex, taproot or bech32. Got errors: "+format, errs...)
 			}
 
 			cmd.Println("Address:", addr)
 			cmd.Printf("Address (hex): %X\n", addr)
-			cmd.Printf("Bech32 Acc: %s\n", sdk.AccAddress(addr))
-			cmd.Printf("Bech32 Val: %s\n", sdk.ValAddress(addr))
-			cmd.Printf("Bech32 Con: %s\n", sdk.ConsAddress(addr))
+			if prefix := config.GetBech32AccountAddrPrefix(); prefix != "" {
+				cmd.Printf("Bech32 Acc: %s\n", sdk.MustBech32ifyAddressBytes(prefix, addr))
+			}
+			if taprootAddr, err := taproot.EncodeSegwitAddress(addr, config.GetBitcoinNetParams()); err == nil {
+				cmd.Printf("Taproot Acc: %s\n", taprootAddr)
+			}
+			cmd.Printf("Bech32 Val: %s\n", sdk.MustBech32ifyAddressBytes(config.GetBech32ValidatorAddrPrefix(), addr))
+			cmd.Printf("Bech32 Con: %s\n", sdk.MustBech32ifyAddressBytes(config.GetBech32ConsensusAddrPrefix(), addr))
 			return nil
 		},
 	}
 }
 
+// taprootAddressBytes decodes the witness program of a P2TR or P2WPKH address.
+func taprootAddressBytes(text string, net *chaincfg.Params) ([]byte, error) {
+	bz, err := taproot.DecodeSegwitAddress(text, net)
+	if err != nil {
+		return nil, errorsmod.Wrap(errors.ErrInvalidAddress, err.Error())
+	}
+
+	return bz, nil
+}
+
 func RawBytesCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:     "raw-bytes <raw-bytes>",
@@ -21,6 +21,31 @@ var ServiceAutoCLIDescriptor = &autocliv1.ServiceCommandDescriptor{
 	},
 }
 
+// MempoolServiceAutoCLIDescriptor describes the commands of the mempool gRPC
+// service. The service is referred to by name, as apps may depend on an API
+// module which doesn't define it.
+var MempoolServiceAutoCLIDescriptor = &autocliv1.ServiceCommandDescriptor{
+	Service: "cosmos.base.node.v1beta1.MempoolService",
+	RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+		{
+			RpcMethod: "MempoolTxs",
+			Use:       "txs",
+			Short:     "Query the transactions of the app-side mempool, in the order of their selection",
+		},
+		{
+			RpcMethod:      "MempoolSenderTxs",
+			Use:            "sender [sender]",
+			Short:          "Query the transactions of a sender in the app-side mempool, in nonce order",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}},
+		},
+		{
+			RpcMethod: "MempoolCounts",
+			Use:       "counts",
+			Short:     "Query the number of transactions in the app-side mempool, in total and by sender",
+		},
+	},
+}
+
 // NewNodeCommands is a fake `appmodule.Module` to be considered as a module
 // and be added in AutoCLI.
 func NewNodeCommands() *nodeModule {
@@ -41,3 +66,24 @@ func (m nodeModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 		Query: ServiceAutoCLIDescriptor,
 	}
 }
+
+// NewMempoolCommands is a fake `appmodule.Module` to be considered as a module
+// and be added in AutoCLI, providing the `query mempool` commands.
+func NewMempoolCommands() *mempoolModule {
+	return &mempoolModule{}
+}
+
+type mempoolModule struct{}
+
+func (m mempoolModule) IsOnePerModuleType() {}
+func (m mempoolModule) IsAppModule()        {}
+
+func (m mempoolModule) Name() string {
+	return "mempool"
+}
+
+func (m mempoolModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: MempoolServiceAutoCLIDescriptor,
+	}
+}
@@ -0,0 +1,142 @@
+package node
+
+import (
+	context "context"
+	"math"
+	"slices"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// defaultMempoolTxsLimit is the number of transactions returned by MempoolTxs
+// when the request has no limit.
+const defaultMempoolTxsLimit = 100
+
+// RegisterMempoolService registers the mempool gRPC service on the provided gRPC
+// router, serving the content of the given app-side mempool.
+func RegisterMempoolService(clientCtx client.Context, server gogogrpc.Server, mp mempool.Mempool) {
+	RegisterMempoolServiceServer(server, NewMempoolQueryServer(clientCtx, mp))
+}
+
+var _ MempoolServiceServer = mempoolQueryServer{}
+
+type mempoolQueryServer struct {
+	clientCtx client.Context
+	mempool   mempool.Mempool
+}
+
+// NewMempoolQueryServer returns the mempool gRPC service, which requires the
+// mempool to implement mempool.Inspectable.
+func NewMempoolQueryServer(clientCtx client.Context, mp mempool.Mempool) MempoolServiceServer {
+	return mempoolQueryServer{
+		clientCtx: clientCtx,
+		mempool:   mp,
+	}
+}
+
+func (s mempoolQueryServer) MempoolTxs(_ context.Context, req *MempoolTxsRequest) (*MempoolTxsResponse, error) {
+	inspectable, err := s.inspectable()
+	if err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultMempoolTxsLimit
+	}
+	infos, total := inspectable.InspectTxs(int(min(req.Offset, math.MaxInt)), int(min(limit, math.MaxInt)))
+
+	txs, err := s.mempoolTxs(infos)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MempoolTxsResponse{Txs: txs, Total: uint64(total)}, nil
+}
+
+func (s mempoolQueryServer) MempoolSenderTxs(_ context.Context, req *MempoolSenderTxsRequest) (*MempoolSenderTxsResponse, error) {
+	if req.Sender == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty sender")
+	}
+
+	inspectable, err := s.inspectable()
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := s.mempoolTxs(inspectable.InspectSenderTxs(req.Sender))
+	if err != nil {
+		return nil, err
+	}
+
+	return &MempoolSenderTxsResponse{Txs: txs}, nil
+}
+
+func (s mempoolQueryServer) MempoolCounts(_ context.Context, _ *MempoolCountsRequest) (*MempoolCountsResponse, error) {
+	inspectable, err := s.inspectable()
+	if err != nil {
+		return nil, err
+	}
+
+	counts := inspectable.SenderCounts()
+	resp := &MempoolCountsResponse{Senders: make([]*SenderCount, 0, len(counts))}
+	for sender, count := range counts {
+		resp.Total += uint64(count)
+		resp.Senders = append(resp.Senders, &SenderCount{Sender: sender, Count: uint64(count)})
+	}
+	slices.SortFunc(resp.Senders, func(a, b *SenderCount) int {
+		switch {
+		case a.Sender < b.Sender:
+			return -1
+		case a.Sender > b.Sender:
+			return 1
+		}
+		return 0
+	})
+
+	return resp, nil
+}
+
+func (s mempoolQueryServer) inspectable() (mempool.Inspectable, error) {
+	inspectable, ok := s.mempool.(mempool.Inspectable)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "mempool %T can't be inspected", s.mempool)
+	}
+
+	return inspectable, nil
+}
+
+func (s mempoolQueryServer) mempoolTxs(infos []mempool.TxInfo) ([]*MempoolTx, error) {
+	txs := make([]*MempoolTx, len(infos))
+	for i, info := range infos {
+		tx := &MempoolTx{
+			Sender:   info.Sender,
+			Nonce:    info.Nonce,
+			Priority: info.Priority,
+		}
+
+		if s.clientCtx.TxConfig != nil {
+			txBytes, err := s.clientCtx.TxConfig.TxEncoder()(info.Tx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to encode tx: %v", err)
+			}
+			tx.Hash = cmttypes.Tx(txBytes).Hash()
+		}
+
+		if feeTx, ok := info.Tx.(sdk.FeeTx); ok {
+			tx.GasWanted = feeTx.GetGas()
+			tx.Fee = feeTx.GetFee()
+		}
+
+		txs[i] = tx
+	}
+
+	return txs, nil
+}
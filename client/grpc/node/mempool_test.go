@@ -0,0 +1,109 @@
+package node
+
+import (
+	"fmt"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestMempoolQueryServer(t *testing.T) {
+	txConfig := moduletestutil.MakeTestEncodingConfig().TxConfig
+	clientCtx := client.Context{}.WithTxConfig(txConfig)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+
+	newTx := func(pub cryptotypes.PubKey, addr sdk.AccAddress, sequence uint64, fee int64) sdk.Tx {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(testdata.NewTestMsg(addr)))
+		builder.SetGasLimit(uint64(100000 + fee))
+		builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", fee)))
+		require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+			PubKey:   pub,
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+			Sequence: sequence,
+		}))
+		return builder.GetTx()
+	}
+
+	_, pubA, addrA := testdata.KeyTestPubAddr()
+	_, pubB, addrB := testdata.KeyTestPubAddr()
+	txs := []sdk.Tx{
+		newTx(pubA, addrA, 0, 10),
+		newTx(pubA, addrA, 1, 30),
+		newTx(pubB, addrB, 0, 20),
+	}
+
+	mp := mempool.DefaultPriorityMempool()
+	for i, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(int64(i)), tx))
+	}
+	svr := NewMempoolQueryServer(clientCtx, mp)
+
+	mempoolTx := func(i int, sender string, nonce uint64) *MempoolTx {
+		txBytes, err := txConfig.TxEncoder()(txs[i])
+		require.NoError(t, err)
+		feeTx := txs[i].(sdk.FeeTx)
+		return &MempoolTx{
+			Hash:      cmttypes.Tx(txBytes).Hash(),
+			Sender:    sender,
+			Nonce:     nonce,
+			Priority:  fmt.Sprint(i),
+			GasWanted: feeTx.GetGas(),
+			Fee:       feeTx.GetFee(),
+		}
+	}
+	all := []*MempoolTx{
+		mempoolTx(2, addrB.String(), 0),
+		mempoolTx(0, addrA.String(), 0),
+		mempoolTx(1, addrA.String(), 1),
+	}
+
+	resp, err := svr.MempoolTxs(ctx, &MempoolTxsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), resp.Total)
+	require.Equal(t, all, resp.Txs)
+
+	resp, err = svr.MempoolTxs(ctx, &MempoolTxsRequest{Offset: 1, Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), resp.Total)
+	require.Equal(t, all[1:2], resp.Txs)
+
+	resp, err = svr.MempoolTxs(ctx, &MempoolTxsRequest{Offset: 5})
+	require.NoError(t, err)
+	require.Empty(t, resp.Txs)
+
+	senderResp, err := svr.MempoolSenderTxs(ctx, &MempoolSenderTxsRequest{Sender: addrA.String()})
+	require.NoError(t, err)
+	require.Equal(t, all[1:], senderResp.Txs)
+
+	_, err = svr.MempoolSenderTxs(ctx, &MempoolSenderTxsRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	countsResp, err := svr.MempoolCounts(ctx, &MempoolCountsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), countsResp.Total)
+	require.Len(t, countsResp.Senders, 2)
+	require.Less(t, countsResp.Senders[0].Sender, countsResp.Senders[1].Sender)
+	for _, count := range countsResp.Senders {
+		require.Equal(t, map[string]uint64{addrA.String(): 2, addrB.String(): 1}[count.Sender], count.Count)
+	}
+
+	// a mempool which can't be inspected isn't supported
+	svr = NewMempoolQueryServer(clientCtx, mempool.NoOpMempool{})
+	_, err = svr.MempoolCounts(ctx, &MempoolCountsRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -16,10 +16,11 @@ func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server, cfg c
 	RegisterServiceServer(server, NewQueryServer(clientCtx, cfg))
 }
 
-// RegisterGRPCGatewayRoutes mounts the node gRPC service's GRPC-gateway routes
-// on the given mux object.
+// RegisterGRPCGatewayRoutes mounts the node and mempool gRPC services' GRPC-gateway
+// routes on the given mux object.
 func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
 	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+	_ = RegisterMempoolServiceHandlerClient(context.Background(), mux, NewMempoolServiceClient(clientConn))
 }
 
 var _ ServiceServer = queryServer{}
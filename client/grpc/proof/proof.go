@@ -0,0 +1,228 @@
+// Package proof verifies the proofs of the state read by the gRPC queries of a
+// node against a trusted app hash, so that a client, such as a wallet, doesn't
+// have to trust the node serving the queries.
+//
+// A query requests the proofs with the GRPCQueryProveHeader header, and the
+// node returns the proof of each key read by the query handler in the
+// GRPCQueryProofsTrailer trailer of the response:
+//
+//	var header, trailer metadata.MD
+//	res, err := bankClient.Balance(proof.WithProve(ctx), req, grpc.Header(&header), grpc.Trailer(&trailer))
+//	...
+//	proofs, err := proof.Verify(header, trailer, appHash)
+//
+// The app hash of the state at the height of the query, which is returned in
+// the GRPCBlockHeightHeader header, is committed in the header of the next
+// block, and must be obtained from a trusted source, such as a light client.
+//
+// The proofs only prove the values of the keys which the node reports as read
+// by the query, and the response itself isn't proven:
+//   - nothing ties the fields of the response to the proven values, the client
+//     must check them with CheckValue or CheckCollectionValue, from the keys it
+//     expects the query to read;
+//   - the keys read from an iterator are only proven to exist, nothing proves
+//     that no other key is in the range of the iterator, so the responses
+//     listing the entries of a range, such as the paginated queries, can omit
+//     some of them.
+//
+// Only the responses whose fields are checked against the verified values of
+// the keys they're made of can thus be trusted. A query reading more keys than
+// the node proves, see baseapp.MaxQueryProofs, fails when the proofs are
+// requested.
+package proof
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"google.golang.org/grpc/metadata"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// KVProof is the proof of a key read by a query, and of its value.
+type KVProof struct {
+	// StoreName is the name of the store of the key.
+	StoreName string
+	// Key is the key read.
+	Key []byte
+	// Value is the value of the key, which is nil if the key doesn't exist.
+	Value []byte
+	// Height is the height of the state the key was read from.
+	Height int64
+	// ProofOps proves the value of the key in its store, and the store in the
+	// app hash.
+	ProofOps *cmtcrypto.ProofOps
+}
+
+// WithProve returns a context requesting the proofs of the state read by the
+// queries made with it.
+func WithProve(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCQueryProveHeader, "true")
+}
+
+// Decode decodes the proofs in the trailer of a query response.
+func Decode(trailer metadata.MD) ([]KVProof, error) {
+	values := trailer.Get(grpctypes.GRPCQueryProofsTrailer)
+	proofs := make([]KVProof, 0, len(values))
+	for _, value := range values {
+		var res abci.ResponseQuery
+		if err := res.Unmarshal([]byte(value)); err != nil {
+			return nil, fmt.Errorf("failed to decode proof: %w", err)
+		}
+		if res.ProofOps == nil || len(res.ProofOps.Ops) == 0 {
+			return nil, fmt.Errorf("empty proof of key %X", res.Key)
+		}
+
+		// an empty value is decoded as nil, the proof of the key in its store
+		// tells whether it exists
+		value := res.Value
+		if value == nil {
+			op, err := storetypes.CommitmentOpDecoder(res.ProofOps.Ops[0])
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode proof of key %X: %w", res.Key, err)
+			}
+			if op.(storetypes.CommitmentOp).Proof.GetExist() != nil {
+				value = []byte{}
+			}
+		}
+
+		proofs = append(proofs, KVProof{
+			// the last op proves the store in the app hash
+			StoreName: string(res.ProofOps.Ops[len(res.ProofOps.Ops)-1].Key),
+			Key:       res.Key,
+			Value:     value,
+			Height:    res.Height,
+			ProofOps:  res.ProofOps,
+		})
+	}
+
+	return proofs, nil
+}
+
+// Verify verifies the proof against the app hash of the state at its height.
+func (p KVProof) Verify(appHash []byte) error {
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(p.StoreName), merkle.KeyEncodingURL).
+		AppendKey(p.Key, merkle.KeyEncodingHex).
+		String()
+
+	prt := rootmulti.DefaultProofRuntime()
+	if p.Value == nil {
+		return prt.VerifyAbsence(p.ProofOps, appHash, keyPath)
+	}
+	return prt.VerifyValue(p.ProofOps, appHash, keyPath, p.Value)
+}
+
+// Verify decodes the proofs in the trailer of a query response and verifies
+// them against the app hash of the state at the height of the query, read
+// from the header of the response. It returns the verified proofs, from which
+// the client must check the values in the response, see the package
+// documentation.
+func Verify(header, trailer metadata.MD, appHash []byte) ([]KVProof, error) {
+	heights := header.Get(grpctypes.GRPCBlockHeightHeader)
+	if len(heights) != 1 {
+		return nil, fmt.Errorf("expected one %s header, got %d", grpctypes.GRPCBlockHeightHeader, len(heights))
+	}
+	height, err := strconv.ParseInt(heights[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s header %q: %w", grpctypes.GRPCBlockHeightHeader, heights[0], err)
+	}
+
+	proofs, err := Decode(trailer)
+	if err != nil {
+		return nil, err
+	}
+	// a response without proofs can't be verified, they may have been removed
+	if len(proofs) == 0 {
+		return nil, errors.New("no proofs in the response trailer")
+	}
+
+	for _, p := range proofs {
+		if p.Height != height {
+			return nil, fmt.Errorf("proof of key %X of store %s at height %d, expected %d", p.Key, p.StoreName, p.Height, height)
+		}
+		if err := p.Verify(appHash); err != nil {
+			return nil, fmt.Errorf("failed to verify proof of key %X of store %s: %w", p.Key, p.StoreName, err)
+		}
+	}
+
+	return proofs, nil
+}
+
+// Lookup returns the verified value of a key of a store, which is nil if the
+// key is proven not to exist, and false if the key isn't proven.
+func Lookup(proofs []KVProof, storeName string, key []byte) ([]byte, bool) {
+	for _, p := range proofs {
+		if p.StoreName == storeName && bytes.Equal(p.Key, key) {
+			return p.Value, true
+		}
+	}
+	return nil, false
+}
+
+// CheckValue checks that the value of a key of a store, as encoded in the
+// store from a field of a response, is the verified one. A nil value checks
+// that the key is proven not to exist.
+func CheckValue(proofs []KVProof, storeName string, key, value []byte) error {
+	proven, ok := Lookup(proofs, storeName, key)
+	switch {
+	case !ok:
+		return fmt.Errorf("key %X of store %s isn't proven", key, storeName)
+	case value == nil && proven != nil:
+		return fmt.Errorf("key %X of store %s is proven to exist", key, storeName)
+	case value != nil && proven == nil:
+		return fmt.Errorf("key %X of store %s is proven not to exist", key, storeName)
+	case !bytes.Equal(value, proven):
+		return fmt.Errorf("value of key %X of store %s isn't the proven one", key, storeName)
+	}
+	return nil
+}
+
+// CheckCollectionValue checks that the value of a key of a collection, such as
+// a collections.Map, of a store, taken from a field of a response, is the
+// verified one. The value is compared in its encoding by the value codec, which
+// must be the one of the collection.
+func CheckCollectionValue[K, V any](
+	proofs []KVProof,
+	storeName string,
+	prefix []byte,
+	keyCodec collcodec.KeyCodec[K],
+	valueCodec collcodec.ValueCodec[V],
+	key K,
+	value V,
+) error {
+	encodedKey, err := collections.EncodeKeyWithPrefix(prefix, keyCodec, key)
+	if err != nil {
+		return err
+	}
+	encodedValue, err := valueCodec.Encode(value)
+	if err != nil {
+		return err
+	}
+	if encodedValue == nil {
+		encodedValue = []byte{}
+	}
+	return CheckValue(proofs, storeName, encodedKey, encodedValue)
+}
+
+// CheckCollectionAbsence checks that a key of a collection of a store, such
+// as the one of an entry missing from a response, is proven not to exist.
+func CheckCollectionAbsence[K any](proofs []KVProof, storeName string, prefix []byte, keyCodec collcodec.KeyCodec[K], key K) error {
+	encodedKey, err := collections.EncodeKeyWithPrefix(prefix, keyCodec, key)
+	if err != nil {
+		return err
+	}
+	return CheckValue(proofs, storeName, encodedKey, nil)
+}
@@ -0,0 +1,306 @@
+package proof_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/grpc/proof"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/auth/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// setupApp starts a gRPC server for an app with a funded account at height 2,
+// and returns a bank query client, the account and the app hash at height 2.
+// The options are applied to the app before registering its gRPC services.
+func setupApp(t *testing.T, opts ...func(*runtime.App)) (banktypes.QueryClient, sdk.AccAddress, []byte) {
+	t.Helper()
+
+	var (
+		interfaceRegistry codectypes.InterfaceRegistry
+		appBuilder        *runtime.AppBuilder
+		cdc               codec.Codec
+	)
+	err := depinject.Inject(
+		depinject.Configs(
+			testutil.AppConfig,
+			depinject.Supply(log.NewNopLogger()),
+		),
+		&interfaceRegistry, &appBuilder, &cdc)
+	require.NoError(t, err)
+
+	app := appBuilder.Build(dbm.NewMemDB(), nil)
+	require.NoError(t, app.Load(true))
+
+	valSet, err := sims.CreateRandomValidatorSet()
+	require.NoError(t, err)
+
+	privKey := taproot.GenPrivKey()
+	acc := authtypes.NewBaseAccount(privKey.PubKey().Address().Bytes(), privKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100000000))),
+	}
+	genesisState, err := sims.GenesisStateWithValSet(cdc, app.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+	stateBytes, err := cmtjson.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	_, err = app.InitChain(&abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: sims.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+
+	// proofs can't be queried at height 1
+	for range 2 {
+		_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{
+			Height:             app.LastBlockHeight() + 1,
+			NextValidatorsHash: valSet.Hash(),
+		})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+	}
+
+	for _, opt := range opts {
+		opt(app)
+	}
+
+	grpcSrv := grpc.NewServer(grpc.ForceServerCodec(codec.NewProtoCodec(interfaceRegistry).GRPCCodec()))
+	app.RegisterGRPCServer(grpcSrv)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = grpcSrv.Serve(listener) }()
+	t.Cleanup(grpcSrv.Stop)
+
+	conn, err := grpc.NewClient(
+		listener.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(interfaceRegistry).GRPCCodec())),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return banktypes.NewQueryClient(conn), acc.GetAddress(), app.LastCommitID().Hash
+}
+
+var balancesKeyCodec = collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)
+
+func balanceKey(t *testing.T, addr sdk.AccAddress, denom string) []byte {
+	t.Helper()
+
+	key, err := collections.EncodeKeyWithPrefix(banktypes.BalancesPrefix, balancesKeyCodec, collections.Join(addr, denom))
+	require.NoError(t, err)
+	return key
+}
+
+func findProof(proofs []proof.KVProof, storeName string, key []byte) (proof.KVProof, bool) {
+	for _, p := range proofs {
+		if p.StoreName == storeName && string(p.Key) == string(key) {
+			return p, true
+		}
+	}
+	return proof.KVProof{}, false
+}
+
+func checkBalance(proofs []proof.KVProof, addr sdk.AccAddress, denom string, amount math.Int) error {
+	return proof.CheckCollectionValue(
+		proofs, banktypes.StoreKey, banktypes.BalancesPrefix,
+		balancesKeyCodec, banktypes.BalanceValueCodec,
+		collections.Join(addr, denom), amount,
+	)
+}
+
+func TestVerify(t *testing.T) {
+	client, addr, appHash := setupApp(t)
+
+	var header, trailer metadata.MD
+	res, err := client.Balance(
+		proof.WithProve(context.Background()),
+		&banktypes.QueryBalanceRequest{Address: addr.String(), Denom: sdk.DefaultBondDenom},
+		grpc.Header(&header),
+		grpc.Trailer(&trailer),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{"2"}, header.Get(grpctypes.GRPCBlockHeightHeader))
+	require.Empty(t, header.Get(grpctypes.GRPCQueryProofsTrailer))
+
+	proofs, err := proof.Verify(header, trailer, appHash)
+	require.NoError(t, err)
+
+	// the proven value of the balance is the one of the response
+	require.NoError(t, checkBalance(proofs, addr, sdk.DefaultBondDenom, res.Balance.Amount))
+	require.Error(t, checkBalance(proofs, addr, sdk.DefaultBondDenom, res.Balance.Amount.AddRaw(1)))
+	value, ok := proof.Lookup(proofs, banktypes.StoreKey, balanceKey(t, addr, sdk.DefaultBondDenom))
+	require.True(t, ok)
+	amount, err := banktypes.BalanceValueCodec.Decode(value)
+	require.NoError(t, err)
+	require.Equal(t, res.Balance.Amount, amount)
+
+	// a key which was not read by the query is not proven
+	require.Error(t, checkBalance(proofs, addr, "other", math.ZeroInt()))
+	require.Error(t, proof.CheckValue(proofs, banktypes.StoreKey, balanceKey(t, addr, "other"), nil))
+
+	// the verification fails against another app hash
+	_, err = proof.Verify(header, trailer, []byte("invalid app hash"))
+	require.Error(t, err)
+
+	// the verification fails with a tampered value
+	p, ok := findProof(proofs, banktypes.StoreKey, balanceKey(t, addr, sdk.DefaultBondDenom))
+	require.True(t, ok)
+	p.Value = []byte("1")
+	require.Error(t, p.Verify(appHash))
+}
+
+func TestVerifyAbsence(t *testing.T) {
+	client, addr, appHash := setupApp(t)
+
+	var header, trailer metadata.MD
+	res, err := client.Balance(
+		proof.WithProve(context.Background()),
+		&banktypes.QueryBalanceRequest{Address: addr.String(), Denom: "absent"},
+		grpc.Header(&header),
+		grpc.Trailer(&trailer),
+	)
+	require.NoError(t, err)
+	require.True(t, res.Balance.IsZero())
+
+	proofs, err := proof.Verify(header, trailer, appHash)
+	require.NoError(t, err)
+
+	require.NoError(t, proof.CheckCollectionAbsence(
+		proofs, banktypes.StoreKey, banktypes.BalancesPrefix, balancesKeyCodec, collections.Join(addr, "absent"),
+	))
+	require.Error(t, checkBalance(proofs, addr, "absent", math.OneInt()))
+
+	p, ok := findProof(proofs, banktypes.StoreKey, balanceKey(t, addr, "absent"))
+	require.True(t, ok)
+	require.Nil(t, p.Value)
+
+	// the absence can't be verified as an existence
+	p.Value = []byte("1")
+	require.Error(t, p.Verify(appHash))
+}
+
+func TestVerifyWithoutProve(t *testing.T) {
+	client, addr, appHash := setupApp(t)
+
+	var header, trailer metadata.MD
+	_, err := client.Balance(
+		context.Background(),
+		&banktypes.QueryBalanceRequest{Address: addr.String(), Denom: sdk.DefaultBondDenom},
+		grpc.Header(&header),
+		grpc.Trailer(&trailer),
+	)
+	require.NoError(t, err)
+	require.Empty(t, trailer.Get(grpctypes.GRPCQueryProofsTrailer))
+
+	_, err = proof.Verify(header, trailer, appHash)
+	require.Error(t, err)
+}
+
+func TestVerifyQueryMultiStore(t *testing.T) {
+	// the query multi-store holds another balance than the committed state
+	qms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	var bankKey storetypes.StoreKey
+	client, addr, _ := setupApp(t, func(app *runtime.App) {
+		bankKey = app.UnsafeFindStoreKey(banktypes.StoreKey)
+		qms.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+		require.NoError(t, qms.LoadLatestVersion())
+		app.SetQueryMultiStore(qms)
+	})
+
+	amount := math.NewInt(42)
+	value, err := banktypes.BalanceValueCodec.Encode(amount)
+	require.NoError(t, err)
+	for range 2 {
+		qms.GetKVStore(bankKey).Set(balanceKey(t, addr, sdk.DefaultBondDenom), value)
+		qms.Commit()
+	}
+
+	var header, trailer metadata.MD
+	res, err := client.Balance(
+		proof.WithProve(context.Background()),
+		&banktypes.QueryBalanceRequest{Address: addr.String(), Denom: sdk.DefaultBondDenom},
+		grpc.Header(&header),
+		grpc.Trailer(&trailer),
+	)
+	require.NoError(t, err)
+	require.Equal(t, amount, res.Balance.Amount)
+
+	// the proofs are built from the query multi-store which served the query
+	proofs, err := proof.Verify(header, trailer, qms.LastCommitID().Hash)
+	require.NoError(t, err)
+	require.NoError(t, checkBalance(proofs, addr, sdk.DefaultBondDenom, res.Balance.Amount))
+}
+
+func TestVerifyMaxQueryProofs(t *testing.T) {
+	// the query multi-store holds more balances than the keys proven by a query
+	qms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	var bankKey storetypes.StoreKey
+	client, addr, _ := setupApp(t, func(app *runtime.App) {
+		bankKey = app.UnsafeFindStoreKey(banktypes.StoreKey)
+		qms.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+		require.NoError(t, qms.LoadLatestVersion())
+		app.SetQueryMultiStore(qms)
+	})
+
+	value, err := banktypes.BalanceValueCodec.Encode(math.OneInt())
+	require.NoError(t, err)
+	for range 2 {
+		for i := range baseapp.MaxQueryProofs + 1 {
+			qms.GetKVStore(bankKey).Set(balanceKey(t, addr, fmt.Sprintf("denom%d", i)), value)
+		}
+		qms.Commit()
+	}
+
+	req := &banktypes.QueryAllBalancesRequest{
+		Address:    addr.String(),
+		Pagination: &query.PageRequest{Limit: baseapp.MaxQueryProofs + 1},
+	}
+	res, err := client.AllBalances(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, res.Balances, baseapp.MaxQueryProofs+1)
+
+	// the query fails when its proofs are requested
+	_, err = client.AllBalances(proof.WithProve(context.Background()), req)
+	require.ErrorContains(t, err, fmt.Sprintf("query reads more than %d keys", baseapp.MaxQueryProofs))
+
+	// a page of the balances within the limit is proven
+	var header, trailer metadata.MD
+	req.Pagination.Limit = 10
+	_, err = client.AllBalances(proof.WithProve(context.Background()), req, grpc.Header(&header), grpc.Trailer(&trailer))
+	require.NoError(t, err)
+	proofs, err := proof.Verify(header, trailer, qms.LastCommitID().Hash)
+	require.NoError(t, err)
+	require.NotEmpty(t, proofs)
+}
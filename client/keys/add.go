@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -36,6 +37,7 @@ const (
 	flagIndex        = "index"
 	flagMultisig     = "multisig"
 	flagNoSort       = "nosort"
+	flagMuSig2       = "musig2"
 	flagHDPath       = "hd-path"
 	flagPubKeyBase64 = "pubkey-base64"
 	flagMnemonicSrc  = "source"
@@ -72,6 +74,13 @@ sorted by address, unless the flag --nosort is set.
 Example:
 
     keys add mymultisig --multisig "keyname1,keyname2,keyname3" --multisig-threshold 2
+
+Passing --musig2 instead aggregates the taproot keys given through --multisig into a MuSig2
+key. It is an n-of-n key with a regular P2TR address, whose transactions are signed with the
+"keys musig2" commands.
+Example:
+
+    keys add mymusig2 --multisig "keyname1,keyname2,keyname3" --musig2
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runAddCmdPrepare,
@@ -80,6 +89,7 @@ Example:
 	f.StringSlice(flagMultisig, nil, "List of key names stored in keyring to construct a public legacy multisig key")
 	f.Int(flagMultiSigThreshold, 1, "K out of N required signatures. For use in conjunction with --multisig")
 	f.Bool(flagNoSort, false, "Keys passed to --multisig are taken in the order they're supplied")
+	f.Bool(flagMuSig2, false, "Aggregate the taproot keys passed to --multisig into a MuSig2 key")
 	f.String(FlagPublicKey, "", "Parse a public key in JSON format and saves key info to <name> file.")
 	f.String(flagPubKeyBase64, "", "Parse a public key in base64 format and saves key info.")
 	f.BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
@@ -204,6 +214,20 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 				pks[i] = key
 			}
 
+			if useMuSig2, _ := cmd.Flags().GetBool(flagMuSig2); useMuSig2 {
+				pk, err := taproot.NewMuSig2PubKey(pks)
+				if err != nil {
+					return err
+				}
+
+				k, err := kb.SaveMultisig(name, pk)
+				if err != nil {
+					return err
+				}
+
+				return printCreate(cmd, k, false, "", outputFormat)
+			}
+
 			if noSort, _ := cmd.Flags().GetBool(flagNoSort); !noSort {
 				sort.Slice(pks, func(i, j int) bool {
 					return bytes.Compare(pks[i].Address(), pks[j].Address()) < 0
@@ -286,7 +310,7 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	// If we're using ledger, only thing we need is the path and the bech32 prefix.
 	if useLedger {
 		bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
-		k, err := kb.SaveLedgerKey(name, hd.Secp256k1, bech32PrefixAccAddr, coinType, account, index)
+		k, err := kb.SaveLedgerKey(name, algo, bech32PrefixAccAddr, coinType, account, index)
 		if err != nil {
 			return err
 		}
@@ -79,11 +79,11 @@ func Test_runAddCmdLedgerWithCustomCoinType(t *testing.T) {
 	pub, err := key1.GetPubKey()
 	require.NoError(t, err)
 	require.Equal(t,
-		"PubKeySecp256k1{03028F0D5A9FD41600191CDEFDEA05E77A68DFBCE286241C0190805B9346667D07}",
+		"PubKeyTaproot{025B8D9DE8AA4C81829751FE4ECF28AECEAAA938DFC741ACCE60401A8E2097A8C8}",
 		pub.String())
 
-	config.SetPurpose(44)
-	config.SetCoinType(118)
+	config.SetPurpose(sdk.Purpose)
+	config.SetCoinType(sdk.CoinType)
 	config.SetBech32PrefixForAccount(sdk.Bech32PrefixAccAddr, sdk.Bech32PrefixAccPub)
 	config.SetBech32PrefixForValidator(sdk.Bech32PrefixValAddr, sdk.Bech32PrefixValPub)
 	config.SetBech32PrefixForConsensusNode(sdk.Bech32PrefixConsAddr, sdk.Bech32PrefixConsPub)
@@ -131,7 +131,7 @@ func Test_runAddCmdLedger(t *testing.T) {
 	pub, err := key1.GetPubKey()
 	require.NoError(t, err)
 	require.Equal(t,
-		"PubKeySecp256k1{034FEF9CD7C4C63588D3B03FEB5281B9D232CBA34D6F3D71AEE59211FFBFE1FE87}",
+		"PubKeyTaproot{02B3362E4D8093F2F1DB5BD2656C85DF567E992A31D73C8AE4F8F4E2CD29EBC4F9}",
 		pub.String())
 }
 
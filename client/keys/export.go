@@ -3,6 +3,7 @@ package keys
 import (
 	"bufio"
 	"encoding/hex"
+	"errors"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -16,6 +17,9 @@ import (
 const (
 	flagUnarmoredHex = "unarmored-hex"
 	flagUnsafe       = "unsafe"
+	flagWIF          = "wif"
+	flagXPub         = "xpub"
+	flagDescriptor   = "descriptor"
 )
 
 // ExportKeyCommand exports private keys from the key store.
@@ -30,7 +34,13 @@ private key material is exported in an INSECURE fashion that is designed to
 allow users to import their keys in hot wallets. This feature is for advanced
 users only that are confident about how to handle private keys work and are
 FULLY AWARE OF THE RISKS. If you are unsure, you may want to do some research
-and export your keys in ASCII-armored encrypted format.`,
+and export your keys in ASCII-armored encrypted format. The same applies to the
+--wif flag, which exports the private key of a taproot key in Wallet Import Format
+and also requires --unsafe.
+
+The --xpub and --descriptor flags export the public data of a taproot key for
+Bitcoin wallets: the extended public key of its BIP-32 account, or its output
+descriptor.`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -40,10 +50,22 @@ and export your keys in ASCII-armored encrypted format.`,
 			buf := bufio.NewReader(clientCtx.Input)
 			unarmored, _ := cmd.Flags().GetBool(flagUnarmoredHex)
 			unsafe, _ := cmd.Flags().GetBool(flagUnsafe)
+			wif, _ := cmd.Flags().GetBool(flagWIF)
+			xpub, _ := cmd.Flags().GetBool(flagXPub)
+			descriptor, _ := cmd.Flags().GetBool(flagDescriptor)
+
+			if xpub || descriptor {
+				return exportBitcoinPublic(cmd, args[0], xpub, clientCtx.Keyring)
+			}
 
-			if unarmored && unsafe {
+			switch {
+			case unarmored && unsafe:
 				return exportUnsafeUnarmored(cmd, args[0], buf, clientCtx.Keyring)
-			} else if unarmored || unsafe {
+			case wif && unsafe:
+				return exportUnsafeWIF(cmd, args[0], buf, clientCtx.Keyring)
+			case wif:
+				return fmt.Errorf("the flags %s and %s must be used together", flagUnsafe, flagWIF)
+			case unarmored || unsafe:
 				return fmt.Errorf("the flags %s and %s must be used together", flagUnsafe, flagUnarmoredHex)
 			}
 
@@ -66,6 +88,10 @@ and export your keys in ASCII-armored encrypted format.`,
 	cmd.Flags().Bool(flagUnarmoredHex, false, "Export unarmored hex privkey. Requires --unsafe.")
 	cmd.Flags().Bool(flagUnsafe, false, "Enable unsafe operations. This flag must be switched on along with all unsafe operation-specific options.")
 	cmd.Flags().BoolP(flagYes, "y", false, "Skip confirmation prompt when export unarmored hex privkey")
+	cmd.Flags().Bool(flagWIF, false, "Export the taproot privkey in WIF format. Requires --unsafe.")
+	cmd.Flags().Bool(flagXPub, false, "Export the extended public key of the BIP-32 account of the key")
+	cmd.Flags().Bool(flagDescriptor, false, "Export the output descriptor of the key")
+	cmd.MarkFlagsMutuallyExclusive(flagUnarmoredHex, flagWIF, flagXPub, flagDescriptor)
 
 	return cmd
 }
@@ -90,6 +116,55 @@ func exportUnsafeUnarmored(cmd *cobra.Command, uid string, buf *bufio.Reader, kr
 	return nil
 }
 
+func exportUnsafeWIF(cmd *cobra.Command, uid string, buf *bufio.Reader, kr keyring.Keyring) error {
+	exporter, ok := kr.(keyring.BitcoinExporter)
+	if !ok {
+		return errors.New("the keyring does not support WIF keys")
+	}
+
+	// confirm export of the WIF privkey, unless -y is passed
+	if skip, _ := cmd.Flags().GetBool(flagYes); !skip {
+		if yes, err := input.GetConfirmation("WARNING: The private key will be exported in WIF format. USE AT YOUR OWN RISK. Continue?", buf, cmd.ErrOrStderr()); err != nil {
+			return err
+		} else if !yes {
+			return nil
+		}
+	}
+
+	wif, err := exporter.ExportWIF(uid)
+	if err != nil {
+		return err
+	}
+
+	cmd.Println(wif)
+
+	return nil
+}
+
+func exportBitcoinPublic(cmd *cobra.Command, uid string, xpub bool, kr keyring.Keyring) error {
+	exporter, ok := kr.(keyring.BitcoinExporter)
+	if !ok {
+		return errors.New("the keyring does not support extended keys")
+	}
+
+	var (
+		out string
+		err error
+	)
+	if xpub {
+		out, err = exporter.ExportXPub(uid)
+	} else {
+		out, err = exporter.ExportDescriptor(uid)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.Println(out)
+
+	return nil
+}
+
 // unsafeExporter is implemented by key stores that support unsafe export
 // of private keys' material.
 type unsafeExporter interface {
@@ -65,6 +65,39 @@ func Test_runExportCmd(t *testing.T) {
 			mustFail:              false,
 			expectedOutputContain: "84c9dcac18a66f5ebab545b2dc647e242330abb3baa7ff1f4ed6a63df7267892\n",
 		},
+		{
+			name:           "--wif must fail",
+			keyringBackend: keyring.BackendTest,
+			extraArgs:      []string{"--wif"},
+			mustFail:       true,
+		},
+		{
+			name:                  "--unsafe --wif --yes success",
+			keyringBackend:        keyring.BackendTest,
+			extraArgs:             []string{"--unsafe", "--wif", "--yes"},
+			mustFail:              false,
+			expectedOutputContain: "L1fqMEn2d1M1mcaje8zYgV5bJp8XnbhtZdmDEoUcDqzRseRUbJG2\n",
+		},
+		{
+			name:                  "--xpub success",
+			keyringBackend:        keyring.BackendTest,
+			extraArgs:             []string{"--xpub"},
+			mustFail:              false,
+			expectedOutputContain: "xpub",
+		},
+		{
+			name:                  "--descriptor success",
+			keyringBackend:        keyring.BackendTest,
+			extraArgs:             []string{"--descriptor"},
+			mustFail:              false,
+			expectedOutputContain: "tr([",
+		},
+		{
+			name:           "--xpub --unarmored-hex must fail",
+			keyringBackend: keyring.BackendTest,
+			extraArgs:      []string{"--xpub", "--unarmored-hex"},
+			mustFail:       true,
+		},
 		{
 			name:           "file keyring backend properly read password and user confirmation",
 			keyringBackend: keyring.BackendFile,
@@ -2,6 +2,7 @@ package keys
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"os"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
@@ -80,3 +82,122 @@ func ImportKeyHexCommand() *cobra.Command {
 	cmd.Flags().String(flags.FlagKeyType, string(hd.TaprootType), "private key signing algorithm kind")
 	return cmd
 }
+
+// ImportKeyWIFCommand imports WIF encoded private keys as taproot keys.
+func ImportKeyWIFCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-wif <name> [wif]",
+		Short: "Import a WIF private key into the local keybase",
+		Long:  "Import a private key in Wallet Import Format (WIF) into the local keybase as a taproot key.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			name := args[0]
+			if err := checkName(name); err != nil {
+				return err
+			}
+
+			importer, ok := clientCtx.Keyring.(keyring.BitcoinImporter)
+			if !ok {
+				return errors.New("the keyring does not support WIF keys")
+			}
+
+			wif, err := readSecretArg(clientCtx, args, "Enter WIF private key:")
+			if err != nil {
+				return err
+			}
+
+			return importer.ImportWIF(name, wif)
+		},
+	}
+}
+
+// ImportKeyXPrvCommand imports taproot keys derived from BIP-32 extended private keys.
+func ImportKeyXPrvCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-xprv <name> [xprv]",
+		Short: "Import a key derived from an extended private key into the local keybase",
+		Long: `Import the taproot key derived from a BIP-32 extended private key into the local keybase.
+
+An absolute --hd-path (m/...) is derived from a master key, a relative one (e.g. 0/5) from the key
+itself. By default, the first receive key is imported: m/86'/<coin_type>'/0'/0/0 for a master key,
+0/0 for an account key.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			name := args[0]
+			if err := checkName(name); err != nil {
+				return err
+			}
+
+			importer, ok := clientCtx.Keyring.(keyring.BitcoinImporter)
+			if !ok {
+				return errors.New("the keyring does not support extended keys")
+			}
+
+			xprv, err := readSecretArg(clientCtx, args, "Enter extended private key:")
+			if err != nil {
+				return err
+			}
+
+			hdPath, _ := cmd.Flags().GetString(flagHDPath)
+			return importer.ImportExtendedPrivKey(name, xprv, hdPath)
+		},
+	}
+	cmd.Flags().String(flagHDPath, "", "BIP-32 derivation path of the key, absolute or relative to the extended key")
+	return cmd
+}
+
+// ImportKeyXPubCommand stores watch-only taproot keys derived from BIP-32 extended public keys.
+func ImportKeyXPubCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-xpub <name> <xpub_or_descriptor>",
+		Short: "Store a watch-only key derived from an extended public key",
+		Long: `Store the taproot key derived at --index from an extended public key as an offline key.
+
+The extended public key is either an account xpub, whose receive keys xpub/0/<index> are derived,
+or a ranged output descriptor such as tr([fingerprint/86'/0'/0']xpub/0/*).`,
+		Example: fmt.Sprintf("%s keys import-xpub watch \"tr([73c5da0a/86'/0'/0']xpub.../0/*)\" --index 5", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			name := args[0]
+			if err := checkName(name); err != nil {
+				return err
+			}
+
+			importer, ok := clientCtx.Keyring.(keyring.BitcoinImporter)
+			if !ok {
+				return errors.New("the keyring does not support extended keys")
+			}
+
+			index, _ := cmd.Flags().GetUint32(flagIndex)
+			k, err := importer.SaveWatchOnlyKey(name, args[1], index)
+			if err != nil {
+				return err
+			}
+
+			return printCreate(cmd, k, false, "", clientCtx.OutputFormat)
+		},
+	}
+	cmd.Flags().Uint32(flagIndex, 0, "Index of the key in the range of the extended public key")
+	return cmd
+}
+
+// readSecretArg returns the optional second argument, or prompts for it.
+func readSecretArg(clientCtx client.Context, args []string, prompt string) (string, error) {
+	if len(args) == 2 {
+		return args[1], nil
+	}
+
+	return input.GetPassword(prompt, bufio.NewReader(clientCtx.Input))
+}
@@ -2,11 +2,13 @@ package keys
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -223,3 +225,101 @@ func Test_runImportCmdWithEmptyName(t *testing.T) {
 
 	require.ErrorContains(t, cmd.ExecuteContext(ctx), "the provided name is invalid or empty after trimming whitespace")
 }
+
+func Test_runImportBitcoinCmds(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	// BIP-86 test vectors of m/86'/0'/0'/0/0
+	const (
+		xprv      = "xprv9s21ZrQH143K3GJpoapnV8SFfukcVBSfeCficPSGfubmSFDxo1kuHnLisriDvSnRRuL2Qrg5ggqHKNVpxR86QEC8w35uxmGoggxtQTPvfUu"
+		xpub      = "xpub6BgBgsespWvERF3LHQu6CnqdvfEvtMcQjYrcRzx53QJjSxarj2afYWcLteoGVky7D3UKDP9QyrLprQ3VCECoY49yfdDEHGCtMMj92pReUsQ"
+		wif       = "KyRv5iFPHG7iB5E4CqvMzH3WFJVhbfYK4VY7XAedd9Ys69mEsPLQ"
+		outputKey = "a60869f0dbcf1dc659c9cecbaf8050135ea9e8cdc487053f1dc6880949dc684c"
+	)
+
+	testCases := []struct {
+		name        string
+		cmd         func() *cobra.Command
+		args        []string
+		stdInput    string
+		expectError bool
+	}{
+		{
+			name: "import wif",
+			cmd:  ImportKeyWIFCommand,
+			args: []string{wif},
+		},
+		{
+			name:     "read the wif from standard input",
+			cmd:      ImportKeyWIFCommand,
+			stdInput: wif,
+		},
+		{
+			name:        "invalid wif",
+			cmd:         ImportKeyWIFCommand,
+			args:        []string{"not-a-wif"},
+			expectError: true,
+		},
+		{
+			name: "import xprv",
+			cmd:  ImportKeyXPrvCommand,
+			args: []string{xprv},
+		},
+		{
+			name: "import xprv with a path",
+			cmd:  ImportKeyXPrvCommand,
+			args: []string{xprv, fmt.Sprintf("--%s=m/86'/0'/0'/0/0", flagHDPath)},
+		},
+		{
+			name:        "import xpub as xprv",
+			cmd:         ImportKeyXPrvCommand,
+			args:        []string{xpub},
+			expectError: true,
+		},
+		{
+			name: "watch-only xpub",
+			cmd:  ImportKeyXPubCommand,
+			args: []string{xpub},
+		},
+		{
+			name: "watch-only descriptor",
+			cmd:  ImportKeyXPubCommand,
+			args: []string{"tr([73c5da0a/86'/0'/0']" + xpub + "/0/*)", fmt.Sprintf("--%s=0", flagIndex)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := tc.cmd()
+			cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+			mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+
+			kbHome := t.TempDir()
+			kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil, cdc)
+			require.NoError(t, err)
+
+			clientCtx := client.Context{}.
+				WithKeyringDir(kbHome).
+				WithKeyring(kb).
+				WithInput(mockIn).
+				WithCodec(cdc).
+				WithOutputFormat(flags.OutputFormatJSON)
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+			mockIn.Reset(tc.stdInput)
+			cmd.SetArgs(append([]string{"keyname1"}, tc.args...))
+
+			err = cmd.ExecuteContext(ctx)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			k, err := kb.Key("keyname1")
+			require.NoError(t, err)
+			addr, err := k.GetAddress()
+			require.NoError(t, err)
+			require.Equal(t, outputKey, hex.EncodeToString(addr))
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package keys
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerr "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// SignMessageCommand signs an arbitrary message with a taproot key.
+func SignMessageCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "sign-message <name> <message>",
+		Short: "Sign a message with a taproot key",
+		Long: `Sign a message with the taproot key <name> and print the base64 encoded BIP-322
+simple signature, which proves the ownership of the key address.
+
+The signature is the one produced by Bitcoin wallets for the P2TR address of the key,
+and can be checked by "keys verify-message" or by any BIP-322 compatible tool.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			k, err := clientCtx.Keyring.Key(args[0])
+			if err != nil {
+				return err
+			}
+
+			pubKey, err := k.GetPubKey()
+			if err != nil {
+				return err
+			}
+
+			if _, ok := pubKey.(*taproot.PubKey); !ok {
+				return fmt.Errorf("%s keys can't sign BIP-322 messages", pubKey.Type())
+			}
+
+			// ledger devices sign the raw message with SIGN_MODE_TEXTUAL
+			sig, _, err := clientCtx.Keyring.Sign(args[0], []byte(args[1]), signing.SignMode_SIGN_MODE_TEXTUAL)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(base64.StdEncoding.EncodeToString(sig))
+			return nil
+		},
+	}
+}
+
+// VerifyMessageCommand verifies the signature of a message by a taproot address.
+func VerifyMessageCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify-message <name_or_address> <message> <signature>",
+		Short: "Verify the signature of a message by a taproot address",
+		Long: `Verify the base64 encoded BIP-322 signature of a message by the taproot address of
+the key <name>, or by <address> which doesn't need to be in the keyring, and print the
+format of the signature.
+
+Simple, full and legacy BIP-322 signatures are accepted, as produced by Bitcoin wallets.
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputKey, err := fetchOutputKey(clientCtx, args[0])
+			if err != nil {
+				return fmt.Errorf("%s is not a valid name or address: %w", args[0], err)
+			}
+
+			sig, err := base64.StdEncoding.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid signature encoding: %w", err)
+			}
+
+			if err := taproot.Bip322VerifyOutputKey([]byte(args[1]), sig, outputKey, taproot.GetBitcoinNetParams()); err != nil {
+				return fmt.Errorf("invalid signature: %w", err)
+			}
+
+			cmd.Printf("Signature verified (%s)\n", taproot.DetectBip322Format(sig))
+			return nil
+		},
+	}
+}
+
+// fetchOutputKey returns the x-only output key of a taproot key given by name
+// or by address.
+func fetchOutputKey(clientCtx client.Context, keyref string) ([]byte, error) {
+	var addr []byte
+
+	k, err := clientCtx.Keyring.Key(keyref)
+	switch {
+	case err == nil:
+		addr, err = k.GetAddress()
+		if err != nil {
+			return nil, err
+		}
+
+	case errorsmod.IsOf(err, sdkerr.ErrIO, sdkerr.ErrKeyNotFound):
+		addr, err = sdk.AccAddressFromBech32(keyref)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, err
+	}
+
+	if len(addr) != 32 {
+		return nil, errors.New("not a taproot address")
+	}
+
+	return addr, nil
+}
@@ -0,0 +1,76 @@
+package keys
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func Test_signVerifyMessageCmd(t *testing.T) {
+	kbHome := t.TempDir()
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil, cdc)
+	require.NoError(t, err)
+
+	path := sdk.GetConfig().GetFullBIP44Path()
+	k, err := kb.NewAccount("signer", testdata.TestMnemonic, "", path, hd.Taproot)
+	require.NoError(t, err)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+	_, err = kb.SaveOfflineKey("secp", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithCodec(cdc)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	run := func(cmd *cobra.Command, args ...string) (string, error) {
+		cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetErr(out)
+		cmd.SetArgs(append(args,
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		))
+		err := cmd.ExecuteContext(ctx)
+		return strings.TrimSpace(out.String()), err
+	}
+
+	sig, err := run(SignMessageCommand(), "signer", "hello")
+	require.NoError(t, err)
+
+	// the signature is verified by name and by address
+	for _, keyref := range []string{"signer", addr.String()} {
+		out, err := run(VerifyMessageCommand(), keyref, "hello", sig)
+		require.NoError(t, err)
+		require.Equal(t, "Signature verified (simple)", out)
+	}
+
+	_, err = run(VerifyMessageCommand(), "signer", "hello!", sig)
+	require.ErrorContains(t, err, "invalid signature")
+
+	_, err = run(VerifyMessageCommand(), "signer", "hello", "not base64")
+	require.ErrorContains(t, err, "invalid signature encoding")
+
+	_, err = run(VerifyMessageCommand(), "secp", "hello", sig)
+	require.ErrorContains(t, err, "not a taproot address")
+
+	_, err = run(SignMessageCommand(), "secp", "hello")
+	require.ErrorContains(t, err, "keys can't sign BIP-322 messages")
+}
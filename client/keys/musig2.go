@@ -0,0 +1,233 @@
+package keys
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
+)
+
+const (
+	flagBip322      = "bip322"
+	flagNonces      = "nonces"
+	flagPartialSigs = "partial-sigs"
+)
+
+// MuSig2Command returns the commands running the signing sessions of MuSig2 keys.
+func MuSig2Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "musig2",
+		Short: "Jointly sign a message with a MuSig2 key",
+		Long: `Jointly sign a message with a MuSig2 key created with "keys add --musig2".
+
+A MuSig2 key only signs once all of its co-signers took part in a two-round session,
+which can be run offline by exchanging hex encoded values:
+
+1. Each co-signer runs "musig2 nonce" and shares the resulting public nonce.
+2. Each co-signer runs "musig2 partial-sign" with the public nonces of all co-signers
+   and shares the resulting partial signature.
+3. Anyone runs "musig2 combine" with all public nonces and partial signatures, which
+   outputs a single signature verifying against the MuSig2 key.
+
+Public nonces and partial signatures are always listed in the order of the co-signer
+keys of the MuSig2 key, as shown by "keys show". The message is read from a file, e.g.
+the sign bytes of a transaction. By default the co-signers produce a BIP-340 Schnorr
+signature; pass --bip322 to all three commands to produce a BIP-322 simple signature.
+`,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		muSig2NonceCommand(),
+		muSig2PartialSignCommand(),
+		muSig2CombineCommand(),
+	)
+
+	return cmd
+}
+
+func muSig2NonceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nonce <name> <musig2-key> <message-file>",
+		Short: "Start a signing session and print the public nonce of a co-signer",
+		Long: `Start a session signing the message with the local key <name> on behalf of the
+MuSig2 key <musig2-key>, and print the public nonce to share with the other co-signers.
+
+The matching secret nonce is stored in the keyring until "musig2 partial-sign" uses it.
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer, pubKey, msg, format, err := parseMuSig2Session(cmd, clientCtx, args[1], args[2])
+			if err != nil {
+				return err
+			}
+
+			pubNonce, err := signer.MuSig2Nonce(args[0], pubKey, msg, format)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(hex.EncodeToString(pubNonce))
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagBip322, false, "Sign a BIP-322 simple signature instead of a BIP-340 Schnorr signature")
+
+	return cmd
+}
+
+func muSig2PartialSignCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "partial-sign <name> <musig2-key> <message-file> <nonce>...",
+		Short: "Print the partial signature of a co-signer",
+		Long: `Sign the message with the local key <name> on behalf of the MuSig2 key <musig2-key>,
+given the public nonces of all co-signers, and print the partial signature to share.
+
+The secret nonce of the session is deleted from the keyring, so each session can only
+be signed once.
+`,
+		Args: cobra.MinimumNArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer, pubKey, msg, format, err := parseMuSig2Session(cmd, clientCtx, args[1], args[2])
+			if err != nil {
+				return err
+			}
+
+			pubNonces, err := decodeHexList(args[3:])
+			if err != nil {
+				return fmt.Errorf("invalid nonce: %w", err)
+			}
+
+			partialSig, err := signer.MuSig2PartialSign(args[0], pubKey, msg, format, pubNonces)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(hex.EncodeToString(partialSig))
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagBip322, false, "Sign a BIP-322 simple signature instead of a BIP-340 Schnorr signature")
+
+	return cmd
+}
+
+func muSig2CombineCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "combine <musig2-key> <message-file>",
+		Short: "Combine the partial signatures of all co-signers",
+		Long: `Check the partial signatures of all co-signers of the MuSig2 key <musig2-key> and
+combine them into the hex encoded signature of the message.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, pubKey, msg, format, err := parseMuSig2Session(cmd, clientCtx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			nonces, _ := cmd.Flags().GetStringSlice(flagNonces)
+			pubNonces, err := decodeHexList(nonces)
+			if err != nil {
+				return fmt.Errorf("invalid nonce: %w", err)
+			}
+
+			sigs, _ := cmd.Flags().GetStringSlice(flagPartialSigs)
+			partialSigs, err := decodeHexList(sigs)
+			if err != nil {
+				return fmt.Errorf("invalid partial signature: %w", err)
+			}
+
+			sig, err := pubKey.CombineSignatures(msg, format, pubNonces, partialSigs)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(hex.EncodeToString(sig))
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagBip322, false, "Combine a BIP-322 simple signature instead of a BIP-340 Schnorr signature")
+	cmd.Flags().StringSlice(flagNonces, nil, "Public nonces of all co-signers, in the order of the MuSig2 key")
+	cmd.Flags().StringSlice(flagPartialSigs, nil, "Partial signatures of all co-signers, in the order of the MuSig2 key")
+	_ = cmd.MarkFlagRequired(flagNonces)
+	_ = cmd.MarkFlagRequired(flagPartialSigs)
+
+	return cmd
+}
+
+// parseMuSig2Session resolves the MuSig2 key, the message and the signature
+// format shared by all musig2 commands.
+func parseMuSig2Session(
+	cmd *cobra.Command, clientCtx client.Context, keyName, msgFile string,
+) (keyring.MuSig2Signer, *taproot.MuSig2PubKey, []byte, taproot.MuSig2SignatureFormat, error) {
+	signer, ok := clientCtx.Keyring.(keyring.MuSig2Signer)
+	if !ok {
+		return nil, nil, nil, 0, errors.New("the keyring does not support MuSig2 signing")
+	}
+
+	k, err := clientCtx.Keyring.Key(keyName)
+	if err != nil {
+		return nil, nil, nil, 0, err
+	}
+
+	pk, err := k.GetPubKey()
+	if err != nil {
+		return nil, nil, nil, 0, err
+	}
+
+	pubKey, ok := pk.(*taproot.MuSig2PubKey)
+	if !ok {
+		return nil, nil, nil, 0, fmt.Errorf("%s is not a MuSig2 key", keyName)
+	}
+
+	msg, err := os.ReadFile(msgFile)
+	if err != nil {
+		return nil, nil, nil, 0, err
+	}
+
+	format := taproot.MuSig2Schnorr
+	if bip322, _ := cmd.Flags().GetBool(flagBip322); bip322 {
+		format = taproot.MuSig2BIP322
+	}
+
+	return signer, pubKey, msg, format, nil
+}
+
+func decodeHexList(values []string) ([][]byte, error) {
+	res := make([][]byte, len(values))
+	for i, v := range values {
+		bz, err := hex.DecodeString(v)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = bz
+	}
+
+	return res, nil
+}
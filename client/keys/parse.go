@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 )
@@ -30,18 +31,30 @@ func bech32Prefixes(config *sdk.Config) []string {
 type hexOutput struct {
 	Human string `json:"human"`
 	Bytes string `json:"bytes"`
+
+	// @nubit: the other encodings of the parsed address, so that parse
+	// converts between its bech32 and taproot encodings.
+	Formats []string `json:"formats,omitempty"`
+	Taproot string   `json:"taproot,omitempty"`
 }
 
 func (ho hexOutput) String() string {
-	return fmt.Sprintf("Human readable part: %v\nBytes (hex): %s", ho.Human, ho.Bytes)
+	out := fmt.Sprintf("Human readable part: %v\nBytes (hex): %s", ho.Human, ho.Bytes)
+	if len(ho.Formats) > 0 {
+		out = fmt.Sprintf("%s\n%s", out, bech32Output{Formats: ho.Formats, Taproot: ho.Taproot})
+	}
+
+	return out
 }
 
-func newHexOutput(human string, bs []byte) hexOutput {
-	return hexOutput{Human: human, Bytes: fmt.Sprintf("%X", bs)}
+func newHexOutput(config *sdk.Config, human string, bs []byte) hexOutput {
+	formats := newBech32Output(config, bs)
+	return hexOutput{Human: human, Bytes: fmt.Sprintf("%X", bs), Formats: formats.Formats, Taproot: formats.Taproot}
 }
 
 type bech32Output struct {
 	Formats []string `json:"formats"`
+	Taproot string   `json:"taproot,omitempty"`
 }
 
 func newBech32Output(config *sdk.Config, bs []byte) bech32Output {
@@ -57,6 +70,11 @@ func newBech32Output(config *sdk.Config, bs []byte) bech32Output {
 		out.Formats[i] = bech32Addr
 	}
 
+	// only 20-byte and 32-byte addresses have a SegWit encoding
+	if taprootAddr, err := taproot.EncodeSegwitAddress(bs, config.GetBitcoinNetParams()); err == nil {
+		out.Taproot = taprootAddr
+	}
+
 	return out
 }
 
@@ -67,16 +85,22 @@ func (bo bech32Output) String() string {
 		out[i] = fmt.Sprintf("  - %s", format)
 	}
 
-	return fmt.Sprintf("Bech32 Formats:\n%s", strings.Join(out, "\n"))
+	res := fmt.Sprintf("Bech32 Formats:\n%s", strings.Join(out, "\n"))
+	if bo.Taproot != "" {
+		res = fmt.Sprintf("%s\nTaproot Format: %s", res, bo.Taproot)
+	}
+
+	return res
 }
 
-// ParseKeyStringCommand parses an address from hex to bech32 and vice versa.
+// ParseKeyStringCommand parses an address from hex to bech32 or taproot and vice versa.
 func ParseKeyStringCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "parse <hex-or-bech32-address>",
-		Short: "Parse address from hex to bech32 and vice versa",
+		Use:   "parse <hex-bech32-or-taproot-address>",
+		Short: "Parse address from hex to bech32 or taproot and vice versa",
 		Long: `Convert and print to stdout key addresses and fingerprints from
-hexadecimal into bech32 cosmos prefixed format and vice versa.
+hexadecimal into bech32 cosmos prefixed format and taproot format, and vice versa.
+A bech32 or taproot address is also converted into the other encodings of its bytes.
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: parseKey,
@@ -99,21 +123,35 @@ func doParseKey(cmd *cobra.Command, config *sdk.Config, args []string) error {
 	}
 
 	output, _ := cmd.Flags().GetString(flags.FlagOutput)
-	if !runFromBech32(outstream, addr, output) && !runFromHex(config, outstream, addr, output) {
-		return errors.New("couldn't find valid bech32 nor hex data")
+	if !runFromTaproot(config, outstream, addr, output) && !runFromBech32(config, outstream, addr, output) &&
+		!runFromHex(config, outstream, addr, output) {
+		return errors.New("couldn't find valid bech32, taproot nor hex data")
 	}
 
 	return nil
 }
 
+// print info from taproot
+func runFromTaproot(config *sdk.Config, w io.Writer, taprootStr, output string) bool {
+	net := config.GetBitcoinNetParams()
+	bz, err := taproot.DecodeSegwitAddress(taprootStr, net)
+	if err != nil {
+		return false
+	}
+
+	displayParseKeyInfo(w, newHexOutput(config, net.Bech32HRPSegwit, bz), output)
+
+	return true
+}
+
 // print info from bech32
-func runFromBech32(w io.Writer, bech32str, output string) bool {
+func runFromBech32(config *sdk.Config, w io.Writer, bech32str, output string) bool {
 	hrp, bz, err := bech32.DecodeAndConvert(bech32str)
 	if err != nil {
 		return false
 	}
 
-	displayParseKeyInfo(w, newHexOutput(hrp, bz), output)
+	displayParseKeyInfo(w, newHexOutput(config, hrp, bz), output)
 
 	return true
 }
@@ -1,16 +1,20 @@
 package keys
 
 import (
+	"bytes"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParseKey(t *testing.T) {
 	bech32str := "cosmos104ytdpvrx9284zd50v9ep8c6j7pua7dkk0x3ek"
 	hexstr := "EB5AE9872103497EC092EF901027049E4F39200C60040D3562CD7F104A39F62E6E5A39A818F4"
+	taprootstr := "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0"
 
 	config := sdk.NewConfig()
 
@@ -23,6 +27,7 @@ func TestParseKey(t *testing.T) {
 		{"invalid input", []string{"invalid"}, true},
 		{"bech32", []string{bech32str}, false},
 		{"hex", []string{hexstr}, false},
+		{"taproot", []string{taprootstr}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -30,3 +35,38 @@ func TestParseKey(t *testing.T) {
 		})
 	}
 }
+
+func TestParseKeyConvertsEncodings(t *testing.T) {
+	taprootstr := "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0"
+	bech32str := "cosmos10xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqvlhp3r"
+	hexstr := "79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798"
+
+	config := sdk.NewConfig()
+	config.SetBech32PrefixForAccount("cosmos", "cosmospub")
+
+	parse := func(addr string) hexOutput {
+		cmd := ParseKeyStringCommand()
+		cmd.Flags().String(flags.FlagOutput, flags.OutputFormatJSON, "")
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+
+		require.NoError(t, doParseKey(cmd, config, []string{addr}))
+
+		var res hexOutput
+		require.NoError(t, json.Unmarshal(out.Bytes(), &res))
+		return res
+	}
+
+	// a taproot address is converted to its bech32 encodings
+	res := parse(taprootstr)
+	require.Equal(t, "bc", res.Human)
+	require.Equal(t, hexstr, res.Bytes)
+	require.Equal(t, taprootstr, res.Taproot)
+	require.Contains(t, res.Formats, bech32str)
+
+	// and a bech32 address to its taproot encoding
+	res = parse(bech32str)
+	require.Equal(t, "cosmos", res.Human)
+	require.Equal(t, hexstr, res.Bytes)
+	require.Equal(t, taprootstr, res.Taproot)
+}
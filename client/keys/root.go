@@ -42,6 +42,10 @@ The pass backend requires GnuPG: https://gnupg.org/
 		ExportKeyCommand(),
 		ImportKeyCommand(),
 		ImportKeyHexCommand(),
+		ImportKeyWIFCommand(),
+		ImportKeyXPrvCommand(),
+		ImportKeyXPubCommand(),
+		ScanXPubCommand(),
 		ListKeysCmd(),
 		ListKeyTypesCmd(),
 		ShowKeysCmd(),
@@ -49,6 +53,9 @@ The pass backend requires GnuPG: https://gnupg.org/
 		RenameKeyCommand(),
 		ParseKeyStringCommand(),
 		MigrateCommand(),
+		MuSig2Command(),
+		SignMessageCommand(),
+		VerifyMessageCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagOutput, "text", "Output format (text|json)")
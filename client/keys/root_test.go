@@ -11,5 +11,5 @@ func TestCommands(t *testing.T) {
 	assert.Assert(t, rootCommands != nil)
 
 	// Commands are registered
-	assert.Equal(t, 12, len(rootCommands.Commands()))
+	assert.Equal(t, 19, len(rootCommands.Commands()))
 }
@@ -0,0 +1,181 @@
+package keys
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	flagGapLimit   = "gap-limit"
+	flagSavePrefix = "save-prefix"
+
+	// DefaultGapLimit is the number of consecutive unused addresses after which
+	// Bitcoin wallets stop scanning an account.
+	DefaultGapLimit = 20
+)
+
+// AddressUsageFn returns whether an address was used on chain and its balances.
+type AddressUsageFn func(ctx context.Context, addr sdk.AccAddress) (used bool, balances sdk.Coins, err error)
+
+// QueryAddressUsage returns an AddressUsageFn querying x/auth and x/bank: an
+// address is used if it has an account or a balance.
+func QueryAddressUsage(clientCtx client.Context) AddressUsageFn {
+	authClient := authtypes.NewQueryClient(clientCtx)
+	bankClient := banktypes.NewQueryClient(clientCtx)
+
+	return func(ctx context.Context, addr sdk.AccAddress) (bool, sdk.Coins, error) {
+		used := true
+		_, err := authClient.Account(ctx, &authtypes.QueryAccountRequest{Address: addr.String()})
+		if status.Code(err) == codes.NotFound {
+			used = false
+		} else if err != nil {
+			return false, nil, err
+		}
+
+		res, err := bankClient.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{Address: addr.String()})
+		if err != nil {
+			return false, nil, err
+		}
+
+		return used || !res.Balances.IsZero(), res.Balances, nil
+	}
+}
+
+// XPubAddress is a taproot address derived from an extended public key.
+type XPubAddress struct {
+	Index    uint32    `json:"index"`
+	Address  string    `json:"address"`
+	Balances sdk.Coins `json:"balances"`
+}
+
+// XPubScan is the result of the scan of an extended public key.
+type XPubScan struct {
+	// Used are the used addresses, by increasing index.
+	Used []XPubAddress `json:"used"`
+	// NextIndex is the index following the last used address, the next deposit
+	// address of the account.
+	NextIndex uint32 `json:"next_index"`
+}
+
+// ScanXPub derives the BIP-86 receive addresses of an extended public key,
+// given as an account xpub or a ranged descriptor, from index 0 and returns
+// the used ones. The scan stops after gapLimit consecutive unused addresses.
+func ScanXPub(ctx context.Context, xpub string, gapLimit uint32, usage AddressUsageFn) (*XPubScan, error) {
+	if gapLimit == 0 {
+		return nil, errors.New("gap limit must be positive")
+	}
+
+	scan := &XPubScan{Used: []XPubAddress{}}
+	for index, gap := uint32(0), uint32(0); gap < gapLimit && index <= math.MaxInt32; index++ {
+		pubKey, _, err := keyring.DeriveWatchOnlyKey(xpub, index)
+		if err != nil {
+			return nil, err
+		}
+
+		addr := sdk.AccAddress(pubKey.Address())
+		used, balances, err := usage(ctx, addr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query address %s: %w", addr, err)
+		}
+
+		if !used {
+			gap++
+			continue
+		}
+
+		gap = 0
+		scan.Used = append(scan.Used, XPubAddress{Index: index, Address: addr.String(), Balances: balances})
+		scan.NextIndex = index + 1
+	}
+
+	return scan, nil
+}
+
+// ScanXPubCommand scans the used addresses of an extended public key.
+func ScanXPubCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scan-xpub <xpub_or_descriptor>",
+		Short: "Find the used addresses of an extended public key",
+		Long: `Derive the BIP-86 receive addresses of an extended public key, from index 0, and query
+the chain for the used ones: the addresses with an account or a balance. As in Bitcoin wallets,
+the scan stops after --gap-limit consecutive unused addresses.
+
+The extended public key is either an account xpub, whose receive keys xpub/0/<index> are derived,
+or a ranged output descriptor such as tr([fingerprint/86'/0'/0']xpub/0/*).
+
+With --save-prefix, the used addresses are stored as offline keys named <prefix>-<index>, as
+"keys import-xpub" does for a single index.`,
+		Example: fmt.Sprintf("%s keys scan-xpub xpub... --gap-limit 50 --save-prefix deposit", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			gapLimit, _ := cmd.Flags().GetUint32(flagGapLimit)
+			scan, err := ScanXPub(cmd.Context(), args[0], gapLimit, QueryAddressUsage(clientCtx))
+			if err != nil {
+				return err
+			}
+
+			if prefix, _ := cmd.Flags().GetString(flagSavePrefix); prefix != "" {
+				if err := saveXPubAddresses(clientCtx.Keyring, prefix, args[0], scan.Used); err != nil {
+					return err
+				}
+			}
+
+			out, err := json.Marshal(scan)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint32(flagGapLimit, DefaultGapLimit, "Number of consecutive unused addresses ending the scan")
+	cmd.Flags().String(flagSavePrefix, "", "Store the used addresses as offline keys named <prefix>-<index>")
+
+	return cmd
+}
+
+// saveXPubAddresses stores the addresses as offline keys, skipping the ones
+// already in the keyring so that a scan can be run again.
+func saveXPubAddresses(kr keyring.Keyring, prefix, xpub string, addrs []XPubAddress) error {
+	importer, ok := kr.(keyring.BitcoinImporter)
+	if !ok {
+		return errors.New("the keyring does not support extended keys")
+	}
+
+	for _, addr := range addrs {
+		name := fmt.Sprintf("%s-%d", prefix, addr.Index)
+		if k, err := kr.Key(name); err == nil {
+			if stored, err := k.GetAddress(); err == nil && stored.String() == addr.Address {
+				continue
+			}
+		}
+
+		if _, err := importer.SaveWatchOnlyKey(name, xpub, addr.Index); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
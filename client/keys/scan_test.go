@@ -0,0 +1,108 @@
+package keys
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// BIP-86 test vector of the account m/86'/0'/0'
+const scanXPub = "xpub6BgBgsespWvERF3LHQu6CnqdvfEvtMcQjYrcRzx53QJjSxarj2afYWcLteoGVky7D3UKDP9QyrLprQ3VCECoY49yfdDEHGCtMMj92pReUsQ"
+
+// usedIndexes returns an AddressUsageFn for which the addresses at indexes are used.
+func usedIndexes(t *testing.T, indexes ...uint32) AddressUsageFn {
+	t.Helper()
+
+	used := make(map[string]bool, len(indexes))
+	for _, index := range indexes {
+		pubKey, _, err := keyring.DeriveWatchOnlyKey(scanXPub, index)
+		require.NoError(t, err)
+		used[sdk.AccAddress(pubKey.Address()).String()] = true
+	}
+
+	return func(_ context.Context, addr sdk.AccAddress) (bool, sdk.Coins, error) {
+		if !used[addr.String()] {
+			return false, nil, nil
+		}
+		return true, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), nil
+	}
+}
+
+func TestScanXPub(t *testing.T) {
+	testCases := []struct {
+		name      string
+		used      []uint32
+		gapLimit  uint32
+		expUsed   []uint32
+		nextIndex uint32
+	}{
+		{"no used address", nil, 20, nil, 0},
+		{"first address", []uint32{0}, 20, []uint32{0}, 1},
+		{"gap below the limit", []uint32{0, 3, 23}, 20, []uint32{0, 3, 23}, 24},
+		{"gap above the limit", []uint32{0, 3, 24}, 20, []uint32{0, 3}, 4},
+		{"small gap limit", []uint32{1, 2, 4}, 1, nil, 0},
+		{"gap limit of two", []uint32{1, 2, 4}, 2, []uint32{1, 2, 4}, 5},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scan, err := ScanXPub(context.Background(), scanXPub, tc.gapLimit, usedIndexes(t, tc.used...))
+			require.NoError(t, err)
+			require.Equal(t, tc.nextIndex, scan.NextIndex)
+			require.Len(t, scan.Used, len(tc.expUsed))
+			for i, index := range tc.expUsed {
+				require.Equal(t, index, scan.Used[i].Index)
+				require.Equal(t, "10stake", scan.Used[i].Balances.String())
+			}
+		})
+	}
+
+	// the first address is the one of the BIP-86 test vector
+	scan, err := ScanXPub(context.Background(), scanXPub, 1, usedIndexes(t, 0))
+	require.NoError(t, err)
+	addr, err := sdk.AccAddressFromBech32(scan.Used[0].Address)
+	require.NoError(t, err)
+	require.Equal(t, "a60869f0dbcf1dc659c9cecbaf8050135ea9e8cdc487053f1dc6880949dc684c", hex.EncodeToString(addr))
+
+	_, err = ScanXPub(context.Background(), scanXPub, 0, usedIndexes(t))
+	require.Error(t, err)
+	_, err = ScanXPub(context.Background(), "xpub", 20, usedIndexes(t))
+	require.Error(t, err)
+
+	failing := func(context.Context, sdk.AccAddress) (bool, sdk.Coins, error) {
+		return false, nil, errors.New("node unavailable")
+	}
+	_, err = ScanXPub(context.Background(), scanXPub, 20, failing)
+	require.ErrorContains(t, err, "node unavailable")
+}
+
+func TestSaveXPubAddresses(t *testing.T) {
+	kr := keyring.NewInMemory(moduletestutil.MakeTestEncodingConfig().Codec)
+
+	scan, err := ScanXPub(context.Background(), scanXPub, 5, usedIndexes(t, 0, 2))
+	require.NoError(t, err)
+	require.NoError(t, saveXPubAddresses(kr, "deposit", scanXPub, scan.Used))
+
+	for _, addr := range scan.Used {
+		k, err := kr.KeyByAddress(sdk.MustAccAddressFromBech32(addr.Address))
+		require.NoError(t, err)
+		require.Equal(t, keyring.TypeOffline, k.GetType())
+	}
+	_, err = kr.Key("deposit-2")
+	require.NoError(t, err)
+
+	// scanning again only stores the new addresses
+	scan, err = ScanXPub(context.Background(), scanXPub, 5, usedIndexes(t, 0, 2, 3))
+	require.NoError(t, err)
+	require.NoError(t, saveXPubAddresses(kr, "deposit", scanXPub, scan.Used))
+	records, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+}
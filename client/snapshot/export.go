@@ -35,9 +35,25 @@ func ExportSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 				height = app.CommitMultiStore().LastCommitID().Version
 			}
 
-			cmd.Printf("Exporting snapshot for height %d\n", height)
+			delta, err := cmd.Flags().GetBool("delta")
+			if err != nil {
+				return err
+			}
 
 			sm := app.SnapshotManager()
+			if delta {
+				cmd.Printf("Exporting delta snapshot for height %d\n", height)
+				snapshot, err := sm.CreateDelta(uint64(height))
+				if err != nil {
+					return err
+				}
+
+				cmd.Printf("Delta snapshot created at height %d, format %d, chunks %d, base height %d\n",
+					snapshot.Height, snapshot.Format, snapshot.Chunks, snapshot.Metadata.BaseHeight)
+				return nil
+			}
+
+			cmd.Printf("Exporting snapshot for height %d\n", height)
 			snapshot, err := sm.Create(uint64(height))
 			if err != nil {
 				return err
@@ -49,6 +65,7 @@ func ExportSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 	}
 
 	cmd.Flags().Int64("height", 0, "Height to export, default to latest state height")
+	cmd.Flags().Bool("delta", false, "Export a delta snapshot on top of the latest snapshot")
 
 	return cmd
 }
@@ -23,6 +23,10 @@ var ListSnapshotsCmd = &cobra.Command{
 			return fmt.Errorf("failed to list snapshots: %w", err)
 		}
 		for _, snapshot := range snapshots {
+			if snapshot.IsDelta() {
+				cmd.Println("height:", snapshot.Height, "format:", snapshot.Format, "chunks:", snapshot.Chunks, "base:", snapshot.Metadata.BaseHeight)
+				continue
+			}
 			cmd.Println("height:", snapshot.Height, "format:", snapshot.Format, "chunks:", snapshot.Chunks)
 		}
 
@@ -61,13 +61,25 @@ func LoadArchiveCmd() *cobra.Command {
 				return fmt.Errorf("failed to unmarshal snapshot: %w", err)
 			}
 
-			// make sure the channel is unbuffered, because the tar reader can't do concurrency
-			chunks := make(chan io.ReadCloser)
+			// make sure the channels are unbuffered, because the tar reader can't do concurrency,
+			// the chunks being read in order, group after group
+			groupChunks := snapshot.ChunkGroups()
+			groups := make([]chan io.ReadCloser, len(groupChunks))
+			chunkGroups := make([]<-chan io.ReadCloser, len(groupChunks))
+			for i := range groups {
+				groups[i] = make(chan io.ReadCloser)
+				chunkGroups[i] = groups[i]
+			}
 			quitChan := make(chan *snapshottypes.Snapshot)
 			go func() {
 				defer close(quitChan)
 
-				savedSnapshot, err := snapshotStore.Save(snapshot.Height, snapshot.Format, chunks)
+				// the base snapshot of a delta snapshot must be loaded first
+				metadata := snapshottypes.Metadata{
+					BaseHeight: snapshot.Metadata.BaseHeight,
+					AppHash:    snapshot.Metadata.AppHash,
+				}
+				savedSnapshot, err := snapshotStore.SaveGroups(snapshot.Height, snapshot.Format, metadata, chunkGroups)
 				if err != nil {
 					cmd.Println("failed to save snapshot", err)
 					return
@@ -75,26 +87,36 @@ func LoadArchiveCmd() *cobra.Command {
 				quitChan <- savedSnapshot
 			}()
 
-			for i := uint32(0); i < snapshot.Chunks; i++ {
-				hdr, err = tr.Next()
-				if err != nil {
-					if errors.Is(err, io.EOF) {
-						break
+			// the groups are closed once their chunks are read, the remaining ones on a truncated archive
+			next := 0
+			i := uint32(0)
+		loop:
+			for group, chunks := range groups {
+				for end := i + groupChunks[group]; i < end; i++ {
+					hdr, err = tr.Next()
+					if err != nil {
+						if errors.Is(err, io.EOF) {
+							break loop
+						}
+						return err
 					}
-					return err
-				}
 
-				if hdr.Name != strconv.FormatInt(int64(i), 10) {
-					return fmt.Errorf("invalid archive, expect file: %d, got: %s", i, hdr.Name)
-				}
+					if hdr.Name != strconv.FormatInt(int64(i), 10) {
+						return fmt.Errorf("invalid archive, expect file: %d, got: %s", i, hdr.Name)
+					}
 
-				bz, err := io.ReadAll(tr)
-				if err != nil {
-					return fmt.Errorf("failed to read chunk file: %w", err)
+					bz, err := io.ReadAll(tr)
+					if err != nil {
+						return fmt.Errorf("failed to read chunk file: %w", err)
+					}
+					chunks <- io.NopCloser(bytes.NewReader(bz))
 				}
-				chunks <- io.NopCloser(bytes.NewReader(bz))
+				close(chunks)
+				next = group + 1
+			}
+			for _, chunks := range groups[next:] {
+				close(chunks)
 			}
-			close(chunks)
 
 			savedSnapshot := <-quitChan
 			if savedSnapshot == nil {
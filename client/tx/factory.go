@@ -48,6 +48,7 @@ type Factory struct {
 	gasPrices          sdk.DecCoins
 	extOptions         []*codectypes.Any
 	signMode           signing.SignMode
+	signSchnorr        bool
 	simulateAndExecute bool
 	preprocessTxHook   client.PreprocessTxFn
 }
@@ -95,6 +96,7 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 	}
 	timeoutHeight := clientCtx.Viper.GetUint64(flags.FlagTimeoutHeight)
 	unordered := clientCtx.Viper.GetBool(flags.FlagUnordered)
+	signSchnorr := clientCtx.Viper.GetBool(flags.FlagSignSchnorr)
 
 	gasStr := clientCtx.Viper.GetString(flags.FlagGas)
 	gasSetting, _ := flags.ParseGasSetting(gasStr)
@@ -117,6 +119,7 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 		gasAdjustment:      gasAdj,
 		memo:               memo,
 		signMode:           signMode,
+		signSchnorr:        signSchnorr,
 		feeGranter:         clientCtx.FeeGranter,
 		feePayer:           clientCtx.FeePayer,
 	}
@@ -145,6 +148,7 @@ func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRe
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
 func (f Factory) TimeoutTimestamp() time.Time               { return f.timeoutTimestamp }
 func (f Factory) Unordered() bool                           { return f.unordered }
+func (f Factory) SignSchnorr() bool                         { return f.signSchnorr }
 func (f Factory) FromName() string                          { return f.fromName }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
@@ -270,6 +274,13 @@ func (f Factory) WithUnordered(v bool) Factory {
 	return f
 }
 
+// WithSignSchnorr returns a copy of the Factory signing with raw BIP-340 Schnorr
+// signatures instead of BIP-322 ones, which only local taproot keys can do.
+func (f Factory) WithSignSchnorr(v bool) Factory {
+	f.signSchnorr = v
+	return f
+}
+
 // WithFeeGranter returns a copy of the Factory with an updated fee granter.
 func (f Factory) WithFeeGranter(fg sdk.AccAddress) Factory {
 	f.feeGranter = fg
@@ -0,0 +1,125 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExportPSBT returns the bytes pubKey has to sign for txBuilder as the BIP-322
+// to_sign virtual transaction of a PSBT, so that the signature can be produced
+// by Bitcoin wallets instead of the keyring. Like Sign, it sets an empty
+// signature of pubKey on txBuilder, overwriting the previous ones if
+// overwriteSig is true.
+//
+// Only keys producing BIP-322 simple signatures, i.e. taproot and MuSig2
+// keys, can be exported. The internal key of a taproot key and its BIP-32
+// derivation, which wallets need to recognize their key, are read from its
+// record in the keyring of txf.
+func ExportPSBT(
+	ctx context.Context, txf Factory, pubKey cryptotypes.PubKey, txBuilder client.TxBuilder, overwriteSig bool,
+) (*psbt.Packet, error) {
+	outputKey, err := psbtOutputKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	internalKey, derivation, err := psbtInternalKey(txf, pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	_, bytesToSign, _, err := prepareSignature(ctx, txf, pubKey, txBuilder, overwriteSig)
+	if err != nil {
+		return nil, err
+	}
+
+	packet, err := taproot.Bip322ToSignPacket(bytesToSign, outputKey, internalKey, taproot.GetBitcoinNetParams())
+	if err != nil {
+		return nil, err
+	}
+	if derivation != nil {
+		packet.Inputs[0].TaprootBip32Derivation = []*psbt.TaprootBip32Derivation{derivation}
+	}
+
+	return packet, nil
+}
+
+// SignWithPSBT sets the signature of pubKey extracted from a PSBT exported by
+// ExportPSBT and signed by a Bitcoin wallet on txBuilder. The signature is
+// appended to the previous ones unless overwriteSig is true, which must have
+// the value passed to ExportPSBT. Unlike Sign, it doesn't run the
+// preprocessing hook of txf, as pubKey needs no keyring record.
+func SignWithPSBT(
+	ctx context.Context, txf Factory, pubKey cryptotypes.PubKey, txBuilder client.TxBuilder, packet *psbt.Packet, overwriteSig bool,
+) error {
+	outputKey, err := psbtOutputKey(pubKey)
+	if err != nil {
+		return err
+	}
+
+	signMode, bytesToSign, prevSignatures, err := prepareSignature(ctx, txf, pubKey, txBuilder, overwriteSig)
+	if err != nil {
+		return err
+	}
+
+	sigBytes, err := taproot.Bip322SigFromPacket(packet, bytesToSign, outputKey, taproot.GetBitcoinNetParams())
+	if err != nil {
+		return err
+	}
+
+	if !pubKey.VerifySignature(bytesToSign, sigBytes) {
+		return fmt.Errorf("invalid PSBT signature for %s", pubKey)
+	}
+
+	return setSignature(txf, pubKey, signMode, sigBytes, txBuilder, prevSignatures, overwriteSig)
+}
+
+// psbtOutputKey returns the x-only output key of pubKey.
+func psbtOutputKey(pubKey cryptotypes.PubKey) ([]byte, error) {
+	switch pubKey.(type) {
+	case *taproot.PubKey, *taproot.MuSig2PubKey:
+		return pubKey.Address(), nil
+
+	default:
+		return nil, fmt.Errorf("%s keys can't sign with PSBTs", pubKey.Type())
+	}
+}
+
+// psbtInternalKey returns the x-only internal key of pubKey and its BIP-32
+// derivation. The ones of a taproot key are read from the keyring of txf,
+// MuSig2 keys have no BIP-32 derivation.
+func psbtInternalKey(txf Factory, pubKey cryptotypes.PubKey) ([]byte, *psbt.TaprootBip32Derivation, error) {
+	switch pubKey := pubKey.(type) {
+	case *taproot.PubKey:
+		exporter, ok := txf.Keybase().(keyring.BitcoinExporter)
+		if !ok {
+			return nil, nil, fmt.Errorf("the internal key of %s is unknown without a keyring", pubKey)
+		}
+
+		k, err := txf.Keybase().KeyByAddress(sdk.AccAddress(pubKey.Address()))
+		if err != nil {
+			return nil, nil, fmt.Errorf("the internal key of %s is unknown: %w", pubKey, err)
+		}
+
+		derivation, err := exporter.TaprootDerivation(k.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		return derivation.XOnlyPubKey, derivation, nil
+
+	case *taproot.MuSig2PubKey:
+		internalKey, err := pubKey.InternalKey()
+		return internalKey, nil, err
+
+	default:
+		return nil, nil, fmt.Errorf("%s keys can't sign with PSBTs", pubKey.Type())
+	}
+}
@@ -0,0 +1,126 @@
+package tx
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// walletSign signs the PSBT the way a Bitcoin wallet holding privKey does.
+func walletSign(t *testing.T, packet *psbt.Packet, privKey *taproot.PrivKey) {
+	t.Helper()
+
+	input := &packet.Inputs[0]
+	prevFetcher := txscript.NewCannedPrevOutputFetcher(input.WitnessUtxo.PkScript, input.WitnessUtxo.Value)
+	sigHashes := txscript.NewTxSigHashes(packet.UnsignedTx, prevFetcher)
+	sigHash, err := txscript.CalcTaprootSignatureHash(sigHashes, input.SighashType, packet.UnsignedTx, 0, prevFetcher)
+	require.NoError(t, err)
+
+	internalKey, _ := btcec.PrivKeyFromBytes(privKey.Key)
+	signingKey := txscript.TweakTaprootPrivKey(*internalKey, nil)
+	sig, err := schnorr.Sign(signingKey, sigHash)
+	require.NoError(t, err)
+	input.TaprootKeySpendSig = sig.Serialize()
+}
+
+func TestSignWithPSBT(t *testing.T) {
+	txConfig, cdc := newTestTxConfig()
+	kb, err := keyring.New(t.Name(), "test", t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	// the keyring only holds the account xpub of the wallet, which holds the
+	// private key
+	master, err := hdkeychain.NewMaster(bytes.Repeat([]byte{1}, 32), taproot.GetBitcoinNetParams())
+	require.NoError(t, err)
+	account := master
+	for _, i := range []uint32{86, 0, 0} {
+		account, err = account.Derive(hdkeychain.HardenedKeyStart + i)
+		require.NoError(t, err)
+	}
+	accountPub, err := account.Neuter()
+	require.NoError(t, err)
+	masterPub, err := master.ECPubKey()
+	require.NoError(t, err)
+	fingerprint := binary.BigEndian.Uint32(btcutil.Hash160(masterPub.SerializeCompressed())[:4])
+	_, err = kb.(keyring.BitcoinImporter).SaveWatchOnlyKey("wallet", fmt.Sprintf("tr([%08x/86'/0'/0']%s/0/*)", fingerprint, accountPub), 0)
+	require.NoError(t, err)
+
+	walletKey, err := account.Derive(0)
+	require.NoError(t, err)
+	walletKey, err = walletKey.Derive(0)
+	require.NoError(t, err)
+	ecPrivKey, err := walletKey.ECPrivKey()
+	require.NoError(t, err)
+	privKey := &taproot.PrivKey{Key: ecPrivKey.Serialize()}
+	pubKey := privKey.PubKey()
+
+	msg := banktypes.NewMsgSend(sdk.AccAddress(pubKey.Address()), sdk.AccAddress("115eb49fc24cae1aaba6f36b7e7863fd"), nil)
+	txf := mockTxFactory(txConfig).WithKeybase(kb)
+
+	for _, signMode := range []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT, signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON} {
+		t.Run(signMode.String(), func(t *testing.T) {
+			txf := txf.WithSignMode(signMode)
+			txb, err := txf.BuildUnsignedTx(msg)
+			require.NoError(t, err)
+
+			packet, err := ExportPSBT(context.Background(), txf, pubKey, txb, true)
+			require.NoError(t, err)
+
+			// the wallet recognizes its key from its internal key and derivation
+			internalKey := schnorr.SerializePubKey(ecPrivKey.PubKey())
+			require.Equal(t, internalKey, packet.Inputs[0].TaprootInternalKey)
+			require.Equal(t, []*psbt.TaprootBip32Derivation{{
+				XOnlyPubKey:          internalKey,
+				MasterKeyFingerprint: fingerprint,
+				Bip32Path:            []uint32{hdkeychain.HardenedKeyStart + 86, hdkeychain.HardenedKeyStart, hdkeychain.HardenedKeyStart, 0, 0},
+			}}, packet.Inputs[0].TaprootBip32Derivation)
+
+			// an unsigned PSBT is rejected
+			require.ErrorContains(t, SignWithPSBT(context.Background(), txf, pubKey, txb, packet, true), "not signed")
+
+			walletSign(t, packet, privKey)
+			require.NoError(t, SignWithPSBT(context.Background(), txf, pubKey, txb, packet, true))
+
+			sigs, err := txb.GetTx().GetSignaturesV2()
+			require.NoError(t, err)
+			require.Len(t, sigs, 1)
+			require.True(t, sigs[0].PubKey.Equals(pubKey))
+			require.Equal(t, txf.Sequence(), sigs[0].Sequence)
+			sigData := sigs[0].Data.(*signingtypes.SingleSignatureData)
+			require.Equal(t, signMode, sigData.SignMode)
+
+			// the PSBT of another sequence doesn't sign the tx
+			err = SignWithPSBT(context.Background(), txf.WithSequence(txf.Sequence()+1), pubKey, txb, packet, true)
+			require.ErrorContains(t, err, "not the BIP-322 to_sign transaction")
+		})
+	}
+
+	_, err = ExportPSBT(context.Background(), txf, secp256k1.GenPrivKey().PubKey(), nil, true)
+	require.ErrorContains(t, err, "can't sign with PSBTs")
+
+	// the internal key of a key saved without its origin is unknown
+	offlineKey := taproot.GenPrivKey().PubKey()
+	_, err = kb.SaveOfflineKey("offline", offlineKey)
+	require.NoError(t, err)
+	_, err = ExportPSBT(context.Background(), txf, offlineKey, nil, true)
+	require.ErrorContains(t, err, "internal key of offline is unknown")
+	_, err = ExportPSBT(context.Background(), txf, taproot.GenPrivKey().PubKey(), nil, true)
+	require.ErrorContains(t, err, "is unknown")
+}
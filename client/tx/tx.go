@@ -13,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -253,16 +254,6 @@ func Sign(ctx context.Context, txf Factory, name string, txBuilder client.TxBuil
 		return errors.New("keybase must be set prior to signing a transaction")
 	}
 
-	var err error
-	signMode := txf.signMode
-	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
-		// use the SignModeHandler's default mode if unspecified
-		signMode, err = authsigning.APISignModeToInternal(txf.txConfig.SignModeHandler().DefaultMode())
-		if err != nil {
-			return err
-		}
-	}
-
 	k, err := txf.keybase.Key(name)
 	if err != nil {
 		return err
@@ -273,6 +264,51 @@ func Sign(ctx context.Context, txf Factory, name string, txBuilder client.TxBuil
 		return err
 	}
 
+	signMode, bytesToSign, prevSignatures, err := prepareSignature(ctx, txf, pubKey, txBuilder, overwriteSig)
+	if err != nil {
+		return err
+	}
+
+	// Sign those bytes
+	var sigBytes []byte
+	if txf.signSchnorr {
+		signer, ok := txf.keybase.(keyring.SchnorrSigner)
+		if !ok {
+			return errors.New("keybase does not support Schnorr signatures")
+		}
+		sigBytes, _, err = signer.SignSchnorr(name, bytesToSign)
+	} else {
+		sigBytes, _, err = txf.keybase.Sign(name, bytesToSign, signMode)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := setSignature(txf, pubKey, signMode, sigBytes, txBuilder, prevSignatures, overwriteSig); err != nil {
+		return err
+	}
+
+	// Run optional preprocessing if specified. By default, this is unset
+	// and will return nil.
+	return txf.PreprocessTx(name, txBuilder)
+}
+
+// prepareSignature sets an empty signature of pubKey on txBuilder, and returns
+// the sign mode and the bytes pubKey has to sign, along with the signatures
+// previously set on txBuilder unless overwriteSig is true.
+func prepareSignature(
+	ctx context.Context, txf Factory, pubKey cryptotypes.PubKey, txBuilder client.TxBuilder, overwriteSig bool,
+) (signing.SignMode, []byte, []signing.SignatureV2, error) {
+	var err error
+	signMode := txf.signMode
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		// use the SignModeHandler's default mode if unspecified
+		signMode, err = authsigning.APISignModeToInternal(txf.txConfig.SignModeHandler().DefaultMode())
+		if err != nil {
+			return signMode, nil, nil, err
+		}
+	}
+
 	signerData := authsigning.SignerData{
 		ChainID:       txf.chainID,
 		AccountNumber: txf.accountNumber,
@@ -303,7 +339,7 @@ func Sign(ctx context.Context, txf Factory, name string, txBuilder client.TxBuil
 	if !overwriteSig {
 		prevSignatures, err = txBuilder.GetTx().GetSignaturesV2()
 		if err != nil {
-			return err
+			return signMode, nil, nil, err
 		}
 	}
 	// Overwrite or append signer infos.
@@ -315,35 +351,39 @@ func Sign(ctx context.Context, txf Factory, name string, txBuilder client.TxBuil
 		sigs = append(sigs, sig)
 	}
 	if err := txBuilder.SetSignatures(sigs...); err != nil {
-		return err
+		return signMode, nil, nil, err
 	}
 
 	if err := checkMultipleSigners(txBuilder.GetTx()); err != nil {
-		return err
+		return signMode, nil, nil, err
 	}
 
 	bytesToSign, err := authsigning.GetSignBytesAdapter(ctx, txf.txConfig.SignModeHandler(), signMode, signerData, txBuilder.GetTx())
 	if err != nil {
-		return err
+		return signMode, nil, nil, err
 	}
 
-	// Sign those bytes
-	sigBytes, _, err := txf.keybase.Sign(name, bytesToSign, signMode)
-	if err != nil {
-		return err
-	}
+	return signMode, bytesToSign, prevSignatures, nil
+}
 
+// setSignature sets the signature of pubKey on txBuilder, after
+// prevSignatures unless overwriteSig is true.
+func setSignature(
+	txf Factory, pubKey cryptotypes.PubKey, signMode signing.SignMode, sigBytes []byte,
+	txBuilder client.TxBuilder, prevSignatures []signing.SignatureV2, overwriteSig bool,
+) error {
 	// Construct the SignatureV2 struct
-	sigData = signing.SingleSignatureData{
+	sigData := signing.SingleSignatureData{
 		SignMode:  signMode,
 		Signature: sigBytes,
 	}
-	sig = signing.SignatureV2{
+	sig := signing.SignatureV2{
 		PubKey:   pubKey,
 		Data:     &sigData,
 		Sequence: txf.Sequence(),
 	}
 
+	var err error
 	if overwriteSig {
 		err = txBuilder.SetSignatures(sig)
 	} else {
@@ -355,9 +395,7 @@ func Sign(ctx context.Context, txf Factory, name string, txBuilder client.TxBuil
 		return fmt.Errorf("unable to set signatures on payload: %w", err)
 	}
 
-	// Run optional preprocessing if specified. By default, this is unset
-	// and will return nil.
-	return txf.PreprocessTx(name, txBuilder)
+	return nil
 }
 
 // GasEstimateResponse defines a response definition for tx gas estimation.
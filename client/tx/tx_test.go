@@ -14,6 +14,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -366,6 +367,43 @@ func TestSign(t *testing.T) {
 	}
 }
 
+func TestSignSchnorr(t *testing.T) {
+	txConfig, cdc := newTestTxConfig()
+	requireT := require.New(t)
+	kb, err := keyring.New(t.Name(), "test", t.TempDir(), nil, cdc)
+	requireT.NoError(err)
+
+	from := "test_key"
+	k, _, err := kb.NewMnemonic(from, keyring.English, hd.CreateHDPath(118, 0, 0).String(), keyring.DefaultBIP39Passphrase, hd.Taproot)
+	requireT.NoError(err)
+	pubKey, err := k.GetPubKey()
+	requireT.NoError(err)
+	addr, err := k.GetAddress()
+	requireT.NoError(err)
+
+	txf := mockTxFactory(txConfig).
+		WithKeybase(kb).
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT).
+		WithSignSchnorr(true)
+	txb, err := txf.BuildUnsignedTx(banktypes.NewMsgSend(addr, sdk.AccAddress("115eb49fc24cae1aaba6f36b7e7863fd"), nil))
+	requireT.NoError(err)
+
+	requireT.NoError(Sign(context.TODO(), txf, from, txb, true))
+	sigs := testSigners(requireT, txb.GetTx(), pubKey)
+	sigData, ok := sigs[0].Data.(*signingtypes.SingleSignatureData)
+	requireT.True(ok)
+	requireT.True(taproot.IsSchnorrSignature(sigData.Signature))
+
+	// the signature verifies against the sign bytes of the tx
+	signBytes, err := signing.GetSignBytesAdapter(
+		context.TODO(), txConfig.SignModeHandler(), signingtypes.SignMode_SIGN_MODE_DIRECT,
+		signing.SignerData{ChainID: txf.ChainID(), AccountNumber: txf.AccountNumber(), Sequence: txf.Sequence(), PubKey: pubKey, Address: addr.String()},
+		txb.GetTx(),
+	)
+	requireT.NoError(err)
+	requireT.True(pubKey.VerifySignature(signBytes, sigData.Signature))
+}
+
 func TestPreprocessHook(t *testing.T) {
 	_, _, addr2 := testdata.KeyTestPubAddr()
 
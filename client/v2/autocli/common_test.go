@@ -73,7 +73,7 @@ func initFixture(t *testing.T) *fixture {
 		Builder: flag.Builder{
 			TypeResolver:          protoregistry.GlobalTypes,
 			FileResolver:          protoregistry.GlobalFiles,
-			AddressCodec:          addresscodec.NewTaprootCodec(&sdk.BitcoinNetParams),
+			AddressCodec:          addresscodec.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams()),
 			ValidatorAddressCodec: addresscodec.NewBech32Codec("cosmosvaloper"),
 			ConsensusAddressCodec: addresscodec.NewBech32Codec("cosmosvalcons"),
 		},
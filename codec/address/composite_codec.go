@@ -0,0 +1,84 @@
+package address
+
+import (
+	"cosmossdk.io/core/address"
+
+	"github.com/btcsuite/btcd/chaincfg"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CompositeCodec parses address strings with any of its codecs and formats
+// address bytes with the canonical one. It lets a chain accept several
+// encodings of the same address bytes, e.g. both the Taproot and the Bech32
+// encodings of an account, while rendering a single one.
+type CompositeCodec struct {
+	Canonical  address.Codec
+	Alternates []address.Codec
+}
+
+var _ address.Codec = &CompositeCodec{}
+
+func NewCompositeCodec(canonical address.Codec, alternates ...address.Codec) address.Codec {
+	return CompositeCodec{Canonical: canonical, Alternates: alternates}
+}
+
+// StringToBytes decodes text with the canonical codec, then with the alternate
+// codecs in order. The error of the canonical codec is returned if none of
+// them can decode text.
+func (cc CompositeCodec) StringToBytes(text string) ([]byte, error) {
+	bz, err := cc.Canonical.StringToBytes(text)
+	if err == nil {
+		return bz, nil
+	}
+
+	for _, alternate := range cc.Alternates {
+		if bz, altErr := alternate.StringToBytes(text); altErr == nil {
+			return bz, nil
+		}
+	}
+
+	return nil, err
+}
+
+// BytesToString encodes bz with the canonical codec.
+func (cc CompositeCodec) BytesToString(bz []byte) (string, error) {
+	return cc.Canonical.BytesToString(bz)
+}
+
+// NewFormatCodec returns the codec encoding addresses in format, with the
+// Bech32 prefix or the Bitcoin network of the Taproot encoding. In dual mode
+// it also decodes the alternate format.
+func NewFormatCodec(format sdk.AddressFormat, bech32Prefix string, btcNetworkParams *chaincfg.Params, dual bool) address.Codec {
+	codecs := map[sdk.AddressFormat]address.Codec{
+		sdk.AddressFormatTaproot: NewTaprootCodec(btcNetworkParams),
+		sdk.AddressFormatBech32:  NewBech32Codec(bech32Prefix),
+	}
+
+	alternate := format.Alternate()
+	if !dual || (alternate == sdk.AddressFormatBech32 && bech32Prefix == "") {
+		return codecs[format]
+	}
+
+	return NewCompositeCodec(codecs[format], codecs[alternate])
+}
+
+// NewAccAddressCodec returns the account address codec set by the sdk config.
+func NewAccAddressCodec() address.Codec {
+	config := sdk.GetConfig()
+	return NewFormatCodec(config.GetAccountAddrFormat(), config.GetBech32AccountAddrPrefix(), config.GetBitcoinNetParams(), config.IsDualAddressMode())
+}
+
+// NewValAddressCodec returns the validator operator address codec set by the
+// sdk config.
+func NewValAddressCodec() address.Codec {
+	config := sdk.GetConfig()
+	return NewFormatCodec(config.GetValidatorAddrFormat(), config.GetBech32ValidatorAddrPrefix(), config.GetBitcoinNetParams(), config.IsDualAddressMode())
+}
+
+// NewConsAddressCodec returns the consensus node address codec set by the sdk
+// config.
+func NewConsAddressCodec() address.Codec {
+	config := sdk.GetConfig()
+	return NewFormatCodec(config.GetConsensusAddrFormat(), config.GetBech32ConsensusAddrPrefix(), config.GetBitcoinNetParams(), config.IsDualAddressMode())
+}
@@ -0,0 +1,102 @@
+package address
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"cosmossdk.io/core/address"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCompositeCodec(t *testing.T) {
+	bz := make([]byte, 32)
+	_, err := rand.Read(bz)
+	require.NoError(t, err)
+
+	taprootCodec := NewTaprootCodec(&chaincfg.MainNetParams)
+	bech32Codec := NewBech32Codec("cosmos")
+	taprootStr, err := taprootCodec.BytesToString(bz)
+	require.NoError(t, err)
+	bech32Str, err := bech32Codec.BytesToString(bz)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		codec     CompositeCodec
+		canonical string
+	}{
+		{"taproot canonical", CompositeCodec{taprootCodec, []address.Codec{bech32Codec}}, taprootStr},
+		{"bech32 canonical", CompositeCodec{bech32Codec, []address.Codec{taprootCodec}}, bech32Str},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, str := range []string{taprootStr, bech32Str} {
+				res, err := tc.codec.StringToBytes(str)
+				require.NoError(t, err)
+				require.Equal(t, bz, res)
+			}
+
+			str, err := tc.codec.BytesToString(bz)
+			require.NoError(t, err)
+			require.Equal(t, tc.canonical, str)
+
+			_, err = tc.codec.StringToBytes("osmo1qqqsyqcyq5rqwzqfpg9scrgwpugpzysn7hzdtn")
+			require.Error(t, err)
+			_, err = tc.codec.StringToBytes("")
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestNewFormatCodec(t *testing.T) {
+	bz := make([]byte, 32)
+	_, err := rand.Read(bz)
+	require.NoError(t, err)
+	bech32Str, err := NewBech32Codec("cosmos").BytesToString(bz)
+	require.NoError(t, err)
+	taprootStr, err := NewTaprootCodec(&chaincfg.MainNetParams).BytesToString(bz)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name         string
+		format       sdk.AddressFormat
+		bech32Prefix string
+		dual         bool
+		canonical    string
+		alternate    string
+		parseAlt     bool
+	}{
+		{"taproot dual", sdk.AddressFormatTaproot, "cosmos", true, taprootStr, bech32Str, true},
+		{"taproot only", sdk.AddressFormatTaproot, "cosmos", false, taprootStr, bech32Str, false},
+		{"taproot dual without bech32 prefix", sdk.AddressFormatTaproot, "", true, taprootStr, bech32Str, false},
+		{"bech32 dual", sdk.AddressFormatBech32, "cosmos", true, bech32Str, taprootStr, true},
+		{"bech32 only", sdk.AddressFormatBech32, "cosmos", false, bech32Str, taprootStr, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cdc := NewFormatCodec(tc.format, tc.bech32Prefix, &chaincfg.MainNetParams, tc.dual)
+
+			str, err := cdc.BytesToString(bz)
+			require.NoError(t, err)
+			require.Equal(t, tc.canonical, str)
+
+			res, err := cdc.StringToBytes(tc.canonical)
+			require.NoError(t, err)
+			require.Equal(t, bz, res)
+
+			res, err = cdc.StringToBytes(tc.alternate)
+			if tc.parseAlt {
+				require.NoError(t, err)
+				require.Equal(t, bz, res)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
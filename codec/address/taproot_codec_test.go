@@ -1,6 +1,8 @@
 package address
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/btcsuite/btcd/chaincfg"
@@ -24,9 +26,21 @@ func TestTaprootCodec(t *testing.T) {
 			expectError: false,
 		},
 		{
-			name:        "valid mainnet address with other format",
+			name:        "valid mainnet P2WPKH address",
 			network:     mainnetParams,
 			address:     "bc1qqx7a4rtcf7f49f8537xu9e2p0xt54k9jxlf6wm",
+			expectError: false,
+		},
+		{
+			name:        "valid mainnet P2WSH address",
+			network:     mainnetParams,
+			address:     "bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3",
+			expectError: true,
+		},
+		{
+			name:        "valid mainnet P2PKH address",
+			network:     mainnetParams,
+			address:     "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
 			expectError: true,
 		},
 		{
@@ -38,7 +52,7 @@ func TestTaprootCodec(t *testing.T) {
 		{
 			name:        "invalid bech32 address",
 			network:     mainnetParams,
-			address:     "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+			address:     "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5",
 			expectError: true,
 		},
 		{
@@ -100,6 +114,34 @@ func TestTaprootCodecRoundTrip(t *testing.T) {
 	require.Equal(t, originalAddr, addrStr)
 }
 
+func TestTaprootCodecAddressLengths(t *testing.T) {
+	codec := NewTaprootCodec(&chaincfg.MainNetParams)
+
+	tests := []struct {
+		name   string
+		bz     []byte
+		prefix string
+	}{
+		{"20-byte address", bytes.Repeat([]byte{0x01}, 20), "bc1q"},
+		{"32-byte address", bytes.Repeat([]byte{0x01}, 32), "bc1p"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addrStr, err := codec.BytesToString(tt.bz)
+			require.NoError(t, err)
+			require.True(t, strings.HasPrefix(addrStr, tt.prefix), addrStr)
+
+			bz, err := codec.StringToBytes(addrStr)
+			require.NoError(t, err)
+			require.Equal(t, tt.bz, bz)
+		})
+	}
+
+	_, err := codec.BytesToString(bytes.Repeat([]byte{0x01}, 21))
+	require.ErrorContains(t, err, "invalid address length")
+}
+
 func TestTaprootCodecEmptyBytes(t *testing.T) {
 	mainnetParams := &chaincfg.MainNetParams
 	codec := NewTaprootCodec(mainnetParams)
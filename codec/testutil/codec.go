@@ -33,7 +33,7 @@ func (o CodecOptions) NewInterfaceRegistry() codectypes.InterfaceRegistry {
 	ir, err := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
 		ProtoFiles: proto.HybridResolver,
 		SigningOptions: signing.Options{
-			AddressCodec:          address.NewTaprootCodec(&types.BitcoinNetParams),
+			AddressCodec:          address.NewTaprootCodec(types.GetConfig().GetBitcoinNetParams()),
 			ValidatorAddressCodec: address.NewBech32Codec(valAddressPrefix),
 		},
 	})
@@ -25,6 +25,10 @@ func RegisterCrypto(cdc *codec.LegacyAmino) {
 		kmultisig.PubKeyAminoRoute, nil)
 	cdc.RegisterConcrete(&taproot.PubKey{},
 		taproot.PubKeyName, nil)
+	cdc.RegisterConcrete(&taproot.MuSig2PubKey{},
+		taproot.MuSig2PubKeyName, nil)
+	cdc.RegisterConcrete(&taproot.TapscriptPubKey{},
+		taproot.TapscriptPubKeyName, nil)
 
 	cdc.RegisterInterface((*cryptotypes.PrivKey)(nil), nil)
 	cdc.RegisterConcrete(sr25519.PrivKey{},
@@ -18,6 +18,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(pk, &secp256k1.PubKey{})
 	registry.RegisterImplementations(pk, &multisig.LegacyAminoPubKey{})
 	registry.RegisterImplementations(pk, &taproot.PubKey{})
+	registry.RegisterImplementations(pk, &taproot.MuSig2PubKey{})
+	registry.RegisterImplementations(pk, &taproot.TapscriptPubKey{})
 
 	var priv *cryptotypes.PrivKey
 	registry.RegisterInterface("cosmos.crypto.PrivKey", priv)
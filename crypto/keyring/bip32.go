@@ -0,0 +1,686 @@
+package keyring
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/cosmos/go-bip39"
+	secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
+	"github.com/cosmos/cosmos-sdk/crypto/ledger"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BitcoinImporter is implemented by key stores that import taproot keys in the
+// formats of Bitcoin wallets.
+type BitcoinImporter interface {
+	// ImportWIF imports a WIF encoded private key as a taproot key.
+	ImportWIF(uid, wif string) error
+	// ImportExtendedPrivKey imports the taproot key derived by path from a
+	// BIP-32 extended private key. An absolute path (m/...) requires a master
+	// key, a relative one (e.g. 0/0) is derived from the key itself. An empty
+	// path selects the first receive key: the BIP-86 path of the configured coin
+	// type for a master key, 0/0 for any other key.
+	ImportExtendedPrivKey(uid, xprv, path string) error
+	// SaveWatchOnlyKey stores an offline taproot key derived at index from an
+	// extended public key, given either as an account xpub, derived at 0/index,
+	// or as a ranged descriptor such as tr([fp/86'/0'/0']xpub/0/*).
+	SaveWatchOnlyKey(uid, xpub string, index uint32) (*Record, error)
+}
+
+// BitcoinExporter is implemented by key stores that export taproot keys in the
+// formats of Bitcoin wallets.
+type BitcoinExporter interface {
+	// ExportWIF returns the private key of a local taproot key in WIF format.
+	ExportWIF(uid string) (string, error)
+	// ExportXPub returns the extended public key of the account a taproot key
+	// is derived from. It fails if the key has no known BIP-32 origin.
+	ExportXPub(uid string) (string, error)
+	// ExportDescriptor returns an output descriptor of a taproot key: the
+	// ranged descriptor of its account if its BIP-32 origin is known, a single
+	// key descriptor otherwise.
+	ExportDescriptor(uid string) (string, error)
+	// TaprootDerivation returns the BIP-371 derivation of a taproot key, with
+	// which Bitcoin wallets recognize their key in a PSBT: its x-only internal
+	// key, and the fingerprint of its master key and its path. It fails if the
+	// internal key is unknown.
+	TaprootDerivation(uid string) (*psbt.TaprootBip32Derivation, error)
+}
+
+var (
+	_ BitcoinImporter = keystore{}
+	_ BitcoinExporter = keystore{}
+)
+
+func (ks keystore) ImportWIF(uid, wif string) error {
+	if _, err := ks.Key(uid); err == nil {
+		return errorsmod.Wrap(ErrOverwriteKey, uid)
+	}
+
+	decoded, err := btcutil.DecodeWIF(wif)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to decode WIF")
+	}
+
+	_, err = ks.writeLocalKey(uid, &taproot.PrivKey{Key: decoded.PrivKey.Serialize()})
+	return err
+}
+
+func (ks keystore) ImportExtendedPrivKey(uid, xprv, path string) error {
+	if _, err := ks.Key(uid); err == nil {
+		return errorsmod.Wrap(ErrOverwriteKey, uid)
+	}
+
+	key, err := hdkeychain.NewKeyFromString(xprv)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to decode extended key")
+	}
+	if !key.IsPrivate() {
+		return errors.New("not an extended private key")
+	}
+
+	if path == "" {
+		path = "0/0"
+		if key.Depth() == 0 {
+			path = hd.CreateHDPath(sdk.GetConfig().GetCoinType(), 0, 0).String()
+		}
+	}
+
+	origin, derived, err := deriveKeyOrigin(key, path)
+	if err != nil {
+		return err
+	}
+
+	ecPriv, err := derived.ECPrivKey()
+	if err != nil {
+		return err
+	}
+
+	_, err = ks.writeLocalKeyWithOrigin(uid, &taproot.PrivKey{Key: ecPriv.Serialize()}, origin)
+	return err
+}
+
+func (ks keystore) SaveWatchOnlyKey(uid, xpub string, index uint32) (*Record, error) {
+	if _, err := ks.Key(uid); err == nil {
+		return nil, errorsmod.Wrap(ErrOverwriteKey, uid)
+	}
+
+	pubKey, origin, err := DeriveWatchOnlyKey(xpub, index)
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := NewOfflineRecord(uid, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	k.GetOffline().KeyOrigin = origin
+
+	return k, ks.writeRecord(k)
+}
+
+// DeriveWatchOnlyKey derives the taproot key at index from an extended public
+// key, as SaveWatchOnlyKey does, and returns it with its BIP-32 origin.
+func DeriveWatchOnlyKey(xpub string, index uint32) (*taproot.PubKey, *Record_KeyOrigin, error) {
+	desc, err := parseDescriptor(xpub)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path := strconv.FormatUint(uint64(index), 10)
+	if desc.rangePath != "" {
+		path = desc.rangePath + "/" + path
+	}
+	origin, derived, err := deriveKeyOrigin(desc.key, path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if desc.fingerprint != 0 {
+		origin.Fingerprint = desc.fingerprint
+		origin.Path = desc.originPath + "/" + path
+	}
+
+	ecPub, err := derived.ECPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &taproot.PubKey{Key: taproot.TweakPubKey(ecPub)}, origin, nil
+}
+
+func (ks keystore) ExportWIF(uid string) (string, error) {
+	priv, err := ks.taprootPrivKey(uid)
+	if err != nil {
+		return "", err
+	}
+
+	wif, err := btcutil.NewWIF(secp256k1.PrivKeyFromBytes(priv.Key), taproot.GetBitcoinNetParams(), true)
+	if err != nil {
+		return "", err
+	}
+
+	return wif.String(), nil
+}
+
+func (ks keystore) ExportXPub(uid string) (string, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	origin := k.keyOrigin()
+	if origin == nil || origin.AccountXpub == "" {
+		return "", fmt.Errorf("key %s has no known BIP-32 account", uid)
+	}
+
+	return origin.AccountXpub, nil
+}
+
+func (ks keystore) ExportDescriptor(uid string) (string, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	pub, err := k.GetPubKey()
+	if err != nil {
+		return "", err
+	}
+	if _, ok := pub.(*taproot.PubKey); !ok {
+		return "", fmt.Errorf("output descriptors require a taproot key, %s is a %s key", uid, pub.Type())
+	}
+
+	origin := k.keyOrigin()
+	if origin != nil && origin.AccountXpub != "" {
+		path, err := parseBIP32Path(origin.Path)
+		if err != nil {
+			return "", err
+		}
+
+		var keyOrigin string
+		if origin.Fingerprint != 0 && path.absolute {
+			keyOrigin = fmt.Sprintf("[%08x%s]", origin.Fingerprint, path.prefix(2).String()[1:])
+		}
+
+		return descriptorWithChecksum(fmt.Sprintf("tr(%s%s/%d/*)", keyOrigin, origin.AccountXpub, path.elems[len(path.elems)-2])), nil
+	}
+
+	// without the internal key, only the output key can be described
+	local := k.GetLocal()
+	if local == nil {
+		return descriptorWithChecksum(fmt.Sprintf("rawtr(%x)", pub.Address())), nil
+	}
+
+	priv, err := ks.taprootPrivKey(uid)
+	if err != nil {
+		return "", err
+	}
+	internalKey := secp256k1.PrivKeyFromBytes(priv.Key).PubKey().SerializeCompressed()[1:]
+
+	return descriptorWithChecksum(fmt.Sprintf("tr(%x)", internalKey)), nil
+}
+
+// TaprootDerivation reads the internal key of local keys from their private
+// key, of offline keys from the account xpub of their origin and of Ledger
+// keys from the device. Like output descriptors, a key without an absolute
+// BIP-32 origin is its own master key, or its account xpub is if it's derived
+// from it. The master key of Ledger keys is unknown, its fingerprint is zero.
+func (ks keystore) TaprootDerivation(uid string) (*psbt.TaprootBip32Derivation, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	pub, err := k.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+	outputKey, ok := pub.(*taproot.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("key %s is a %s key, not a taproot key", uid, pub.Type())
+	}
+
+	var (
+		internalKey []byte
+		origin      = k.keyOrigin()
+	)
+	switch {
+	case k.GetLocal() != nil:
+		priv, err := ks.taprootPrivKey(uid)
+		if err != nil {
+			return nil, err
+		}
+		internalKey = secp256k1.PrivKeyFromBytes(priv.Key).PubKey().SerializeCompressed()[1:]
+
+	case k.GetLedger() != nil:
+		path := k.GetLedger().GetPath()
+		if internalKey, err = ledger.TaprootInternalKey(*path); err != nil {
+			return nil, err
+		}
+		origin = &Record_KeyOrigin{Path: path.String()}
+
+	case origin != nil && origin.AccountXpub != "":
+		if internalKey, err = accountInternalKey(origin); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("the internal key of %s is unknown, save it from an extended public key or a descriptor", uid)
+	}
+
+	ecInternalKey, err := schnorr.ParsePubKey(internalKey)
+	if err != nil {
+		return nil, err
+	}
+	if !outputKey.Equals(&taproot.PubKey{Key: taproot.TweakPubKey(ecInternalKey)}) {
+		return nil, fmt.Errorf("the internal key of %s doesn't match its output key", uid)
+	}
+
+	derivation := &psbt.TaprootBip32Derivation{XOnlyPubKey: internalKey}
+	switch path, err := originPath(origin); {
+	case err != nil:
+		return nil, err
+
+	case path.absolute:
+		derivation.MasterKeyFingerprint = origin.Fingerprint
+		derivation.Bip32Path = path.elems
+
+	case len(path.elems) == 2 && origin.AccountXpub != "":
+		account, err := parseXPub(origin.AccountXpub)
+		if err != nil {
+			return nil, err
+		}
+		derivation.MasterKeyFingerprint = fingerprint(account)
+		derivation.Bip32Path = path.elems
+
+	default:
+		hash := btcutil.Hash160(ecInternalKey.SerializeCompressed())
+		derivation.MasterKeyFingerprint = binary.BigEndian.Uint32(hash[:4])
+	}
+
+	return derivation, nil
+}
+
+// originPath returns the path of a BIP-32 origin, empty if the origin is
+// unknown.
+func originPath(origin *Record_KeyOrigin) (bip32Path, error) {
+	if origin == nil || origin.Path == "" {
+		return bip32Path{}, nil
+	}
+
+	return parseBIP32Path(origin.Path)
+}
+
+// accountInternalKey derives the x-only internal key of a taproot key from the
+// account xpub of its origin.
+func accountInternalKey(origin *Record_KeyOrigin) ([]byte, error) {
+	key, err := parseXPub(origin.AccountXpub)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := parseBIP32Path(origin.Path)
+	if err != nil {
+		return nil, err
+	}
+	if len(path.elems) < 2 {
+		return nil, fmt.Errorf("BIP-32 path %s has no account levels", origin.Path)
+	}
+
+	for _, elem := range path.elems[len(path.elems)-2:] {
+		if key, err = key.Derive(elem); err != nil {
+			return nil, fmt.Errorf("failed to derive %s: %w", origin.Path, err)
+		}
+	}
+
+	ecPub, err := key.ECPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return schnorr.SerializePubKey(ecPub), nil
+}
+
+func (ks keystore) taprootPrivKey(uid string) (*taproot.PrivKey, error) {
+	priv, err := ks.ExportPrivateKeyObject(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	tpriv, ok := priv.(*taproot.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("key %s is a %s key, not a taproot key", uid, priv.Type())
+	}
+
+	return tpriv, nil
+}
+
+func (ks keystore) writeLocalKeyWithOrigin(name string, privKey *taproot.PrivKey, origin *Record_KeyOrigin) (*Record, error) {
+	k, err := NewLocalRecord(name, privKey, privKey.PubKey())
+	if err != nil {
+		return nil, err
+	}
+	k.GetLocal().KeyOrigin = origin
+
+	return k, ks.writeRecord(k)
+}
+
+// keyOrigin returns the BIP-32 origin of local and offline records, if any.
+func (k *Record) keyOrigin() *Record_KeyOrigin {
+	switch {
+	case k.GetLocal() != nil:
+		return k.GetLocal().KeyOrigin
+	case k.GetOffline() != nil:
+		return k.GetOffline().KeyOrigin
+	default:
+		return nil
+	}
+}
+
+// keyOriginFromMnemonic returns the BIP-32 origin of the key derived by hdPath
+// from a mnemonic, as done by hd.Taproot.
+func keyOriginFromMnemonic(mnemonic, bip39Passphrase, hdPath string) (*Record_KeyOrigin, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	master, err := hdkeychain.NewMaster(seed, taproot.GetBitcoinNetParams())
+	if err != nil {
+		return nil, err
+	}
+
+	if hdPath == "" {
+		hdPath = "m"
+	}
+
+	origin, _, err := deriveKeyOrigin(master, hdPath)
+	return origin, err
+}
+
+// deriveKeyOrigin derives the key at path from an extended key, and returns it
+// with its origin. The account extended public key is only known if the last
+// two levels of the path are not hardened.
+func deriveKeyOrigin(key *hdkeychain.ExtendedKey, pathStr string) (*Record_KeyOrigin, *hdkeychain.ExtendedKey, error) {
+	path, err := parseBIP32Path(pathStr)
+	if err != nil {
+		return nil, nil, err
+	}
+	if path.absolute && key.Depth() != 0 {
+		return nil, nil, fmt.Errorf("absolute path %s requires a master key, got a key of depth %d", pathStr, key.Depth())
+	}
+
+	origin := &Record_KeyOrigin{Path: path.String()}
+	if key.Depth() == 0 {
+		origin.Fingerprint = fingerprint(key)
+	}
+
+	n := len(path.elems)
+	accountDepth := -1
+	if n >= 2 && path.elems[n-2] < hdkeychain.HardenedKeyStart && path.elems[n-1] < hdkeychain.HardenedKeyStart {
+		accountDepth = n - 2
+	}
+
+	for i, elem := range path.elems {
+		if i == accountDepth {
+			if origin.AccountXpub, err = serializeXPub(key); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		if key, err = key.Derive(elem); err != nil {
+			return nil, nil, fmt.Errorf("failed to derive %s: %w", pathStr, err)
+		}
+	}
+
+	return origin, key, nil
+}
+
+// serializeXPub serializes the extended public key of key for the configured
+// Bitcoin network.
+func serializeXPub(key *hdkeychain.ExtendedKey) (string, error) {
+	pub, err := key.Neuter()
+	if err != nil {
+		return "", err
+	}
+
+	pub, err = pub.CloneWithVersion(taproot.GetBitcoinNetParams().HDPublicKeyID[:])
+	if err != nil {
+		return "", err
+	}
+
+	return pub.String(), nil
+}
+
+// fingerprint returns the BIP-32 fingerprint of an extended key.
+func fingerprint(key *hdkeychain.ExtendedKey) uint32 {
+	pub, err := key.ECPubKey()
+	if err != nil {
+		return 0
+	}
+
+	hash := btcutil.Hash160(pub.SerializeCompressed())
+	return uint32(hash[0])<<24 | uint32(hash[1])<<16 | uint32(hash[2])<<8 | uint32(hash[3])
+}
+
+// bip32Path is a BIP-32 derivation path, from the master key if absolute.
+type bip32Path struct {
+	absolute bool
+	elems    []uint32
+}
+
+// parseBIP32Path parses paths such as m/86'/0'/0'/0/0 or 0/5. Hardened
+// levels are marked with ' or h.
+func parseBIP32Path(path string) (bip32Path, error) {
+	var p bip32Path
+
+	parts := strings.Split(path, "/")
+	if parts[0] == "m" {
+		p.absolute = true
+		parts = parts[1:]
+	}
+
+	for _, part := range parts {
+		hardened := strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h")
+		if hardened {
+			part = part[:len(part)-1]
+		}
+
+		idx, err := strconv.ParseUint(part, 10, 31)
+		if err != nil {
+			return bip32Path{}, fmt.Errorf("invalid BIP-32 path %s: %w", path, err)
+		}
+		if hardened {
+			idx += hdkeychain.HardenedKeyStart
+		}
+
+		p.elems = append(p.elems, uint32(idx))
+	}
+
+	return p, nil
+}
+
+// prefix returns the path without its last n levels.
+func (p bip32Path) prefix(n int) bip32Path {
+	return bip32Path{absolute: p.absolute, elems: p.elems[:len(p.elems)-n]}
+}
+
+func (p bip32Path) String() string {
+	parts := make([]string, 0, len(p.elems)+1)
+	if p.absolute {
+		parts = append(parts, "m")
+	}
+
+	for _, elem := range p.elems {
+		if elem >= hdkeychain.HardenedKeyStart {
+			parts = append(parts, fmt.Sprintf("%d'", elem-hdkeychain.HardenedKeyStart))
+		} else {
+			parts = append(parts, strconv.FormatUint(uint64(elem), 10))
+		}
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// rangedDescriptor is a ranged taproot descriptor tr([fp/origin]xpub/path/*).
+type rangedDescriptor struct {
+	fingerprint uint32
+	originPath  string
+	key         *hdkeychain.ExtendedKey
+	rangePath   string
+}
+
+// parseDescriptor parses a ranged taproot descriptor, with or without its
+// checksum, or a bare extended public key taken as the descriptor of its
+// receive chain, xpub/0/*.
+func parseDescriptor(text string) (rangedDescriptor, error) {
+	var desc rangedDescriptor
+
+	if !strings.HasPrefix(text, "tr(") {
+		key, err := parseXPub(text)
+		if err != nil {
+			return desc, err
+		}
+
+		return rangedDescriptor{key: key, rangePath: "0"}, nil
+	}
+
+	if i := strings.LastIndex(text, "#"); i >= 0 {
+		if descriptorWithChecksum(text[:i]) != text {
+			return desc, errors.New("invalid descriptor checksum")
+		}
+		text = text[:i]
+	}
+
+	if !strings.HasSuffix(text, "/*)") {
+		return desc, errors.New("descriptor must be a ranged taproot descriptor tr(xpub/.../*)")
+	}
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "tr("), "/*)")
+
+	if strings.HasPrefix(text, "[") {
+		end := strings.Index(text, "]")
+		if end < 0 {
+			return desc, errors.New("invalid descriptor key origin")
+		}
+		fp, path, _ := strings.Cut(text[1:end], "/")
+
+		fpBz, err := hex.DecodeString(fp)
+		if err != nil || len(fpBz) != 4 {
+			return desc, fmt.Errorf("invalid descriptor fingerprint %s", fp)
+		}
+		desc.fingerprint = uint32(fpBz[0])<<24 | uint32(fpBz[1])<<16 | uint32(fpBz[2])<<8 | uint32(fpBz[3])
+
+		desc.originPath = "m"
+		if path != "" {
+			originPath, err := parseBIP32Path("m/" + path)
+			if err != nil {
+				return desc, err
+			}
+			desc.originPath = originPath.String()
+		}
+
+		text = text[end+1:]
+	}
+
+	xpub, rangePath, _ := strings.Cut(text, "/")
+	key, err := parseXPub(xpub)
+	if err != nil {
+		return desc, err
+	}
+	desc.key = key
+
+	if rangePath != "" {
+		if _, err := parseBIP32Path(rangePath); err != nil {
+			return desc, err
+		}
+	}
+	desc.rangePath = rangePath
+
+	return desc, nil
+}
+
+func parseXPub(text string) (*hdkeychain.ExtendedKey, error) {
+	key, err := hdkeychain.NewKeyFromString(text)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to decode extended key")
+	}
+	if key.IsPrivate() {
+		return nil, errors.New("watch-only keys require an extended public key")
+	}
+
+	return key, nil
+}
+
+const (
+	descriptorInputCharset    = "0123456789()[],'/*abcdefgh@:$%{}IJKLMNOPQRSTUVWXYZ&+-.;<=>?!^_|~ijklmnopqrstuvwxyzABCDEFGH`#\"\\ "
+	descriptorChecksumCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+)
+
+// descriptorWithChecksum appends the checksum defined in BIP-380 to an output
+// descriptor.
+func descriptorWithChecksum(desc string) string {
+	var (
+		symbols []uint64
+		groups  []uint64
+	)
+	for _, c := range desc {
+		v := strings.IndexRune(descriptorInputCharset, c)
+		if v < 0 {
+			return desc
+		}
+
+		symbols = append(symbols, uint64(v&31))
+		groups = append(groups, uint64(v>>5))
+		if len(groups) == 3 {
+			symbols = append(symbols, groups[0]*9+groups[1]*3+groups[2])
+			groups = groups[:0]
+		}
+	}
+	switch len(groups) {
+	case 1:
+		symbols = append(symbols, groups[0])
+	case 2:
+		symbols = append(symbols, groups[0]*3+groups[1])
+	}
+	symbols = append(symbols, make([]uint64, 8)...)
+
+	checksum := descriptorPolymod(symbols) ^ 1
+
+	var sb strings.Builder
+	sb.WriteString(desc)
+	sb.WriteByte('#')
+	for i := 0; i < 8; i++ {
+		sb.WriteByte(descriptorChecksumCharset[(checksum>>(5*(7-i)))&31])
+	}
+
+	return sb.String()
+}
+
+func descriptorPolymod(symbols []uint64) uint64 {
+	generator := [5]uint64{0xf5dee51989, 0xa9fdca3312, 0x1bab10e32d, 0x3706b1677a, 0x644d626ffd}
+
+	chk := uint64(1)
+	for _, value := range symbols {
+		top := chk >> 35
+		chk = (chk&0x7ffffffff)<<5 ^ value
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+
+	return chk
+}
@@ -0,0 +1,245 @@
+package keyring
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// BIP-86 test vectors.
+const (
+	bip86Mnemonic    = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	bip86Xprv        = "xprv9s21ZrQH143K3GJpoapnV8SFfukcVBSfeCficPSGfubmSFDxo1kuHnLisriDvSnRRuL2Qrg5ggqHKNVpxR86QEC8w35uxmGoggxtQTPvfUu"
+	bip86AccountPub  = "xpub6BgBgsespWvERF3LHQu6CnqdvfEvtMcQjYrcRzx53QJjSxarj2afYWcLteoGVky7D3UKDP9QyrLprQ3VCECoY49yfdDEHGCtMMj92pReUsQ"
+	bip86InternalKey = "cc8a4bc64d897bddc5fbc2f670f7a8ba0b386779106cf1223c6fc5d7cd6fc115"
+	bip86OutputKey   = "a60869f0dbcf1dc659c9cecbaf8050135ea9e8cdc487053f1dc6880949dc684c"
+)
+
+func requireAddress(t *testing.T, kr Keyring, uid, outputKey string) {
+	t.Helper()
+
+	k, err := kr.Key(uid)
+	require.NoError(t, err)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, outputKey, hex.EncodeToString(addr))
+}
+
+func TestBitcoinExport(t *testing.T) {
+	kr := NewInMemory(getCodec())
+	_, err := kr.NewAccount("bip86", bip86Mnemonic, "", sdk.FullFundraiserPath, hd.Taproot)
+	require.NoError(t, err)
+	requireAddress(t, kr, "bip86", bip86OutputKey)
+
+	exporter := kr.(BitcoinExporter)
+	xpub, err := exporter.ExportXPub("bip86")
+	require.NoError(t, err)
+	require.Equal(t, bip86AccountPub, xpub)
+
+	desc, err := exporter.ExportDescriptor("bip86")
+	require.NoError(t, err)
+	require.Equal(t, "tr([73c5da0a/86'/0'/0']"+bip86AccountPub+"/0/*)#"+desc[len(desc)-8:], desc)
+	require.Equal(t, desc, descriptorWithChecksum(desc[:len(desc)-9]))
+
+	wif, err := exporter.ExportWIF("bip86")
+	require.NoError(t, err)
+
+	// a key imported from its WIF has no origin
+	other := NewInMemory(getCodec())
+	require.NoError(t, other.(BitcoinImporter).ImportWIF("wif", wif))
+	requireAddress(t, other, "wif", bip86OutputKey)
+	_, err = other.(BitcoinExporter).ExportXPub("wif")
+	require.Error(t, err)
+	desc, err = other.(BitcoinExporter).ExportDescriptor("wif")
+	require.NoError(t, err)
+	require.Equal(t, descriptorWithChecksum("tr("+bip86InternalKey+")"), desc)
+
+	// keys that aren't taproot keys can't be exported
+	_, err = kr.NewAccount("secp", bip86Mnemonic, "", "m/44'/0'/0'/0/0", hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = exporter.ExportWIF("secp")
+	require.Error(t, err)
+	_, err = exporter.ExportDescriptor("secp")
+	require.Error(t, err)
+}
+
+func TestBitcoinImportExtendedPrivKey(t *testing.T) {
+	testCases := []struct {
+		name   string
+		xprv   string
+		path   string
+		expErr bool
+	}{
+		{"default path", bip86Xprv, "", false},
+		{"absolute path", bip86Xprv, "m/86h/0h/0h/0/0", false},
+		{"other index", bip86Xprv, "m/86'/0'/0'/0/1", false},
+		{"public key", bip86AccountPub, "", true},
+		{"invalid key", "xprv", "", true},
+		{"invalid path", bip86Xprv, "m/86'/x", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kr := NewInMemory(getCodec())
+			err := kr.(BitcoinImporter).ImportExtendedPrivKey("xprv", tc.xprv, tc.path)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			xpub, err := kr.(BitcoinExporter).ExportXPub("xprv")
+			require.NoError(t, err)
+			require.Equal(t, bip86AccountPub, xpub)
+			if tc.name != "other index" {
+				requireAddress(t, kr, "xprv", bip86OutputKey)
+			}
+		})
+	}
+
+	// relative paths are derived from an account key, absolute ones can't be
+	master, err := hdkeychain.NewKeyFromString(bip86Xprv)
+	require.NoError(t, err)
+	account := master
+	for _, i := range []uint32{86, 0, 0} {
+		account, err = account.Derive(hdkeychain.HardenedKeyStart + i)
+		require.NoError(t, err)
+	}
+
+	kr := NewInMemory(getCodec())
+	require.Error(t, kr.(BitcoinImporter).ImportExtendedPrivKey("abs", account.String(), "m/0/0"))
+	require.NoError(t, kr.(BitcoinImporter).ImportExtendedPrivKey("rel", account.String(), ""))
+	requireAddress(t, kr, "rel", bip86OutputKey)
+	desc, err := kr.(BitcoinExporter).ExportDescriptor("rel")
+	require.NoError(t, err)
+	require.Equal(t, descriptorWithChecksum("tr("+bip86AccountPub+"/0/*)"), desc)
+}
+
+func TestBitcoinWatchOnly(t *testing.T) {
+	desc := descriptorWithChecksum("tr([73c5da0a/86'/0'/0']" + bip86AccountPub + "/0/*)")
+
+	testCases := []struct {
+		name   string
+		xpub   string
+		expErr bool
+	}{
+		{"descriptor", desc, false},
+		{"descriptor without checksum", desc[:len(desc)-9], false},
+		{"xpub", bip86AccountPub, false},
+		{"invalid checksum", desc[:len(desc)-1] + "q", true},
+		{"not ranged", "tr(" + bip86AccountPub + "/0/0)", true},
+		{"private key", bip86Xprv, true},
+		{"invalid fingerprint", "tr([73c5/86'/0'/0']" + bip86AccountPub + "/0/*)", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kr := NewInMemory(getCodec())
+			k, err := kr.(BitcoinImporter).SaveWatchOnlyKey("watch", tc.xpub, 0)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, TypeOffline, k.GetType())
+			requireAddress(t, kr, "watch", bip86OutputKey)
+
+			_, _, err = kr.Sign("watch", []byte("msg"), signing.SignMode_SIGN_MODE_DIRECT)
+			require.ErrorIs(t, err, ErrOfflineSign)
+			_, err = kr.(BitcoinExporter).ExportWIF("watch")
+			require.Error(t, err)
+
+			exported, err := kr.(BitcoinExporter).ExportDescriptor("watch")
+			require.NoError(t, err)
+			if tc.name == "xpub" {
+				require.Equal(t, descriptorWithChecksum("tr("+bip86AccountPub+"/0/*)"), exported)
+			} else {
+				require.Equal(t, desc, exported)
+			}
+		})
+	}
+
+	// the keys of other indexes differ and keep the account
+	kr := NewInMemory(getCodec())
+	_, err := kr.(BitcoinImporter).SaveWatchOnlyKey("watch", desc, 1)
+	require.NoError(t, err)
+	k, err := kr.Key("watch")
+	require.NoError(t, err)
+	require.Equal(t, "m/86'/0'/0'/0/1", k.GetOffline().KeyOrigin.Path)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+	require.NotEqual(t, bip86OutputKey, hex.EncodeToString(addr))
+}
+
+func TestBitcoinTaprootDerivation(t *testing.T) {
+	desc := descriptorWithChecksum("tr([73c5da0a/86'/0'/0']" + bip86AccountPub + "/0/*)")
+	bip86Path := []uint32{
+		hdkeychain.HardenedKeyStart + 86, hdkeychain.HardenedKeyStart, hdkeychain.HardenedKeyStart, 0, 0,
+	}
+	account, err := parseXPub(bip86AccountPub)
+	require.NoError(t, err)
+	internalKey, err := hex.DecodeString(bip86InternalKey)
+	require.NoError(t, err)
+
+	// the keys share their address, each is saved in its own keyring
+	keyrings := map[string]Keyring{}
+	newKeyring := func(uid string) Keyring {
+		keyrings[uid] = NewInMemory(getCodec())
+		return keyrings[uid]
+	}
+	_, err = newKeyring("mnemonic").NewAccount("mnemonic", bip86Mnemonic, "", sdk.FullFundraiserPath, hd.Taproot)
+	require.NoError(t, err)
+	_, err = newKeyring("descriptor").(BitcoinImporter).SaveWatchOnlyKey("descriptor", desc, 0)
+	require.NoError(t, err)
+	_, err = newKeyring("xpub").(BitcoinImporter).SaveWatchOnlyKey("xpub", bip86AccountPub, 0)
+	require.NoError(t, err)
+	wif, err := keyrings["mnemonic"].(BitcoinExporter).ExportWIF("mnemonic")
+	require.NoError(t, err)
+	require.NoError(t, newKeyring("wif").(BitcoinImporter).ImportWIF("wif", wif))
+
+	testCases := []struct {
+		uid         string
+		fingerprint uint32
+		path        []uint32
+	}{
+		{"mnemonic", 0x73c5da0a, bip86Path},
+		{"descriptor", 0x73c5da0a, bip86Path},
+		// the account xpub is the master key of a bare xpub
+		{"xpub", fingerprint(account), []uint32{0, 0}},
+		// a key without origin is its own master key, identified by its
+		// x-only key as in its tr(KEY) descriptor
+		{"wif", 0x342ad67d, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.uid, func(t *testing.T) {
+			derivation, err := keyrings[tc.uid].(BitcoinExporter).TaprootDerivation(tc.uid)
+			require.NoError(t, err)
+			require.Equal(t, internalKey, derivation.XOnlyPubKey)
+			require.Equal(t, tc.fingerprint, derivation.MasterKeyFingerprint)
+			require.Equal(t, tc.path, derivation.Bip32Path)
+		})
+	}
+
+	// the internal key of an offline key saved without origin is unknown
+	k, err := keyrings["mnemonic"].Key("mnemonic")
+	require.NoError(t, err)
+	pubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+	other := NewInMemory(getCodec())
+	_, err = other.SaveOfflineKey("offline", pubKey)
+	require.NoError(t, err)
+	_, err = other.(BitcoinExporter).TaprootDerivation("offline")
+	require.ErrorContains(t, err, "internal key of offline is unknown")
+}
+
+func TestDescriptorChecksum(t *testing.T) {
+	// from the descriptor documentation of Bitcoin Core
+	require.Equal(t, "raw(deadbeef)#89f8spxm", descriptorWithChecksum("raw(deadbeef)"))
+}
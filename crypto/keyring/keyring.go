@@ -21,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -222,6 +223,10 @@ func newKeystore(kr keyring.Keyring, cdc codec.Codec, backend string, opts ...Op
 		ledger.SetDiscoverLedger(options.LedgerDerivation)
 	}
 
+	if options.LedgerTaprootDerivation != nil {
+		ledger.SetDiscoverTaprootLedger(options.LedgerTaprootDerivation)
+	}
+
 	if options.LedgerCreateKey != nil {
 		ledger.SetCreatePubkey(options.LedgerCreateKey)
 	}
@@ -429,7 +434,16 @@ func (ks keystore) SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coi
 
 	hdPath := hd.NewFundraiserParams(account, coinType, index)
 
-	priv, _, err := ledger.NewPrivKeySecp256k1(*hdPath, hrp)
+	var (
+		priv types.LedgerPrivKey
+		err  error
+	)
+	switch algo.Name() {
+	case hd.TaprootType:
+		priv, _, err = ledger.NewPrivKeyTaproot(*hdPath, hrp)
+	default:
+		priv, _, err = ledger.NewPrivKeySecp256k1(*hdPath, hrp)
+	}
 	if err != nil {
 		return nil, errors.CombineErrors(ErrLedgerGenerateKey, err)
 	}
@@ -593,6 +607,13 @@ func (ks keystore) NewAccount(name, mnemonic, bip39Passphrase, hdPath string, al
 		return nil, ErrDuplicatedAddress
 	}
 
+	// @nubit: keep the BIP-32 origin of taproot keys to export them as xpubs and descriptors.
+	// The origin is optional, so keys are still created for paths hdkeychain can't follow.
+	if tpriv, ok := privKey.(*taproot.PrivKey); ok {
+		origin, _ := keyOriginFromMnemonic(mnemonic, bip39Passphrase, hdPath)
+		return ks.writeLocalKeyWithOrigin(name, tpriv, origin)
+	}
+
 	return ks.writeLocalKey(name, privKey)
 }
 
@@ -626,15 +647,22 @@ func SignWithLedger(k *Record, msg []byte, signMode signing.SignMode) (sig []byt
 
 	path := ledgerInfo.GetPath()
 
-	priv, err := ledger.NewPrivKeySecp256k1Unsafe(*path)
+	pubKey, err := k.GetPubKey()
 	if err != nil {
 		return nil, nil, err
 	}
-	ledgerPubKey := priv.PubKey()
-	pubKey, err := k.GetPubKey()
+
+	var priv types.LedgerPrivKeyAminoJSON
+	switch pubKey.(type) {
+	case *taproot.PubKey:
+		priv, err = ledger.NewPrivKeyTaprootUnsafe(*path)
+	default:
+		priv, err = ledger.NewPrivKeySecp256k1Unsafe(*path)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
+	ledgerPubKey := priv.PubKey()
 	if !pubKey.Equals(ledgerPubKey) {
 		return nil, nil, fmt.Errorf("the public key that the user attempted to sign with does not match the public key on the ledger device. %v does not match %v", pubKey.String(), ledgerPubKey.String())
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
@@ -96,6 +97,49 @@ func TestSignVerifyKeyRingWithLedger(t *testing.T) {
 	require.Equal(t, "not a ledger object", err.Error())
 }
 
+func TestSignVerifyKeyRingWithTaprootLedger(t *testing.T) {
+	cdc := getCodec()
+	kb := NewInMemory(cdc)
+
+	k, err := kb.SaveLedgerKey("key", hd.Taproot, "cosmos", types.CoinType, 0, 0)
+	if err != nil {
+		require.Equal(t, "ledger nano S: support for ledger devices is not available in this executable", err.Error())
+		t.Skip("ledger nano S: support for ledger devices is not available in this executable")
+		return
+	}
+
+	key, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.IsType(t, &taproot.PubKey{}, key)
+	require.Equal(t, "m/86'/0'/0'/0/0", k.GetLedger().GetPath().String())
+
+	d1 := []byte("my first message")
+	for _, signMode := range []signing.SignMode{signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signing.SignMode_SIGN_MODE_TEXTUAL} {
+		s1, pub1, err := kb.Sign("key", d1, signMode)
+		require.NoError(t, err)
+		require.Equal(t, key, pub1)
+		require.True(t, key.VerifySignature(d1, s1))
+	}
+
+	// the ledger record must match the key derived in software from the
+	// same mnemonic
+	localKb := NewInMemory(cdc)
+	local, err := localKb.NewAccount("local", testdata.TestMnemonic, DefaultBIP39Passphrase, "m/86'/0'/0'/0/0", hd.Taproot)
+	require.NoError(t, err)
+	localKey, err := local.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, key.Equals(localKey))
+
+	// the internal key is read from the device, on the path of the record
+	localDerivation, err := localKb.(BitcoinExporter).TaprootDerivation("local")
+	require.NoError(t, err)
+	derivation, err := kb.(BitcoinExporter).TaprootDerivation("key")
+	require.NoError(t, err)
+	require.Equal(t, localDerivation.XOnlyPubKey, derivation.XOnlyPubKey)
+	require.Equal(t, localDerivation.Bip32Path, derivation.Bip32Path)
+	require.Zero(t, derivation.MasterKeyFingerprint)
+}
+
 func TestAltKeyring_SaveLedgerKey(t *testing.T) {
 	dir := t.TempDir()
 	cdc := getCodec()
@@ -31,6 +31,8 @@ type Options struct {
 	SupportedAlgosLedger SigningAlgoList
 	// define Ledger Derivation function
 	LedgerDerivation func() (ledger.SECP256K1, error)
+	// define Ledger Derivation function for Taproot keys
+	LedgerTaprootDerivation func() (ledger.TAPROOT, error)
 	// define Ledger key generation function
 	LedgerCreateKey func([]byte) types.PubKey
 	// define Ledger app name
@@ -0,0 +1,143 @@
+package keyring
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/99designs/keyring"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
+)
+
+// MuSig2Signer is implemented by key stores whose local taproot keys can take
+// part in MuSig2 signing sessions. A session runs in two rounds: every
+// co-signer first shares the public nonce returned by MuSig2Nonce, then the
+// partial signatures returned by MuSig2PartialSign are combined with
+// taproot.MuSig2PubKey.CombineSignatures.
+type MuSig2Signer interface {
+	// MuSig2Nonce starts a session signing msg with the local key uid on behalf
+	// of pubKey and returns the public nonce to share with the other
+	// co-signers. The secret nonce is kept in the key store until it is used.
+	MuSig2Nonce(uid string, pubKey *taproot.MuSig2PubKey, msg []byte, format taproot.MuSig2SignatureFormat) ([]byte, error)
+	// MuSig2PartialSign returns the partial signature of the local key uid,
+	// given the public nonces of all co-signers in the order of pubKey.PubKeys.
+	// The secret nonce of the session is deleted before signing, so a session
+	// can never be signed twice.
+	MuSig2PartialSign(uid string, pubKey *taproot.MuSig2PubKey, msg []byte, format taproot.MuSig2SignatureFormat, pubNonces [][]byte) ([]byte, error)
+}
+
+var _ MuSig2Signer = keystore{}
+
+func (ks keystore) MuSig2Nonce(uid string, pubKey *taproot.MuSig2PubKey, msg []byte, format taproot.MuSig2SignatureFormat) ([]byte, error) {
+	priv, err := ks.muSig2PrivKey(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := pubKey.SigningDigest(msg, format)
+	if err != nil {
+		return nil, err
+	}
+
+	pubNonce, secNonce, err := taproot.MuSig2GenNonce(priv, pubKey, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	// the secret nonce is bound to the digest and the aggregate key it was
+	// generated for, so it can't be used to sign anything else
+	data := make([]byte, 0, len(secNonce)+len(digest)+len(pubKey.Address()))
+	data = append(data, secNonce...)
+	data = append(data, digest[:]...)
+	data = append(data, pubKey.Address()...)
+
+	if err := ks.SetItem(keyring.Item{Key: muSig2NonceKey(pubNonce), Data: data}); err != nil {
+		return nil, err
+	}
+
+	return pubNonce, nil
+}
+
+func (ks keystore) MuSig2PartialSign(
+	uid string, pubKey *taproot.MuSig2PubKey, msg []byte, format taproot.MuSig2SignatureFormat, pubNonces [][]byte,
+) ([]byte, error) {
+	priv, err := ks.muSig2PrivKey(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pubNonces) != len(pubKey.PubKeys) {
+		return nil, fmt.Errorf("expected %d public nonces, got %d", len(pubKey.PubKeys), len(pubNonces))
+	}
+
+	idx := -1
+	for i, pk := range pubKey.PubKeys {
+		if pk.Equals(priv.PubKey()) {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return nil, fmt.Errorf("key %s is not a co-signer of the MuSig2 key", uid)
+	}
+
+	nonceKey := muSig2NonceKey(pubNonces[idx])
+	item, err := ks.db.Get(nonceKey)
+	if err != nil {
+		if errors.Is(err, keyring.ErrKeyNotFound) {
+			return nil, fmt.Errorf("no pending MuSig2 session for the nonce of key %s", uid)
+		}
+		return nil, err
+	}
+
+	digest, err := pubKey.SigningDigest(msg, format)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(item.Data) <= taproot.MuSig2SecNonceSize+len(digest) {
+		return nil, errors.New("corrupted MuSig2 session")
+	}
+	secNonce := item.Data[:taproot.MuSig2SecNonceSize]
+	sessionDigest := item.Data[taproot.MuSig2SecNonceSize : taproot.MuSig2SecNonceSize+len(digest)]
+	address := item.Data[taproot.MuSig2SecNonceSize+len(digest):]
+	if !bytes.Equal(sessionDigest, digest[:]) || !bytes.Equal(address, pubKey.Address()) {
+		return nil, errors.New("the MuSig2 nonce was generated for another message or key")
+	}
+
+	// never reuse a secret nonce, even if signing fails
+	if err := ks.db.Remove(nonceKey); err != nil {
+		return nil, err
+	}
+
+	return taproot.MuSig2PartialSign(priv, secNonce, pubKey, pubNonces, digest)
+}
+
+func (ks keystore) muSig2PrivKey(uid string) (*taproot.PrivKey, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.GetLocal() == nil {
+		return nil, fmt.Errorf("MuSig2 signing requires a local key, %s is a %s key", uid, k.GetType())
+	}
+
+	priv, err := extractPrivKeyFromLocal(k.GetLocal())
+	if err != nil {
+		return nil, err
+	}
+
+	tpriv, ok := priv.(*taproot.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("MuSig2 signing requires a taproot key, %s is a %s key", uid, priv.Type())
+	}
+
+	return tpriv, nil
+}
+
+func muSig2NonceKey(pubNonce []byte) string {
+	return fmt.Sprintf("%s.%s", hex.EncodeToString(pubNonce), muSig2NonceSuffix)
+}
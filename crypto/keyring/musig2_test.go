@@ -0,0 +1,103 @@
+package keyring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMuSig2Session(t *testing.T) {
+	cdc := getCodec()
+	names := []string{"alice", "bob", "carol"}
+
+	// every co-signer holds its own keyring
+	keyrings := make(map[string]Keyring, len(names))
+	pubKeys := make([]cryptotypes.PubKey, len(names))
+	for i, name := range names {
+		keyrings[name] = NewInMemory(cdc)
+		k, _, err := keyrings[name].NewMnemonic(name, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Taproot)
+		require.NoError(t, err)
+		pubKeys[i], err = k.GetPubKey()
+		require.NoError(t, err)
+	}
+
+	pk, err := taproot.NewMuSig2PubKey(pubKeys)
+	require.NoError(t, err)
+
+	// the MuSig2 key is stored as a multi record and restored from the keyring
+	record, err := keyrings["alice"].SaveMultisig("shared", pk)
+	require.NoError(t, err)
+	require.Equal(t, TypeMulti, record.GetType())
+	restored, err := keyrings["alice"].Key("shared")
+	require.NoError(t, err)
+	restoredPk, err := restored.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, pk.Equals(restoredPk))
+	addr, err := restored.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, pk.Address().Bytes(), addr.Bytes())
+
+	pubKey := restoredPk.(*taproot.MuSig2PubKey)
+	signerOf := func(i int) string {
+		for _, name := range names {
+			k, err := keyrings[name].Key(name)
+			require.NoError(t, err)
+			kpk, err := k.GetPubKey()
+			require.NoError(t, err)
+			if kpk.Equals(pubKey.PubKeys[i]) {
+				return name
+			}
+		}
+		t.Fatalf("no co-signer for key %d", i)
+		return ""
+	}
+
+	msg := []byte("sign bytes")
+	for _, format := range []taproot.MuSig2SignatureFormat{taproot.MuSig2Schnorr, taproot.MuSig2BIP322} {
+		pubNonces := make([][]byte, len(names))
+		for i := range pubNonces {
+			name := signerOf(i)
+			pubNonces[i], err = keyrings[name].(MuSig2Signer).MuSig2Nonce(name, pubKey, msg, format)
+			require.NoError(t, err)
+		}
+
+		partialSigs := make([][]byte, len(names))
+		for i := range partialSigs {
+			name := signerOf(i)
+			signer := keyrings[name].(MuSig2Signer)
+
+			// the nonce can't be used for another message
+			_, err = signer.MuSig2PartialSign(name, pubKey, []byte("other"), format, pubNonces)
+			require.ErrorContains(t, err, "another message")
+
+			partialSigs[i], err = signer.MuSig2PartialSign(name, pubKey, msg, format, pubNonces)
+			require.NoError(t, err)
+
+			// the secret nonce is deleted once used
+			_, err = signer.MuSig2PartialSign(name, pubKey, msg, format, pubNonces)
+			require.ErrorContains(t, err, "no pending MuSig2 session")
+		}
+
+		sig, err := pubKey.CombineSignatures(msg, format, pubNonces, partialSigs)
+		require.NoError(t, err)
+		require.True(t, pubKey.VerifySignature(msg, sig))
+	}
+
+	// session nonces are not listed as keys
+	list, err := keyrings["alice"].List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+
+	// only local co-signer keys can sign
+	_, err = keyrings["alice"].(MuSig2Signer).MuSig2Nonce("shared", pubKey, msg, taproot.MuSig2Schnorr)
+	require.ErrorContains(t, err, "requires a local key")
+	_, _, err = keyrings["alice"].NewMnemonic("outsider", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Taproot)
+	require.NoError(t, err)
+	_, err = keyrings["alice"].(MuSig2Signer).MuSig2Nonce("outsider", pubKey, msg, taproot.MuSig2Schnorr)
+	require.ErrorContains(t, err, "not a co-signer")
+}
@@ -36,7 +36,7 @@ func NewLocalRecord(name string, priv cryptotypes.PrivKey, pk cryptotypes.PubKey
 		return nil, err
 	}
 
-	recordLocal := &Record_Local{any}
+	recordLocal := &Record_Local{PrivKey: any}
 	recordLocalItem := &Record_Local_{recordLocal}
 
 	return newRecord(name, pk, recordLocalItem)
@@ -4,6 +4,7 @@
 package keyring
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	hd "github.com/cosmos/cosmos-sdk/crypto/hd"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -147,6 +148,8 @@ func (*Record) XXX_OneofWrappers() []interface{} {
 // Local item
 type Record_Local struct {
 	PrivKey *any.Any `protobuf:"bytes,1,opt,name=priv_key,json=privKey,proto3" json:"priv_key,omitempty"`
+	// key_origin is the BIP-32 origin of the key, if it was derived from an extended key.
+	KeyOrigin *Record_KeyOrigin `protobuf:"bytes,2,opt,name=key_origin,json=keyOrigin,proto3" json:"key_origin,omitempty"`
 }
 
 func (m *Record_Local) Reset()         { *m = Record_Local{} }
@@ -259,6 +262,8 @@ var xxx_messageInfo_Record_Multi proto.InternalMessageInfo
 
 // Offline item
 type Record_Offline struct {
+	// key_origin is the BIP-32 origin of a watch-only key derived from an extended public key.
+	KeyOrigin *Record_KeyOrigin `protobuf:"bytes,1,opt,name=key_origin,json=keyOrigin,proto3" json:"key_origin,omitempty"`
 }
 
 func (m *Record_Offline) Reset()         { *m = Record_Offline{} }
@@ -294,12 +299,58 @@ func (m *Record_Offline) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Record_Offline proto.InternalMessageInfo
 
+// KeyOrigin is the BIP-32 origin of a key, used to export it as an extended
+// public key or an output descriptor.
+type Record_KeyOrigin struct {
+	// fingerprint is the fingerprint of the master key, zero if unknown.
+	Fingerprint uint32 `protobuf:"fixed32,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// path is the derivation path of the key from the master key, e.g. m/86'/0'/0'/0/0.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// account_xpub is the serialized extended public key of the account the key
+	// is derived from by the last two levels of path, empty if unknown.
+	AccountXpub string `protobuf:"bytes,3,opt,name=account_xpub,json=accountXpub,proto3" json:"account_xpub,omitempty"`
+}
+
+func (m *Record_KeyOrigin) Reset()         { *m = Record_KeyOrigin{} }
+func (m *Record_KeyOrigin) String() string { return proto.CompactTextString(m) }
+func (*Record_KeyOrigin) ProtoMessage()    {}
+func (*Record_KeyOrigin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36d640103edea005, []int{0, 4}
+}
+func (m *Record_KeyOrigin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Record_KeyOrigin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Record_KeyOrigin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Record_KeyOrigin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Record_KeyOrigin.Merge(m, src)
+}
+func (m *Record_KeyOrigin) XXX_Size() int {
+	return m.Size()
+}
+func (m *Record_KeyOrigin) XXX_DiscardUnknown() {
+	xxx_messageInfo_Record_KeyOrigin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Record_KeyOrigin proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Record)(nil), "cosmos.crypto.keyring.v1.Record")
 	proto.RegisterType((*Record_Local)(nil), "cosmos.crypto.keyring.v1.Record.Local")
 	proto.RegisterType((*Record_Ledger)(nil), "cosmos.crypto.keyring.v1.Record.Ledger")
 	proto.RegisterType((*Record_Multi)(nil), "cosmos.crypto.keyring.v1.Record.Multi")
 	proto.RegisterType((*Record_Offline)(nil), "cosmos.crypto.keyring.v1.Record.Offline")
+	proto.RegisterType((*Record_KeyOrigin)(nil), "cosmos.crypto.keyring.v1.Record.KeyOrigin")
 }
 
 func init() {
@@ -307,33 +358,39 @@ func init() {
 }
 
 var fileDescriptor_36d640103edea005 = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbd, 0xae, 0xd3, 0x30,
-	0x1c, 0xc5, 0x1d, 0x6e, 0x3e, 0xb8, 0x66, 0xb3, 0xee, 0x10, 0x22, 0x64, 0x55, 0x48, 0x40, 0x25,
-	0x54, 0x5b, 0x85, 0x0e, 0x4c, 0x95, 0x5a, 0x31, 0x14, 0x95, 0x8a, 0x2a, 0x23, 0x0b, 0xca, 0x87,
-	0x9b, 0x44, 0x4d, 0xe2, 0xc8, 0x49, 0x2a, 0xe5, 0x2d, 0x18, 0x79, 0xa4, 0x8e, 0x1d, 0x19, 0xa1,
-	0xd9, 0x78, 0x0a, 0x64, 0x3b, 0x1d, 0xa8, 0x04, 0x65, 0x8a, 0x23, 0xff, 0xce, 0xff, 0x9c, 0x63,
-	0xfd, 0xe1, 0x8b, 0x88, 0xd7, 0x05, 0xaf, 0x69, 0x24, 0xba, 0xaa, 0xe1, 0x74, 0xcf, 0x3a, 0x91,
-	0x95, 0x09, 0x3d, 0x4c, 0xa9, 0x60, 0x11, 0x17, 0x31, 0xa9, 0x04, 0x6f, 0x38, 0x72, 0x35, 0x46,
-	0x34, 0x46, 0x06, 0x8c, 0x1c, 0xa6, 0xde, 0x43, 0xc2, 0x13, 0xae, 0x20, 0x2a, 0x4f, 0x9a, 0xf7,
-	0x9e, 0x26, 0x9c, 0x27, 0x39, 0xa3, 0xea, 0x2f, 0x6c, 0x77, 0x34, 0x28, 0xbb, 0xe1, 0xea, 0xd9,
-	0x9f, 0x8e, 0x69, 0x2c, 0xcd, 0xd2, 0xc1, 0xe8, 0xf9, 0xaf, 0x3b, 0x68, 0xfb, 0xca, 0x19, 0x21,
-	0x68, 0x96, 0x41, 0xc1, 0x5c, 0x63, 0x64, 0x8c, 0xef, 0x7d, 0x75, 0x46, 0x13, 0xe8, 0x54, 0x6d,
-	0xf8, 0x65, 0xcf, 0x3a, 0xf7, 0xd1, 0xc8, 0x18, 0x3f, 0x79, 0xf3, 0x40, 0xb4, 0x13, 0xb9, 0x38,
-	0x91, 0x45, 0xd9, 0xf9, 0x76, 0xd5, 0x86, 0x6b, 0xd6, 0xa1, 0x39, 0xb4, 0x72, 0x1e, 0x05, 0xb9,
-	0x7b, 0xa7, 0xe0, 0x97, 0xe4, 0x6f, 0x35, 0x88, 0xf6, 0x24, 0x1f, 0x25, 0xbd, 0x02, 0xbe, 0x96,
-	0xa1, 0x05, 0xb4, 0x73, 0x16, 0x27, 0x4c, 0xb8, 0xa6, 0x1a, 0xf0, 0xea, 0xf6, 0x00, 0x85, 0xaf,
-	0x80, 0x3f, 0x08, 0x65, 0x84, 0xa2, 0xcd, 0x9b, 0xcc, 0xb5, 0xfe, 0x33, 0xc2, 0x46, 0xd2, 0x32,
-	0x82, 0x92, 0xa1, 0xf7, 0xd0, 0xe1, 0xbb, 0x5d, 0x9e, 0x95, 0xcc, 0xb5, 0xd5, 0x84, 0xf1, 0xcd,
-	0x09, 0x9f, 0x34, 0xbf, 0x02, 0xfe, 0x45, 0xea, 0xbd, 0x83, 0x96, 0xaa, 0x86, 0x28, 0x7c, 0x5c,
-	0x89, 0xec, 0xa0, 0x5e, 0xd0, 0xf8, 0xc7, 0x0b, 0x3a, 0x92, 0x5a, 0xb3, 0xce, 0x9b, 0x43, 0x5b,
-	0x77, 0x42, 0x33, 0x68, 0x56, 0x41, 0x93, 0x0e, 0xb2, 0xd1, 0x55, 0x8c, 0x34, 0x96, 0x09, 0x96,
-	0x1f, 0xb6, 0xb3, 0xd9, 0x36, 0x10, 0x41, 0x51, 0xfb, 0x8a, 0xf6, 0x1c, 0x68, 0xa9, 0x46, 0xde,
-	0x3d, 0x74, 0x86, 0x60, 0x4b, 0x1b, 0x9a, 0x59, 0xc3, 0x8a, 0xe5, 0xe6, 0xf8, 0x13, 0x83, 0xe3,
-	0x19, 0x1b, 0xa7, 0x33, 0x36, 0x7e, 0x9c, 0xb1, 0xf1, 0xb5, 0xc7, 0xe0, 0x5b, 0x8f, 0xc1, 0xa9,
-	0xc7, 0xe0, 0x7b, 0x8f, 0xc1, 0xe7, 0xd7, 0x49, 0xd6, 0xa4, 0x6d, 0x48, 0x22, 0x5e, 0xd0, 0xcb,
-	0xde, 0xa8, 0xcf, 0xa4, 0x8e, 0xf7, 0x57, 0x4b, 0x1b, 0xda, 0xaa, 0xc1, 0xdb, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x47, 0x24, 0x2f, 0xa9, 0xd4, 0x02, 0x00, 0x00,
+	// 501 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xbd, 0x8e, 0xd3, 0x4c,
+	0x14, 0xb5, 0xf7, 0x4b, 0xec, 0x2f, 0x37, 0x54, 0xa3, 0x2d, 0x8c, 0x85, 0xac, 0x80, 0x04, 0x44,
+	0xa0, 0x1d, 0x6b, 0x21, 0xf5, 0x4a, 0x1b, 0x51, 0x64, 0xb5, 0xac, 0x76, 0x35, 0xa2, 0x40, 0x34,
+	0x91, 0x7f, 0x26, 0xce, 0xc8, 0x3f, 0x63, 0x4d, 0xec, 0x08, 0xd7, 0xbc, 0x00, 0x25, 0x8f, 0xb4,
+	0xe5, 0x96, 0x94, 0x90, 0x14, 0xbc, 0x06, 0x9a, 0x6b, 0x07, 0x41, 0x24, 0x08, 0x12, 0x95, 0xaf,
+	0xc7, 0xe7, 0x9e, 0x73, 0xee, 0xf1, 0x1d, 0x78, 0x1c, 0xc9, 0x55, 0x2e, 0x57, 0x7e, 0xa4, 0x9a,
+	0xb2, 0x92, 0x7e, 0xca, 0x1b, 0x25, 0x8a, 0xc4, 0x5f, 0x9f, 0xfa, 0x8a, 0x47, 0x52, 0xc5, 0xb4,
+	0x54, 0xb2, 0x92, 0xc4, 0x69, 0x61, 0xb4, 0x85, 0xd1, 0x0e, 0x46, 0xd7, 0xa7, 0xee, 0x71, 0x22,
+	0x13, 0x89, 0x20, 0x5f, 0x57, 0x2d, 0xde, 0xbd, 0x9f, 0x48, 0x99, 0x64, 0xdc, 0xc7, 0xb7, 0xb0,
+	0x5e, 0xf8, 0x41, 0xd1, 0x74, 0x9f, 0x1e, 0xfc, 0xaa, 0xb8, 0x8c, 0xb5, 0xd8, 0xb2, 0x13, 0x7a,
+	0xf4, 0xad, 0x0f, 0x16, 0x43, 0x65, 0x42, 0xa0, 0x57, 0x04, 0x39, 0x77, 0xcc, 0x91, 0x39, 0x1e,
+	0x30, 0xac, 0xc9, 0x09, 0xd8, 0x65, 0x1d, 0xce, 0x53, 0xde, 0x38, 0x47, 0x23, 0x73, 0x3c, 0x7c,
+	0x71, 0x4c, 0x5b, 0x25, 0xba, 0x53, 0xa2, 0xe7, 0x45, 0xc3, 0xac, 0xb2, 0x0e, 0x2f, 0x79, 0x43,
+	0xce, 0xa0, 0x9f, 0xc9, 0x28, 0xc8, 0x9c, 0xff, 0x10, 0xfc, 0x84, 0xfe, 0x6e, 0x0c, 0xda, 0x6a,
+	0xd2, 0xd7, 0x1a, 0x3d, 0x33, 0x58, 0xdb, 0x46, 0xce, 0xc1, 0xca, 0x78, 0x9c, 0x70, 0xe5, 0xf4,
+	0x90, 0xe0, 0xe9, 0x61, 0x02, 0x84, 0xcf, 0x0c, 0xd6, 0x35, 0x6a, 0x0b, 0x79, 0x9d, 0x55, 0xc2,
+	0xe9, 0xff, 0xa5, 0x85, 0x2b, 0x8d, 0xd6, 0x16, 0xb0, 0x8d, 0xbc, 0x02, 0x5b, 0x2e, 0x16, 0x99,
+	0x28, 0xb8, 0x63, 0x21, 0xc3, 0xf8, 0x20, 0xc3, 0x75, 0x8b, 0x9f, 0x19, 0x6c, 0xd7, 0xea, 0x7e,
+	0x30, 0xa1, 0x8f, 0xb3, 0x11, 0x1f, 0xfe, 0x2f, 0x95, 0x58, 0x63, 0x84, 0xe6, 0x1f, 0x22, 0xb4,
+	0x35, 0x4a, 0x67, 0x78, 0x01, 0x90, 0xf2, 0x66, 0x2e, 0x95, 0x48, 0x44, 0xd1, 0xa5, 0xfe, 0xec,
+	0xa0, 0x87, 0x4b, 0xde, 0x5c, 0x63, 0x07, 0x1b, 0xa4, 0xbb, 0xd2, 0x3d, 0x03, 0xab, 0xcd, 0x87,
+	0x4c, 0xa0, 0x57, 0x06, 0xd5, 0xb2, 0x73, 0x30, 0xda, 0xa3, 0x5b, 0xc6, 0x9a, 0x69, 0x7a, 0x71,
+	0x33, 0x99, 0xdc, 0x04, 0x2a, 0xc8, 0x57, 0x0c, 0xd1, 0xae, 0x0d, 0x7d, 0x4c, 0xc7, 0x7d, 0x03,
+	0x76, 0x37, 0xe4, 0x9e, 0x3d, 0xf3, 0x5f, 0xec, 0xc5, 0x30, 0xf8, 0x71, 0x4e, 0x46, 0x30, 0x5c,
+	0x88, 0x22, 0xe1, 0xaa, 0x54, 0xa2, 0xa8, 0x90, 0xd8, 0x66, 0x3f, 0x1f, 0xe9, 0xfd, 0xc4, 0x19,
+	0x8e, 0xda, 0xfd, 0xd4, 0x35, 0x79, 0x08, 0xf7, 0x82, 0x28, 0x92, 0x75, 0x51, 0xcd, 0xdf, 0x97,
+	0x75, 0x88, 0x7b, 0x37, 0x60, 0xc3, 0xee, 0xec, 0x6d, 0x59, 0x87, 0x53, 0x0b, 0x7a, 0xa2, 0xe2,
+	0xf9, 0xf4, 0xea, 0xf6, 0xab, 0x67, 0xdc, 0x6e, 0x3c, 0xf3, 0x6e, 0xe3, 0x99, 0x5f, 0x36, 0x9e,
+	0xf9, 0x71, 0xeb, 0x19, 0x9f, 0xb6, 0x9e, 0x71, 0xb7, 0xf5, 0x8c, 0xcf, 0x5b, 0xcf, 0x78, 0xf7,
+	0x3c, 0x11, 0xd5, 0xb2, 0x0e, 0x69, 0x24, 0x73, 0x7f, 0x77, 0x69, 0xf0, 0x71, 0xb2, 0x8a, 0xd3,
+	0xbd, 0x1b, 0x1b, 0x5a, 0xf8, 0xf7, 0x5e, 0x7e, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x22, 0x01, 0xc2,
+	0x84, 0xd1, 0x03, 0x00, 0x00,
 }
 
 func (m *Record) Marshal() (dAtA []byte, err error) {
@@ -491,6 +548,18 @@ func (m *Record_Local) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.KeyOrigin != nil {
+		{
+			size, err := m.KeyOrigin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.PrivKey != nil {
 		{
 			size, err := m.PrivKey.MarshalToSizedBuffer(dAtA[:i])
@@ -584,6 +653,61 @@ func (m *Record_Offline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.KeyOrigin != nil {
+		{
+			size, err := m.KeyOrigin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Record_KeyOrigin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Record_KeyOrigin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Record_KeyOrigin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountXpub) > 0 {
+		i -= len(m.AccountXpub)
+		copy(dAtA[i:], m.AccountXpub)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.AccountXpub)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Fingerprint != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.Fingerprint))
+		i--
+		dAtA[i] = 0xd
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -676,6 +800,10 @@ func (m *Record_Local) Size() (n int) {
 		l = m.PrivKey.Size()
 		n += 1 + l + sovRecord(uint64(l))
 	}
+	if m.KeyOrigin != nil {
+		l = m.KeyOrigin.Size()
+		n += 1 + l + sovRecord(uint64(l))
+	}
 	return n
 }
 
@@ -707,6 +835,30 @@ func (m *Record_Offline) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.KeyOrigin != nil {
+		l = m.KeyOrigin.Size()
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	return n
+}
+
+func (m *Record_KeyOrigin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fingerprint != 0 {
+		n += 5
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	l = len(m.AccountXpub)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
 	return n
 }
 
@@ -1039,6 +1191,42 @@ func (m *Record_Local) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyOrigin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KeyOrigin == nil {
+				m.KeyOrigin = &Record_KeyOrigin{}
+			}
+			if err := m.KeyOrigin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
@@ -1225,6 +1413,166 @@ func (m *Record_Offline) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Offline: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyOrigin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KeyOrigin == nil {
+				m.KeyOrigin = &Record_KeyOrigin{}
+			}
+			if err := m.KeyOrigin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Record_KeyOrigin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyOrigin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			m.Fingerprint = 0
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountXpub", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountXpub = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
@@ -0,0 +1,30 @@
+package keyring
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// SchnorrSigner is implemented by key stores whose local taproot keys can sign
+// with a raw BIP-340 Schnorr signature instead of a BIP-322 one. Both are
+// accepted by taproot.PubKey.VerifySignature, but a Schnorr signature is
+// cheaper to verify and charged the SigVerifyCostSchnorr auth parameter.
+type SchnorrSigner interface {
+	// SignSchnorr signs msg with the local taproot key uid.
+	SignSchnorr(uid string, msg []byte) ([]byte, types.PubKey, error)
+}
+
+var _ SchnorrSigner = keystore{}
+
+func (ks keystore) SignSchnorr(uid string, msg []byte) ([]byte, types.PubKey, error) {
+	priv, err := ks.taprootPrivKey(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig, err := priv.SignSchnorr(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sig, priv.PubKey(), nil
+}
@@ -0,0 +1,32 @@
+package keyring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSignSchnorr(t *testing.T) {
+	kr := NewInMemory(getCodec())
+	_, _, err := kr.NewMnemonic("taproot", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Taproot)
+	require.NoError(t, err)
+
+	signer, ok := kr.(SchnorrSigner)
+	require.True(t, ok)
+
+	msg := []byte("message")
+	sig, pubKey, err := signer.SignSchnorr("taproot", msg)
+	require.NoError(t, err)
+	require.True(t, taproot.IsSchnorrSignature(sig))
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// keys which aren't local taproot keys can't sign
+	_, err = kr.SaveOfflineKey("offline", pubKey)
+	require.NoError(t, err)
+	_, _, err = signer.SignSchnorr("offline", msg)
+	require.ErrorContains(t, err, "works only for Local")
+}
@@ -38,6 +38,7 @@ const (
 	defaultEntropySize = 256
 	addressSuffix      = "address"
 	infoSuffix         = "info"
+	muSig2NonceSuffix  = "musig2nonce"
 )
 
 // KeyType reflects a human-readable type for key listing.
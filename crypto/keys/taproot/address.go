@@ -0,0 +1,55 @@
+package taproot
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// EncodeSegwitAddress encodes address bytes as a native SegWit address of
+// net, given their length: 20-byte addresses, such as the ones of secp256k1
+// and ed25519 keys, as SegWit v0 P2WPKH addresses and 32-byte addresses as
+// SegWit v1 P2TR addresses.
+func EncodeSegwitAddress(bz []byte, net *chaincfg.Params) (string, error) {
+	var (
+		addr btcutil.Address
+		err  error
+	)
+
+	switch len(bz) {
+	case 20:
+		addr, err = btcutil.NewAddressWitnessPubKeyHash(bz, net)
+	case 32:
+		addr, err = btcutil.NewAddressTaproot(bz, net)
+	default:
+		return "", fmt.Errorf("invalid address length: %d", len(bz))
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return addr.EncodeAddress(), nil
+}
+
+// DecodeSegwitAddress decodes a P2WPKH or a P2TR address of net into its
+// witness program, the inverse of EncodeSegwitAddress. P2WSH addresses are
+// rejected: their 32-byte witness program is a script hash, which would be
+// encoded back as, and credited to, the P2TR address of an unrelated output
+// key.
+func DecodeSegwitAddress(text string, net *chaincfg.Params) ([]byte, error) {
+	addr, err := btcutil.DecodeAddress(text, net)
+	if err != nil {
+		return nil, err
+	}
+
+	switch addr := addr.(type) {
+	case *btcutil.AddressWitnessPubKeyHash:
+		return addr.WitnessProgram(), nil
+	case *btcutil.AddressTaproot:
+		return addr.WitnessProgram(), nil
+	default:
+		return nil, errors.New("address is neither a P2WPKH nor a P2TR address")
+	}
+}
@@ -28,3 +28,18 @@ func BenchmarkVerification(b *testing.B) {
 	priv := GenPrivKey()
 	benchmarking.BenchmarkVerification(b, priv)
 }
+
+func BenchmarkSchnorrVerification(b *testing.B) {
+	b.ReportAllocs()
+	priv := GenPrivKey()
+	pub := priv.PubKey()
+	message := []byte("Hello, world!")
+	signature, err := priv.SignSchnorr(message)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pub.VerifySignature(message, signature)
+	}
+}
@@ -1,15 +1,24 @@
 package taproot
 
 import (
+	"bytes"
+	"errors"
 	fmt "fmt"
 
 	"github.com/babylonlabs-io/babylon/crypto/bip322"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
 
 	secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
 )
 
+// Bip322Verify verifies a BIP-322 simple signature of msg by pubKey, which is
+// the only format of transaction signatures: the full and legacy formats are
+// verified offchain by Bip322VerifyOutputKey.
 func Bip322Verify(
 	msg []byte,
 	signature []byte,
@@ -21,20 +30,98 @@ func Bip322Verify(
 		return false, err
 	}
 
-	witness, err := bip322.SimpleSigToWitness(signature)
+	witness, err := parseSimpleSig(signature)
 	if err != nil {
 		return false, err
 	}
 
-	err = bip322.Verify(msg, witness, address, net)
-	if err != nil {
-		fmt.Println("error", err.Error())
+	if err := bip322.Verify(msg, witness, address, net); err != nil {
 		return false, err
 	}
 
 	return true, nil
 }
 
+// Bip322Format is the encoding of a BIP-322 signature.
+type Bip322Format int
+
+const (
+	// Bip322FormatUnknown is not a BIP-322 signature.
+	Bip322FormatUnknown Bip322Format = iota
+	// Bip322FormatSimple is the consensus encoded witness stack of the
+	// to_sign transaction input.
+	Bip322FormatSimple
+	// Bip322FormatFull is the consensus encoded to_sign transaction.
+	Bip322FormatFull
+	// Bip322FormatLegacy is a 65-byte compact ECDSA signature of the Bitcoin
+	// Core signmessage RPC.
+	Bip322FormatLegacy
+)
+
+func (f Bip322Format) String() string {
+	switch f {
+	case Bip322FormatSimple:
+		return "simple"
+	case Bip322FormatFull:
+		return "full"
+	case Bip322FormatLegacy:
+		return "legacy"
+	default:
+		return "unknown"
+	}
+}
+
+// DetectBip322Format returns the format signature is encoded in.
+func DetectBip322Format(signature []byte) Bip322Format {
+	if len(signature) == legacySignatureSize && signature[0] >= 27 && signature[0] <= 42 {
+		return Bip322FormatLegacy
+	}
+
+	if _, err := parseSimpleSig(signature); err == nil {
+		return Bip322FormatSimple
+	}
+
+	if _, err := parseFullSig(signature); err == nil {
+		return Bip322FormatFull
+	}
+
+	return Bip322FormatUnknown
+}
+
+// Bip322VerifyOutputKey verifies a BIP-322 signature of msg in any format by
+// the P2TR output of the 32-byte x-only outputKey. A legacy signature is valid
+// if it is made by the BIP-86 internal key of outputKey, as the signmessage
+// RPC only supports P2PKH keys. It verifies offchain proofs of address
+// ownership, transaction signatures are verified by PubKey.VerifySignature.
+func Bip322VerifyOutputKey(msg, signature, outputKey []byte, net *chaincfg.Params) error {
+	address, err := TweakedPubKeyToP2trAddress(outputKey, net)
+	if err != nil {
+		return err
+	}
+
+	switch DetectBip322Format(signature) {
+	case Bip322FormatSimple:
+		witness, err := parseSimpleSig(signature)
+		if err != nil {
+			return err
+		}
+		return bip322.Verify(msg, witness, address, net)
+
+	case Bip322FormatFull:
+		toSign, err := parseFullSig(signature)
+		if err != nil {
+			return err
+		}
+		return bip322VerifyFull(msg, toSign, address)
+
+	case Bip322FormatLegacy:
+		return bip322VerifyLegacy(msg, signature, outputKey)
+
+	default:
+		return errors.New("unknown BIP-322 signature format")
+	}
+}
+
 func Bip322Sign(msg []byte, privKey *secp256k1.PrivateKey, net *chaincfg.Params) ([]byte, error) {
 	emptySignature := []byte{}
 	address, err := PubKeyToP2trAddress(privKey.PubKey(), net)
@@ -74,3 +161,154 @@ func Bip322Sign(msg []byte, privKey *secp256k1.PrivateKey, net *chaincfg.Params)
 
 	return signature, nil
 }
+
+// Bip322SigHash returns the key spend sighash a BIP-322 simple signature of msg
+// commits to, for the P2TR output of the 32-byte x-only outputKey. Signing it
+// with BIP-340 and wrapping the result with Bip322SimpleSigFromSchnorr yields
+// the same signature as Bip322Sign, which lets keys that cannot run Bip322Sign
+// themselves, such as MuSig2 aggregates, produce BIP-322 signatures.
+func Bip322SigHash(msg, outputKey []byte, net *chaincfg.Params) ([]byte, error) {
+	address, err := TweakedPubKeyToP2trAddress(outputKey, net)
+	if err != nil {
+		return nil, err
+	}
+
+	pkScript, err := TweakedPubKeyToTaprootScript(outputKey)
+	if err != nil {
+		return nil, err
+	}
+
+	toSpend, err := bip322.GetToSpendTx(msg, address)
+	if err != nil {
+		return nil, err
+	}
+
+	toSign := bip322.GetToSignTx(toSpend)
+
+	prevFetcher := txscript.NewCannedPrevOutputFetcher(
+		pkScript, 0,
+	)
+	sigHashes := txscript.NewTxSigHashes(toSign, prevFetcher)
+
+	return txscript.CalcTaprootSignatureHash(
+		sigHashes, txscript.SigHashDefault, toSign, 0, prevFetcher,
+	)
+}
+
+// Bip322SimpleSigFromSchnorr encodes a 64-byte key spend Schnorr signature of a
+// Bip322SigHash as a BIP-322 simple signature.
+func Bip322SimpleSigFromSchnorr(sig []byte) ([]byte, error) {
+	if !IsSchnorrSignature(sig) {
+		return nil, fmt.Errorf("invalid schnorr signature length %d", len(sig))
+	}
+
+	return bip322.SerializeWitness(wire.TxWitness{sig})
+}
+
+// legacySignatureSize is the size of a signmessage compact signature: the
+// recovery header followed by r and s.
+const legacySignatureSize = 65
+
+// legacyCompressedHeader is the signmessage header of a compressed P2PKH key,
+// to which the recovery id is added.
+const legacyCompressedHeader = 31
+
+// parseSimpleSig decodes a simple signature, rejecting trailing bytes.
+func parseSimpleSig(signature []byte) (wire.TxWitness, error) {
+	witness, err := bip322.SimpleSigToWitness(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := bip322.SerializeWitness(witness)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(encoded, signature) {
+		return nil, errors.New("invalid simple signature encoding")
+	}
+
+	return witness, nil
+}
+
+// parseFullSig decodes a full signature, rejecting trailing bytes.
+func parseFullSig(signature []byte) (*wire.MsgTx, error) {
+	toSign := wire.NewMsgTx(0)
+	r := bytes.NewReader(signature)
+	if err := toSign.Deserialize(r); err != nil {
+		return nil, err
+	}
+	if r.Len() != 0 {
+		return nil, errors.New("invalid full signature encoding")
+	}
+
+	return toSign, nil
+}
+
+// bip322VerifyFull verifies a full signature, i.e. a to_sign transaction
+// spending the to_spend transaction of msg for address. Its version, lock
+// time and input sequence are set by the signer, while proofs of funds
+// spending additional inputs are not supported.
+func bip322VerifyFull(msg []byte, toSign *wire.MsgTx, address btcutil.Address) error {
+	toSpend, err := bip322.GetToSpendTx(msg, address)
+	if err != nil {
+		return err
+	}
+
+	expected := bip322.GetToSignTx(toSpend)
+	if len(toSign.TxIn) != 1 || toSign.TxIn[0].PreviousOutPoint != expected.TxIn[0].PreviousOutPoint ||
+		len(toSign.TxIn[0].SignatureScript) != 0 {
+		return errors.New("to_sign transaction doesn't only spend the to_spend transaction")
+	}
+	if len(toSign.TxOut) != 1 || toSign.TxOut[0].Value != 0 ||
+		!bytes.Equal(toSign.TxOut[0].PkScript, expected.TxOut[0].PkScript) {
+		return errors.New("to_sign transaction output isn't a single OP_RETURN")
+	}
+	pkScript := toSpend.TxOut[0].PkScript
+	prevFetcher := txscript.NewCannedPrevOutputFetcher(pkScript, 0)
+	vm, err := txscript.NewEngine(
+		pkScript, toSign, 0, txscript.StandardVerifyFlags, nil,
+		txscript.NewTxSigHashes(toSign, prevFetcher), 0, prevFetcher,
+	)
+	if err != nil {
+		return err
+	}
+
+	return vm.Execute()
+}
+
+// bip322VerifyLegacy verifies a signmessage signature of msg, made by the
+// internal key of outputKey. Wallets flag the address type in the header, and
+// both (r, s) and (r, N-s) are valid ECDSA signatures. Only the header of a
+// compressed P2PKH key and a low s are accepted, so that a signature has a
+// single valid encoding and can't be rewritten by a third party.
+func bip322VerifyLegacy(msg, signature, outputKey []byte) error {
+	if signature[0] < legacyCompressedHeader || signature[0] >= legacyCompressedHeader+4 {
+		return fmt.Errorf("non-canonical legacy signature header %d", signature[0])
+	}
+
+	var sigS secp256k1.ModNScalar
+	if overflow := sigS.SetByteSlice(signature[33:]); overflow || sigS.IsOverHalfOrder() {
+		return errors.New("legacy signature s value is not canonical")
+	}
+
+	internalKey, _, err := ecdsa.RecoverCompact(signature, legacyMessageHash(msg))
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(TweakPubKey(internalKey)[1:], outputKey) {
+		return errors.New("legacy signature isn't signed by the internal key")
+	}
+
+	return nil
+}
+
+// legacyMessageHash returns the digest signed by the signmessage RPC.
+func legacyMessageHash(msg []byte) []byte {
+	var buf bytes.Buffer
+	_ = wire.WriteVarString(&buf, 0, "Bitcoin Signed Message:\n")
+	_ = wire.WriteVarBytes(&buf, 0, msg)
+
+	return chainhash.DoubleHashB(buf.Bytes())
+}
@@ -1,11 +1,21 @@
 package taproot
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
 	fmt "fmt"
 	"testing"
 
+	"github.com/babylonlabs-io/babylon/crypto/bip322"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
 	secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSign(t *testing.T) {
@@ -44,3 +54,213 @@ func TestSignAndVerify(t *testing.T) {
 	}
 	fmt.Println(verified)
 }
+
+// BIP-322 test vectors, from
+// https://github.com/bitcoin/bips/blob/master/bip-0322.mediawiki#test-vectors
+const (
+	bip322P2WPKHAddress  = "bc1q9vza2e8x573nczrlzms0wvx3gsqjx7vavgkx0l"
+	bip322TaprootAddress = "bc1ppv609nr0vr25u07u95waq5lucwfm6tde4nydujnu8npg4q75mr5sxq8lt3"
+	bip322TaprootWIF     = "L3VFeEujGtevx9w18HD1fhRbCH67Az2dpCymeRE1SoPK6XQtaN2k"
+
+	// bip322TaprootSimpleSig is the simple signature of "Hello World" by
+	// bip322TaprootAddress.
+	bip322TaprootSimpleSig = "AUHd69PrJQEv+oKTfZ8l+WROBHuy9HKrbFCJu7U1iK2iiEy1vMU5EfMtjc+VSHM7aU0SDbak5IUZRVno2P5mjSafAQ=="
+	// bip322TaprootFullSig is the same signature in the full format: the
+	// to_sign transaction of the vector, with the simple signature as the
+	// witness of its input. The BIP lists no full signature of its own, as the
+	// full format of a to_sign transaction is its consensus encoding.
+	bip322TaprootFullSig = "000000000001010679db23166a7ca5a37998ba7836c33198bba97552657b128db108d29f6e6621000000000000000000010000000000000000016a0141ddebd3eb25012ffa82937d9f25f9644e047bb2f472ab6c5089bbb53588ada2884cb5bcc53911f32d8dcf9548733b694d120db6a4e485194559e8d8fe668d269f0100000000"
+	// bip322TaprootToSpend is the hash of the to_spend transaction of
+	// bip322TaprootFullSig, for "Hello World" and bip322TaprootAddress.
+	bip322TaprootToSpend = "21666e9fd208b18d127b655275a9bb9831c33678ba9879a3a57c6a1623db7906"
+)
+
+func TestBip322MessageVectors(t *testing.T) {
+	address, err := btcutil.DecodeAddress(bip322P2WPKHAddress, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		msg         string
+		messageHash string
+		toSpend     string
+		toSign      string
+	}{
+		{
+			"",
+			"c90c269c4f8fcbe6880f72a721ddfbf1914268a794cbb21cfafee13770ae19f1",
+			"c5680aa69bb8d860bf82d4e9cd3504b55dde018de765a91bb566283c545a99a7",
+			"1e9654e951a5ba44c8604c4de6c67fd78a27e81dcadcfe1edf638ba3aaebaed6",
+		},
+		{
+			"Hello World",
+			"f0eb03b1a75ac6d9847f55c624a99169b5dccba2a31f5b23bea77ba270de0a7a",
+			"b79d196740ad5217771c1098fc4a4b51e0535c32236c71f1ea4d61a2d603352b",
+			"88737ae86f2077145f93cc4b153ae9a1cb8d56afa511988c149c5c8c9d93bddf",
+		},
+	}
+
+	for _, tc := range testCases {
+		messageHash := bip322.GetBIP340TaggedHash([]byte(tc.msg))
+		require.Equal(t, tc.messageHash, hex.EncodeToString(messageHash[:]))
+
+		toSpend, err := bip322.GetToSpendTx([]byte(tc.msg), address)
+		require.NoError(t, err)
+		require.Equal(t, tc.toSpend, toSpend.TxHash().String())
+		require.Equal(t, tc.toSign, bip322.GetToSignTx(toSpend).TxHash().String())
+	}
+}
+
+func TestBip322FormatVectors(t *testing.T) {
+	net := &chaincfg.MainNetParams
+	msg := []byte("Hello World")
+
+	wif, err := btcutil.DecodeWIF(bip322TaprootWIF)
+	require.NoError(t, err)
+	pubKey := (&PrivKey{Key: wif.PrivKey.Serialize()}).PubKey().(*PubKey)
+	address, err := TweakedPubKeyToP2trAddress(pubKey.Address(), net)
+	require.NoError(t, err)
+	require.Equal(t, bip322TaprootAddress, address.EncodeAddress())
+
+	simple := mustDecodeBase64(t, bip322TaprootSimpleSig)
+	full, err := hex.DecodeString(bip322TaprootFullSig)
+	require.NoError(t, err)
+	toSign := wire.NewMsgTx(0)
+	require.NoError(t, toSign.Deserialize(bytes.NewReader(full)))
+	require.Equal(t, bip322TaprootToSpend, toSign.TxIn[0].PreviousOutPoint.Hash.String())
+
+	// a full signature of a to_sign transaction with a signer chosen lock time
+	toSpend, err := bip322.GetToSpendTx(msg, address)
+	require.NoError(t, err)
+	toSign = bip322.GetToSignTx(toSpend)
+	toSign.LockTime = 1
+	pkScript := toSpend.TxOut[0].PkScript
+	prevFetcher := txscript.NewCannedPrevOutputFetcher(pkScript, 0)
+	toSign.TxIn[0].Witness, err = txscript.TaprootWitnessSignature(
+		toSign, txscript.NewTxSigHashes(toSign, prevFetcher), 0, 0, pkScript,
+		txscript.SigHashDefault, wif.PrivKey,
+	)
+	require.NoError(t, err)
+	var fullLockTime bytes.Buffer
+	require.NoError(t, toSign.Serialize(&fullLockTime))
+
+	testCases := []struct {
+		name   string
+		sig    []byte
+		format Bip322Format
+	}{
+		{"simple", simple, Bip322FormatSimple},
+		{"full", full, Bip322FormatFull},
+		{"full with lock time", fullLockTime.Bytes(), Bip322FormatFull},
+		{"legacy", legacySign(t, wif.PrivKey, msg, 31), Bip322FormatLegacy},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.format, DetectBip322Format(tc.sig))
+			require.NoError(t, Bip322VerifyOutputKey(msg, tc.sig, pubKey.Address(), net))
+			require.Error(t, Bip322VerifyOutputKey([]byte("Hello World!"), tc.sig, pubKey.Address(), net))
+
+			// transactions only carry simple signatures
+			require.Equal(t, tc.format == Bip322FormatSimple, pubKey.VerifySignature(msg, tc.sig))
+		})
+	}
+
+	// the full signature must spend the to_spend transaction to a single
+	// OP_RETURN output
+	toSign.AddTxOut(wire.NewTxOut(1, toSpend.TxOut[0].PkScript))
+	fullLockTime.Reset()
+	require.NoError(t, toSign.Serialize(&fullLockTime))
+	require.ErrorContains(t, Bip322VerifyOutputKey(msg, fullLockTime.Bytes(), pubKey.Address(), net), "single OP_RETURN")
+
+	require.Equal(t, Bip322FormatUnknown, DetectBip322Format([]byte("not a signature")))
+	require.Equal(t, Bip322FormatSimple, DetectBip322Format(mustDecodeBase64(t,
+		"AkcwRAIgZRfIY3p7/DoVTty6YZbWS71bc5Vct9p9Fia83eRmw2QCICK/ENGfwLtptFluMGs2KsqoNSk89pO7F29zJLUx9a/sASECx/EgAxlkQpQ9hYjgGu6EBCPMVPwVIVJqO4XCsMvViHI=")))
+}
+
+// TestBip322Malleability checks that the encodings a third party can derive
+// from a valid signature without the key are rejected, or are not accepted as
+// transaction signatures.
+func TestBip322Malleability(t *testing.T) {
+	net := &chaincfg.MainNetParams
+	msg := []byte("Hello World")
+
+	wif, err := btcutil.DecodeWIF(bip322TaprootWIF)
+	require.NoError(t, err)
+	outputKey := (&PrivKey{Key: wif.PrivKey.Serialize()}).PubKey().(*PubKey).Address()
+
+	legacy := legacySign(t, wif.PrivKey, msg, 31)
+	require.NoError(t, Bip322VerifyOutputKey(msg, legacy, outputKey, net))
+
+	// the header of an uncompressed key, a P2SH-P2WPKH or a P2WPKH address
+	for _, header := range []byte{27, 35, 39} {
+		sig := legacySign(t, wif.PrivKey, msg, header)
+		require.Equal(t, Bip322FormatLegacy, DetectBip322Format(sig))
+		require.ErrorContains(t, Bip322VerifyOutputKey(msg, sig, outputKey, net), "non-canonical legacy signature header")
+	}
+
+	// (r, N-s) recovers the same key with the other recovery id
+	var sigS secp256k1.ModNScalar
+	sigS.SetByteSlice(legacy[33:])
+	highS := bytes.Clone(legacy)
+	highS[0] ^= 1
+	sigS.Negate().PutBytesUnchecked(highS[33:])
+	require.ErrorContains(t, Bip322VerifyOutputKey(msg, highS, outputKey, net), "s value is not canonical")
+
+	// a simple signature wrapped in the default to_sign transaction is a valid
+	// full signature, but not a transaction signature
+	pubKey := (&PrivKey{Key: wif.PrivKey.Serialize()}).PubKey()
+	simple, err := Bip322Sign(msg, wif.PrivKey, net)
+	require.NoError(t, err)
+	require.NoError(t, Bip322VerifyOutputKey(msg, simple, outputKey, net))
+	witness, err := bip322.SimpleSigToWitness(simple)
+	require.NoError(t, err)
+	address, err := TweakedPubKeyToP2trAddress(outputKey, net)
+	require.NoError(t, err)
+	toSpend, err := bip322.GetToSpendTx(msg, address)
+	require.NoError(t, err)
+	toSign := bip322.GetToSignTx(toSpend)
+	toSign.TxIn[0].Witness = witness
+	var full bytes.Buffer
+	require.NoError(t, toSign.Serialize(&full))
+	require.Equal(t, Bip322FormatFull, DetectBip322Format(full.Bytes()))
+	require.NoError(t, Bip322VerifyOutputKey(msg, full.Bytes(), outputKey, net))
+	require.True(t, pubKey.VerifySignature(msg, simple))
+	require.False(t, pubKey.VerifySignature(msg, full.Bytes()))
+}
+
+// TestBip322LegacyVector verifies the signmessage vector of Bitcoin Core's
+// rpc_signmessage.py functional test, which BIP-322 legacy signatures are.
+func TestBip322LegacyVector(t *testing.T) {
+	wif, err := btcutil.DecodeWIF("cUeKHd5orzT3mz8P9pxyREHfsWtVfgsfDjiZZBcjUBAaGk1BTj7N")
+	require.NoError(t, err)
+	pubKey := (&PrivKey{Key: wif.PrivKey.Serialize()}).PubKey().(*PubKey)
+	msg := []byte("This is just a test message")
+	sig := mustDecodeBase64(t, "INbVnW4e6PeRmsv2Qgu8NuopvrVjkcxob+sX8OcZG0SALhWybUjzMLPdAsXI46YZGb0KQTRii+wWIQzRpG/U+S0=")
+
+	net := &chaincfg.MainNetParams
+	require.Equal(t, Bip322FormatLegacy, DetectBip322Format(sig))
+	require.NoError(t, Bip322VerifyOutputKey(msg, sig, pubKey.Address(), net))
+	require.Error(t, Bip322VerifyOutputKey([]byte("This is just a test message!"), sig, pubKey.Address(), net))
+	require.Error(t, Bip322VerifyOutputKey(msg, sig, GenPrivKey().PubKey().Address(), net))
+
+	// legacy signatures are not transaction signatures
+	require.False(t, pubKey.VerifySignature(msg, sig))
+}
+
+func legacySign(t *testing.T, privKey *btcec.PrivateKey, msg []byte, header byte) []byte {
+	t.Helper()
+
+	sig := ecdsa.SignCompact(privKey, legacyMessageHash(msg), true)
+	sig[0] += header - 31
+
+	return sig
+}
+
+func mustDecodeBase64(t *testing.T, s string) []byte {
+	t.Helper()
+
+	bz, err := base64.StdEncoding.DecodeString(s)
+	require.NoError(t, err)
+
+	return bz
+}
@@ -1,6 +1,7 @@
 package taproot
 
 import (
+	"crypto/sha256"
 	"errors"
 
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
@@ -58,3 +59,50 @@ func TweakedPubKeyToTaprootScript(p []byte) ([]byte, error) {
 		AddData(p).
 		Script()
 }
+
+// SchnorrSignatureSize is the size of a raw BIP-340 Schnorr signature. A BIP-322
+// simple signature always carries a witness stack prefix, so signatures of this
+// length are unambiguously verified as plain Schnorr signatures.
+const SchnorrSignatureSize = schnorr.SignatureSize
+
+// IsSchnorrSignature reports whether sig is encoded as a raw BIP-340 signature
+// rather than as a BIP-322 witness.
+func IsSchnorrSignature(sig []byte) bool {
+	return len(sig) == SchnorrSignatureSize
+}
+
+// Bip340Sign creates a BIP-340 Schnorr signature of sha256(msg) with the private
+// key tweaked for the key spend path, so it verifies against the x-only key
+// committed to in the P2TR address.
+func Bip340Sign(msg []byte, privKey *secp256k1.PrivateKey) ([]byte, error) {
+	tweakedPrivKey := txscript.TweakTaprootPrivKey(*privKey, nil)
+	hash := sha256.Sum256(msg)
+
+	signature, err := schnorr.Sign(tweakedPrivKey, hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return signature.Serialize(), nil
+}
+
+// Bip340Verify verifies a raw BIP-340 Schnorr signature of sha256(msg) against
+// the tweaked x-only key of pubKey.
+func Bip340Verify(msg, signature []byte, pubKey *PubKey) (bool, error) {
+	if !IsSchnorrSignature(signature) {
+		return false, errors.New("invalid schnorr signature length")
+	}
+
+	tapKey, err := schnorr.ParsePubKey(pubKey.Address())
+	if err != nil {
+		return false, err
+	}
+
+	sig, err := schnorr.ParseSignature(signature)
+	if err != nil {
+		return false, err
+	}
+
+	hash := sha256.Sum256(msg)
+	return sig.Verify(hash[:], tapKey), nil
+}
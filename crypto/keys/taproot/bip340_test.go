@@ -1,10 +1,12 @@
 package taproot
 
 import (
+	"crypto/sha256"
 	"encoding/hex"
 	fmt "fmt"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/stretchr/testify/require"
 
@@ -71,3 +73,40 @@ func TestKeySpendPathP2trAddress(t *testing.T) {
 		fmt.Println(hex.EncodeToString(address.WitnessProgram()))
 	}
 }
+
+func TestBip340SignAndVerify(t *testing.T) {
+	msg := []byte("test")
+	priv := GenPrivKey()
+	pub := priv.PubKey().(*PubKey)
+
+	sig, err := priv.SignSchnorr(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, SchnorrSignatureSize)
+	require.True(t, IsSchnorrSignature(sig))
+
+	verified, err := Bip340Verify(msg, sig, pub)
+	require.NoError(t, err)
+	require.True(t, verified)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// A different message or key must not verify.
+	require.False(t, pub.VerifySignature([]byte("other"), sig))
+	require.False(t, GenPrivKey().PubKey().VerifySignature(msg, sig))
+
+	// A signature made with the untweaked internal key must not verify against
+	// the output key.
+	privKeyObj := secp256k1.PrivKeyFromBytes(priv.Key)
+	hash := sha256.Sum256(msg)
+	untweakedSig, err := schnorr.Sign(privKeyObj, hash[:])
+	require.NoError(t, err)
+	require.False(t, pub.VerifySignature(msg, untweakedSig.Serialize()))
+
+	// BIP-322 signatures of the same key are still accepted.
+	bip322Sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+	require.False(t, IsSchnorrSignature(bip322Sig))
+	require.True(t, pub.VerifySignature(msg, bip322Sig))
+
+	_, err = Bip340Verify(msg, bip322Sig, pub)
+	require.Error(t, err)
+}
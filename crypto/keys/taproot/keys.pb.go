@@ -118,31 +118,211 @@ func (m *PrivKey) GetKey() []byte {
 	return nil
 }
 
+// MuSig2PubKey defines a Taproot public key aggregated from the keys of several
+// co-signers with MuSig2 (BIP-327). The aggregate key is tweaked as specified
+// in BIP-86, so it is a regular P2TR output key: the account address is its
+// x-only form and a single BIP-340 or BIP-322 signature produced jointly by all
+// co-signers verifies against it.
+type MuSig2PubKey struct {
+	// public_keys are the taproot keys of the co-signers, in aggregation order.
+	PubKeys []*PubKey `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+}
+
+func (m *MuSig2PubKey) Reset()      { *m = MuSig2PubKey{} }
+func (*MuSig2PubKey) ProtoMessage() {}
+func (*MuSig2PubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73be70735082c020, []int{2}
+}
+func (m *MuSig2PubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MuSig2PubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MuSig2PubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MuSig2PubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MuSig2PubKey.Merge(m, src)
+}
+func (m *MuSig2PubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MuSig2PubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MuSig2PubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MuSig2PubKey proto.InternalMessageInfo
+
+func (m *MuSig2PubKey) GetPubKeys() []*PubKey {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
+// TapscriptPubKey defines a Taproot output key committing to a tree of
+// tapscript leaves as specified in BIP-341. The account address is the x-only
+// output key. It can be spent either with the key path, by the holder of the
+// internal key, or with the script path, by satisfying one of the leaf scripts.
+type TapscriptPubKey struct {
+	// internal_key is the 32-byte x-only internal key.
+	InternalKey []byte `protobuf:"bytes,1,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+	// leaf_scripts are the scripts of the tree leaves, with leaf version 0xc0.
+	LeafScripts [][]byte `protobuf:"bytes,2,rep,name=leaf_scripts,json=leafScripts,proto3" json:"leaf_scripts,omitempty"`
+}
+
+func (m *TapscriptPubKey) Reset()      { *m = TapscriptPubKey{} }
+func (*TapscriptPubKey) ProtoMessage() {}
+func (*TapscriptPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73be70735082c020, []int{3}
+}
+func (m *TapscriptPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TapscriptPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TapscriptPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TapscriptPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TapscriptPubKey.Merge(m, src)
+}
+func (m *TapscriptPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *TapscriptPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_TapscriptPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TapscriptPubKey proto.InternalMessageInfo
+
+func (m *TapscriptPubKey) GetInternalKey() []byte {
+	if m != nil {
+		return m.InternalKey
+	}
+	return nil
+}
+
+func (m *TapscriptPubKey) GetLeafScripts() [][]byte {
+	if m != nil {
+		return m.LeafScripts
+	}
+	return nil
+}
+
+// TapscriptSignature defines a signature of a TapscriptPubKey, which is
+// verified as the witness of the BIP-322 to_sign transaction with the btcd
+// script engine.
+type TapscriptSignature struct {
+	// witness is the witness stack. A script path spend ends with the leaf
+	// script and its control block.
+	Witness [][]byte `protobuf:"bytes,1,rep,name=witness,proto3" json:"witness,omitempty"`
+	// lock_time is the nLockTime of the to_sign transaction, checked by
+	// OP_CHECKLOCKTIMEVERIFY. The ante handler only accepts the signature once
+	// the block height, or block time, reached it.
+	LockTime uint32 `protobuf:"varint,2,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
+}
+
+func (m *TapscriptSignature) Reset()         { *m = TapscriptSignature{} }
+func (m *TapscriptSignature) String() string { return proto.CompactTextString(m) }
+func (*TapscriptSignature) ProtoMessage()    {}
+func (*TapscriptSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73be70735082c020, []int{4}
+}
+func (m *TapscriptSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TapscriptSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TapscriptSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TapscriptSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TapscriptSignature.Merge(m, src)
+}
+func (m *TapscriptSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *TapscriptSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_TapscriptSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TapscriptSignature proto.InternalMessageInfo
+
+func (m *TapscriptSignature) GetWitness() [][]byte {
+	if m != nil {
+		return m.Witness
+	}
+	return nil
+}
+
+func (m *TapscriptSignature) GetLockTime() uint32 {
+	if m != nil {
+		return m.LockTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*PubKey)(nil), "cosmos.crypto.taproot.PubKey")
 	proto.RegisterType((*PrivKey)(nil), "cosmos.crypto.taproot.PrivKey")
+	proto.RegisterType((*MuSig2PubKey)(nil), "cosmos.crypto.taproot.MuSig2PubKey")
+	proto.RegisterType((*TapscriptPubKey)(nil), "cosmos.crypto.taproot.TapscriptPubKey")
+	proto.RegisterType((*TapscriptSignature)(nil), "cosmos.crypto.taproot.TapscriptSignature")
 }
 
 func init() { proto.RegisterFile("cosmos/crypto/taproot/keys.proto", fileDescriptor_73be70735082c020) }
 
 var fileDescriptor_73be70735082c020 = []byte{
-	// 248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2e, 0xaa, 0x2c, 0x28, 0xc9, 0xd7, 0x2f, 0x49, 0x2c, 0x28, 0xca, 0xcf,
-	0x2f, 0xd1, 0xcf, 0x4e, 0xad, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x85, 0xa8,
-	0xd0, 0x83, 0xa8, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93,
-	0x10, 0x95, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0xf2,
-	0xe5, 0x62, 0x0b, 0x28, 0x4d, 0xf2, 0x4e, 0xad, 0x14, 0x12, 0xe0, 0x62, 0xce, 0x4e, 0xad, 0x94,
-	0x60, 0x54, 0x60, 0xd4, 0xe0, 0x09, 0x02, 0x31, 0xad, 0x0c, 0x67, 0x2c, 0x90, 0x67, 0xe8, 0x7a,
-	0xbe, 0x41, 0x4b, 0xaa, 0x24, 0x35, 0x2f, 0x25, 0xb5, 0x28, 0x37, 0x33, 0xaf, 0x44, 0x1f, 0xa2,
-	0x3a, 0x38, 0x35, 0xb9, 0xc0, 0xc8, 0xd4, 0x2c, 0xdb, 0x70, 0xd2, 0xf3, 0x0d, 0x5a, 0x9c, 0xd9,
-	0xa9, 0x95, 0xf1, 0x69, 0x99, 0xa9, 0x39, 0x29, 0x4a, 0xde, 0x5c, 0xec, 0x01, 0x45, 0x99, 0x65,
-	0xd8, 0xcd, 0xd3, 0x03, 0x99, 0x25, 0x8d, 0x6c, 0x16, 0x44, 0x29, 0x0e, 0xc3, 0x9c, 0xbc, 0x4e,
-	0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18,
-	0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x20, 0x3d, 0xb3, 0x24, 0xa3, 0x34,
-	0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x16, 0x44, 0x60, 0x4a, 0xb7, 0x38, 0x25, 0x1b, 0x16, 0x5a,
-	0xa0, 0x50, 0x82, 0x05, 0x59, 0x12, 0x1b, 0xd8, 0xbb, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x81, 0x98, 0xdb, 0xf8, 0x52, 0x01, 0x00, 0x00,
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0xae, 0x94, 0x40,
+	0x14, 0xc6, 0x99, 0xbb, 0xc9, 0x5d, 0xef, 0x80, 0x51, 0x89, 0x26, 0x64, 0x6f, 0xe4, 0x22, 0x8d,
+	0x9b, 0x9b, 0x08, 0xde, 0x35, 0x5a, 0x6c, 0xb9, 0xa5, 0x64, 0x93, 0x0d, 0x6c, 0x65, 0x43, 0x80,
+	0x9d, 0xc5, 0x09, 0x7f, 0x86, 0xcc, 0x0c, 0x1a, 0x3a, 0x0b, 0x2b, 0x2b, 0x63, 0x65, 0xe9, 0x23,
+	0xec, 0x63, 0x58, 0x6e, 0x69, 0x65, 0x0c, 0x5b, 0xec, 0x6b, 0x98, 0x61, 0x40, 0x4d, 0x5c, 0x13,
+	0x1b, 0xf8, 0xf8, 0xf2, 0x3b, 0x1f, 0xe7, 0x9c, 0x1c, 0x68, 0x25, 0x84, 0x15, 0x84, 0xb9, 0x09,
+	0x6d, 0x2a, 0x4e, 0x5c, 0x1e, 0x55, 0x94, 0x10, 0xee, 0x66, 0xa8, 0x61, 0x4e, 0x45, 0x09, 0x27,
+	0xfa, 0x03, 0x49, 0x38, 0x92, 0x70, 0x7a, 0x62, 0x72, 0x2f, 0x2a, 0x70, 0x49, 0xdc, 0xee, 0x29,
+	0xc9, 0xc9, 0xfd, 0x94, 0xa4, 0xa4, 0x93, 0xae, 0x50, 0xd2, 0xb5, 0x97, 0xf0, 0x7c, 0x55, 0xc7,
+	0x1e, 0x6a, 0xf4, 0xbb, 0x70, 0x94, 0xa1, 0xc6, 0x00, 0x16, 0x98, 0x6a, 0xbe, 0x90, 0xf3, 0x9b,
+	0xcf, 0x5f, 0xae, 0x94, 0x0f, 0xc7, 0xdd, 0xf5, 0x84, 0xa3, 0x72, 0x83, 0x68, 0x81, 0x4b, 0xee,
+	0x4a, 0x3a, 0x40, 0x49, 0x35, 0x7b, 0xfe, 0x22, 0xbb, 0xf9, 0x74, 0xdc, 0x5d, 0x5f, 0x64, 0xa8,
+	0x09, 0xb7, 0x18, 0xe5, 0x1b, 0xdb, 0x83, 0xe3, 0x15, 0xc5, 0x6f, 0x4e, 0xe7, 0x39, 0x22, 0xeb,
+	0xf2, 0xcf, 0x2c, 0x89, 0xfe, 0x2b, 0xec, 0x3d, 0x80, 0xda, 0xb2, 0x0e, 0x70, 0x3a, 0xeb, 0x5b,
+	0xf4, 0xa0, 0x5a, 0xd5, 0x71, 0x8e, 0x93, 0x50, 0x6c, 0xc0, 0x00, 0xd6, 0x68, 0xaa, 0xce, 0x1e,
+	0x3a, 0x27, 0x57, 0xe0, 0xc8, 0x9a, 0x85, 0xda, 0x7e, 0xbf, 0x1a, 0x4b, 0xcd, 0x7c, 0x28, 0xcb,
+	0x85, 0x9e, 0x3f, 0x1e, 0xa6, 0x33, 0xff, 0x9a, 0x6e, 0x2d, 0x23, 0xe4, 0xbf, 0xed, 0x77, 0x00,
+	0xde, 0x59, 0x47, 0x15, 0x4b, 0x28, 0xae, 0x78, 0xdf, 0xc9, 0x23, 0xa8, 0xe1, 0x92, 0x23, 0x5a,
+	0x46, 0x79, 0xf8, 0x7b, 0x4a, 0x75, 0xf0, 0x7a, 0x24, 0x47, 0xd1, 0x36, 0x94, 0x75, 0xcc, 0x38,
+	0xb3, 0x46, 0x02, 0x11, 0x5e, 0x20, 0xad, 0xff, 0x68, 0x41, 0x92, 0xb6, 0x07, 0xf5, 0x5f, 0x1d,
+	0x04, 0x38, 0x2d, 0x23, 0x5e, 0x53, 0xa4, 0x1b, 0x70, 0xfc, 0x16, 0xf3, 0x12, 0x31, 0xb9, 0x0a,
+	0xcd, 0x1f, 0x3e, 0xf5, 0x4b, 0x78, 0x91, 0x93, 0x24, 0x0b, 0x39, 0x2e, 0x90, 0x71, 0x66, 0x81,
+	0xe9, 0x6d, 0xff, 0x96, 0x30, 0xd6, 0xb8, 0x40, 0x8b, 0x97, 0x5f, 0x5b, 0x13, 0xec, 0x5b, 0x13,
+	0xfc, 0x68, 0x4d, 0xf0, 0xf1, 0x60, 0x2a, 0xfb, 0x83, 0xa9, 0x7c, 0x3b, 0x98, 0xca, 0xab, 0xa7,
+	0x29, 0xe6, 0xaf, 0xeb, 0xd8, 0x49, 0x48, 0xe1, 0x0e, 0x97, 0xd7, 0xbd, 0x9e, 0xb0, 0x4d, 0x36,
+	0x1c, 0xa1, 0x58, 0xfd, 0x70, 0x89, 0xf1, 0x79, 0x77, 0x45, 0xcf, 0x7e, 0x06, 0x00, 0x00, 0xff,
+	0xff, 0xbd, 0xe2, 0x89, 0x6b, 0xa9, 0x02, 0x00, 0x00,
 }
 
 func (m *PubKey) Marshal() (dAtA []byte, err error) {
@@ -205,6 +385,119 @@ func (m *PrivKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MuSig2PubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MuSig2PubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MuSig2PubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeys(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TapscriptPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TapscriptPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TapscriptPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LeafScripts) > 0 {
+		for iNdEx := len(m.LeafScripts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LeafScripts[iNdEx])
+			copy(dAtA[i:], m.LeafScripts[iNdEx])
+			i = encodeVarintKeys(dAtA, i, uint64(len(m.LeafScripts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.InternalKey) > 0 {
+		i -= len(m.InternalKey)
+		copy(dAtA[i:], m.InternalKey)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.InternalKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TapscriptSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TapscriptSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TapscriptSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockTime != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.LockTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Witness) > 0 {
+		for iNdEx := len(m.Witness) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Witness[iNdEx])
+			copy(dAtA[i:], m.Witness[iNdEx])
+			i = encodeVarintKeys(dAtA, i, uint64(len(m.Witness[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintKeys(dAtA []byte, offset int, v uint64) int {
 	offset -= sovKeys(v)
 	base := offset
@@ -242,6 +535,58 @@ func (m *PrivKey) Size() (n int) {
 	return n
 }
 
+func (m *MuSig2PubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PubKeys) > 0 {
+		for _, e := range m.PubKeys {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TapscriptPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InternalKey)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if len(m.LeafScripts) > 0 {
+		for _, b := range m.LeafScripts {
+			l = len(b)
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TapscriptSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Witness) > 0 {
+		for _, b := range m.Witness {
+			l = len(b)
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if m.LockTime != 0 {
+		n += 1 + sovKeys(uint64(m.LockTime))
+	}
+	return n
+}
+
 func sovKeys(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -416,6 +761,307 @@ func (m *PrivKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MuSig2PubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MuSig2PubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MuSig2PubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, &PubKey{})
+			if err := m.PubKeys[len(m.PubKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TapscriptPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TapscriptPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TapscriptPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InternalKey = append(m.InternalKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.InternalKey == nil {
+				m.InternalKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafScripts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeafScripts = append(m.LeafScripts, make([]byte, postIndex-iNdEx))
+			copy(m.LeafScripts[len(m.LeafScripts)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TapscriptSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TapscriptSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TapscriptSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Witness", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Witness = append(m.Witness, make([]byte, postIndex-iNdEx))
+			copy(m.Witness[len(m.Witness)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockTime", wireType)
+			}
+			m.LockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipKeys(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
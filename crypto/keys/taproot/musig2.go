@@ -0,0 +1,408 @@
+package taproot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/cometbft/cometbft/crypto"
+	secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var _ cryptotypes.PubKey = &MuSig2PubKey{}
+
+const (
+	MuSig2PubKeyName = "tendermint/PubKeyTaprootMuSig2"
+	muSig2KeyType    = "taproot-musig2"
+
+	// MuSig2PubNonceSize is the size of the public nonce a co-signer shares in
+	// the first round of a MuSig2 signing session.
+	MuSig2PubNonceSize = musig2.PubNonceSize
+	// MuSig2SecNonceSize is the size of the secret nonce a co-signer keeps
+	// between the two rounds of a MuSig2 signing session.
+	MuSig2SecNonceSize = musig2.SecNonceSize
+	// MuSig2PartialSigSize is the size of an encoded partial signature: the
+	// 32-byte s value followed by the 33-byte compressed final nonce.
+	MuSig2PartialSigSize = 32 + btcec.PubKeyBytesLenCompressed
+)
+
+// MuSig2SignatureFormat selects the encoding of the signature produced by a
+// MuSig2 signing session. Both are accepted by MuSig2PubKey.VerifySignature.
+type MuSig2SignatureFormat int
+
+const (
+	// MuSig2Schnorr produces a raw BIP-340 Schnorr signature of sha256(msg).
+	MuSig2Schnorr MuSig2SignatureFormat = iota
+	// MuSig2BIP322 produces a BIP-322 simple signature of msg.
+	MuSig2BIP322
+)
+
+// NewMuSig2PubKey aggregates the taproot keys of the co-signers into a MuSig2
+// public key. Keys are sorted as specified by BIP-327, so that all co-signers
+// derive the same key whatever order they list each other in.
+func NewMuSig2PubKey(pubKeys []cryptotypes.PubKey) (*MuSig2PubKey, error) {
+	if len(pubKeys) < 2 {
+		return nil, errors.New("a MuSig2 key requires at least 2 co-signers")
+	}
+
+	keys := make([]*PubKey, len(pubKeys))
+	for i, pk := range pubKeys {
+		tk, ok := pk.(*PubKey)
+		if !ok {
+			return nil, fmt.Errorf("MuSig2 keys can only aggregate taproot keys, got %T", pk)
+		}
+		keys[i] = tk
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i].Key, keys[j].Key) < 0
+	})
+
+	for i := 1; i < len(keys); i++ {
+		if bytes.Equal(keys[i-1].Key, keys[i].Key) {
+			return nil, fmt.Errorf("duplicate MuSig2 co-signer key %s", keys[i])
+		}
+	}
+
+	pubKey := &MuSig2PubKey{PubKeys: keys}
+	if _, err := pubKey.aggregate(); err != nil {
+		return nil, err
+	}
+
+	return pubKey, nil
+}
+
+// Address returns the x-only output key, as for a single taproot key. It panics
+// if the co-signer keys are invalid.
+func (pubKey *MuSig2PubKey) Address() crypto.Address {
+	outputKey, err := pubKey.OutputKey()
+	if err != nil {
+		panic(err)
+	}
+
+	return outputKey.Key[1:]
+}
+
+// Bytes returns the proto encoding of the key.
+func (pubKey *MuSig2PubKey) Bytes() []byte {
+	bz, err := pubKey.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// VerifySignature verifies a signature jointly produced by all co-signers. It
+// accepts the same encodings as PubKey.VerifySignature.
+func (pubKey *MuSig2PubKey) VerifySignature(msg, sig []byte) bool {
+	outputKey, err := pubKey.OutputKey()
+	if err != nil {
+		return false
+	}
+
+	return outputKey.VerifySignature(msg, sig)
+}
+
+func (pubKey *MuSig2PubKey) Equals(other cryptotypes.PubKey) bool {
+	otherKey, ok := other.(*MuSig2PubKey)
+	if !ok || len(pubKey.PubKeys) != len(otherKey.PubKeys) {
+		return false
+	}
+
+	for i, pk := range pubKey.PubKeys {
+		if !pk.Equals(otherKey.PubKeys[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (pubKey *MuSig2PubKey) Type() string {
+	return muSig2KeyType
+}
+
+func (pubKey *MuSig2PubKey) String() string {
+	keys := make([]string, len(pubKey.PubKeys))
+	for i, pk := range pubKey.PubKeys {
+		keys[i] = fmt.Sprintf("%X", pk.Key)
+	}
+
+	return fmt.Sprintf("PubKeyTaprootMuSig2{%s}", strings.Join(keys, ","))
+}
+
+// OutputKey returns the BIP-86 tweaked aggregate key, i.e. the taproot key the
+// jointly produced signatures verify against.
+func (pubKey *MuSig2PubKey) OutputKey() (*PubKey, error) {
+	aggKey, err := pubKey.aggregate()
+	if err != nil {
+		return nil, err
+	}
+
+	key := make([]byte, PubKeySize)
+	key[0] = 0x02
+	copy(key[1:], schnorr.SerializePubKey(aggKey.FinalKey))
+
+	return &PubKey{Key: key}, nil
+}
+
+// InternalKey returns the x-only untweaked aggregate key, i.e. the BIP-341
+// internal key of OutputKey.
+func (pubKey *MuSig2PubKey) InternalKey() ([]byte, error) {
+	aggKey, err := pubKey.aggregate()
+	if err != nil {
+		return nil, err
+	}
+
+	return schnorr.SerializePubKey(aggKey.PreTweakedKey), nil
+}
+
+// SigningDigest returns the 32-byte digest the co-signers sign to produce a
+// signature of msg in the given format.
+func (pubKey *MuSig2PubKey) SigningDigest(msg []byte, format MuSig2SignatureFormat) ([32]byte, error) {
+	switch format {
+	case MuSig2Schnorr:
+		return sha256.Sum256(msg), nil
+
+	case MuSig2BIP322:
+		var digest [32]byte
+		sigHash, err := Bip322SigHash(msg, pubKey.Address(), GetBitcoinNetParams())
+		if err != nil {
+			return digest, err
+		}
+		copy(digest[:], sigHash)
+		return digest, nil
+
+	default:
+		return [32]byte{}, fmt.Errorf("unknown MuSig2 signature format %d", format)
+	}
+}
+
+// MuSig2GenNonce generates the nonces of the co-signer holding privKey for a
+// session signing digest. The public nonce must be shared with the other
+// co-signers, while the secret nonce must be kept private and used at most
+// once, in MuSig2PartialSign.
+func MuSig2GenNonce(privKey *PrivKey, pubKey *MuSig2PubKey, digest [32]byte) (pubNonce, secNonce []byte, err error) {
+	signingKey := tweakedSigningKey(privKey)
+	if pubKey.indexOf(signingKey.PubKey()) < 0 {
+		return nil, nil, errors.New("the signing key is not a co-signer of the MuSig2 key")
+	}
+
+	aggKey, err := pubKey.aggregate()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nonces, err := musig2.GenNonces(
+		musig2.WithPublicKey(signingKey.PubKey()),
+		musig2.WithNonceSecretKeyAux(signingKey),
+		musig2.WithNonceCombinedKeyAux(aggKey.FinalKey),
+		musig2.WithNonceMessageAux(digest),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return nonces.PubNonce[:], nonces.SecNonce[:], nil
+}
+
+// MuSig2PartialSign creates the partial signature of digest of the co-signer
+// holding privKey. pubNonces are the public nonces of all co-signers, in the
+// order of pubKey.PubKeys.
+func MuSig2PartialSign(
+	privKey *PrivKey, secNonce []byte, pubKey *MuSig2PubKey, pubNonces [][]byte, digest [32]byte,
+) ([]byte, error) {
+	if len(secNonce) != MuSig2SecNonceSize {
+		return nil, fmt.Errorf("invalid secret nonce size %d", len(secNonce))
+	}
+
+	keys, err := pubKey.parseKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	combinedNonce, err := pubKey.combineNonces(pubNonces)
+	if err != nil {
+		return nil, err
+	}
+
+	var sn [MuSig2SecNonceSize]byte
+	copy(sn[:], secNonce)
+
+	partialSig, err := musig2.Sign(
+		sn, tweakedSigningKey(privKey), combinedNonce, keys, digest, musig2.WithBip86SignTweak(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodePartialSig(partialSig), nil
+}
+
+// CombineSignatures checks the partial signatures of all co-signers, in
+// the order of pubKey.PubKeys, and combines them into a signature of msg in
+// the given format.
+func (pubKey *MuSig2PubKey) CombineSignatures(
+	msg []byte, format MuSig2SignatureFormat, pubNonces, partialSigs [][]byte,
+) ([]byte, error) {
+	keys, err := pubKey.parseKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(partialSigs) != len(keys) {
+		return nil, fmt.Errorf("expected %d partial signatures, got %d", len(keys), len(partialSigs))
+	}
+
+	digest, err := pubKey.SigningDigest(msg, format)
+	if err != nil {
+		return nil, err
+	}
+
+	combinedNonce, err := pubKey.combineNonces(pubNonces)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs := make([]*musig2.PartialSignature, len(partialSigs))
+	for i, bz := range partialSigs {
+		sigs[i], err = decodePartialSig(bz)
+		if err != nil {
+			return nil, fmt.Errorf("partial signature of co-signer %d: %w", i, err)
+		}
+
+		if !sigs[i].R.IsEqual(sigs[0].R) {
+			return nil, fmt.Errorf("partial signature of co-signer %d uses a different nonce", i)
+		}
+
+		var pubNonce [MuSig2PubNonceSize]byte
+		copy(pubNonce[:], pubNonces[i])
+		if !sigs[i].Verify(pubNonce, combinedNonce, keys, keys[i], digest, musig2.WithBip86SignTweak()) {
+			return nil, fmt.Errorf("invalid partial signature of co-signer %d (%s)", i, pubKey.PubKeys[i])
+		}
+	}
+
+	finalSig := musig2.CombineSigs(sigs[0].R, sigs, musig2.WithBip86TweakedCombine(digest, keys, false))
+
+	sig := finalSig.Serialize()
+	if format == MuSig2BIP322 {
+		sig, err = Bip322SimpleSigFromSchnorr(sig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !pubKey.VerifySignature(msg, sig) {
+		return nil, errors.New("combined MuSig2 signature is invalid")
+	}
+
+	return sig, nil
+}
+
+func (pubKey *MuSig2PubKey) parseKeys() ([]*btcec.PublicKey, error) {
+	if len(pubKey.PubKeys) < 2 {
+		return nil, errors.New("a MuSig2 key requires at least 2 co-signers")
+	}
+
+	keys := make([]*btcec.PublicKey, len(pubKey.PubKeys))
+	for i, pk := range pubKey.PubKeys {
+		key, err := btcec.ParsePubKey(pk.Key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MuSig2 co-signer key %d: %w", i, err)
+		}
+		keys[i] = key
+	}
+
+	return keys, nil
+}
+
+func (pubKey *MuSig2PubKey) aggregate() (*musig2.AggregateKey, error) {
+	keys, err := pubKey.parseKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	aggKey, _, _, err := musig2.AggregateKeys(keys, false, musig2.WithBIP86KeyTweak())
+	if err != nil {
+		return nil, err
+	}
+
+	return aggKey, nil
+}
+
+func (pubKey *MuSig2PubKey) indexOf(key *btcec.PublicKey) int {
+	bz := key.SerializeCompressed()
+	for i, pk := range pubKey.PubKeys {
+		if bytes.Equal(pk.Key, bz) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (pubKey *MuSig2PubKey) combineNonces(pubNonces [][]byte) ([MuSig2PubNonceSize]byte, error) {
+	if len(pubNonces) != len(pubKey.PubKeys) {
+		return [MuSig2PubNonceSize]byte{}, fmt.Errorf("expected %d public nonces, got %d", len(pubKey.PubKeys), len(pubNonces))
+	}
+
+	nonces := make([][MuSig2PubNonceSize]byte, len(pubNonces))
+	for i, bz := range pubNonces {
+		if len(bz) != MuSig2PubNonceSize {
+			return [MuSig2PubNonceSize]byte{}, fmt.Errorf("invalid public nonce size %d of co-signer %d", len(bz), i)
+		}
+		copy(nonces[i][:], bz)
+	}
+
+	return musig2.AggregateNonces(nonces)
+}
+
+// tweakedSigningKey returns the key signing on behalf of the PubKey of privKey,
+// in MuSig2 sessions or tapscript leaves: the private key tweaked for the key
+// spend path and negated if needed, so that its public key is the even-y key
+// stored in PubKey.
+func tweakedSigningKey(privKey *PrivKey) *btcec.PrivateKey {
+	signingKey := txscript.TweakTaprootPrivKey(*secp256k1.PrivKeyFromBytes(privKey.Key), nil)
+	if signingKey.PubKey().SerializeCompressed()[0] == secp256k1.PubKeyFormatCompressedOdd {
+		signingKey.Key.Negate()
+	}
+
+	return signingKey
+}
+
+func encodePartialSig(sig *musig2.PartialSignature) []byte {
+	bz := make([]byte, 0, MuSig2PartialSigSize)
+	s := sig.S.Bytes()
+	bz = append(bz, s[:]...)
+
+	return append(bz, sig.R.SerializeCompressed()...)
+}
+
+func decodePartialSig(bz []byte) (*musig2.PartialSignature, error) {
+	if len(bz) != MuSig2PartialSigSize {
+		return nil, fmt.Errorf("invalid partial signature size %d", len(bz))
+	}
+
+	s := new(btcec.ModNScalar)
+	if overflow := s.SetByteSlice(bz[:32]); overflow {
+		return nil, musig2.ErrPartialSigInvalid
+	}
+
+	r, err := btcec.ParsePubKey(bz[32:])
+	if err != nil {
+		return nil, err
+	}
+
+	sig := musig2.NewPartialSignature(s, r)
+	return &sig, nil
+}
@@ -0,0 +1,177 @@
+package taproot
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func genMuSig2Signers(t *testing.T, n int) ([]*PrivKey, *MuSig2PubKey) {
+	t.Helper()
+
+	privKeys := make([]*PrivKey, n)
+	pubKeys := make([]cryptotypes.PubKey, n)
+	for i := range privKeys {
+		privKeys[i] = GenPrivKey()
+		pubKeys[i] = privKeys[i].PubKey()
+	}
+
+	pubKey, err := NewMuSig2PubKey(pubKeys)
+	require.NoError(t, err)
+
+	// co-signers are indexed in the order of the aggregated key
+	sorted := make([]*PrivKey, n)
+	for _, priv := range privKeys {
+		for j, pk := range pubKey.PubKeys {
+			if pk.Equals(priv.PubKey()) {
+				sorted[j] = priv
+			}
+		}
+	}
+
+	return sorted, pubKey
+}
+
+// runMuSig2Session runs a full signing session, returning the public nonces and
+// partial signatures of all co-signers.
+func runMuSig2Session(
+	t *testing.T, privKeys []*PrivKey, pubKey *MuSig2PubKey, msg []byte, format MuSig2SignatureFormat,
+) (pubNonces, partialSigs [][]byte) {
+	t.Helper()
+
+	digest, err := pubKey.SigningDigest(msg, format)
+	require.NoError(t, err)
+
+	secNonces := make([][]byte, len(privKeys))
+	pubNonces = make([][]byte, len(privKeys))
+	for i, priv := range privKeys {
+		pubNonces[i], secNonces[i], err = MuSig2GenNonce(priv, pubKey, digest)
+		require.NoError(t, err)
+		require.Len(t, pubNonces[i], MuSig2PubNonceSize)
+	}
+
+	partialSigs = make([][]byte, len(privKeys))
+	for i, priv := range privKeys {
+		partialSigs[i], err = MuSig2PartialSign(priv, secNonces[i], pubKey, pubNonces, digest)
+		require.NoError(t, err)
+		require.Len(t, partialSigs[i], MuSig2PartialSigSize)
+	}
+
+	return pubNonces, partialSigs
+}
+
+func TestNewMuSig2PubKey(t *testing.T) {
+	privKeys := []*PrivKey{GenPrivKey(), GenPrivKey(), GenPrivKey()}
+	pubKeys := []cryptotypes.PubKey{privKeys[0].PubKey(), privKeys[1].PubKey(), privKeys[2].PubKey()}
+
+	pubKey, err := NewMuSig2PubKey(pubKeys)
+	require.NoError(t, err)
+
+	// the co-signers order doesn't change the aggregated key
+	reversed, err := NewMuSig2PubKey([]cryptotypes.PubKey{pubKeys[2], pubKeys[1], pubKeys[0]})
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(reversed))
+	require.Equal(t, pubKey.Address(), reversed.Address())
+	for i := 1; i < len(pubKey.PubKeys); i++ {
+		require.Negative(t, bytes.Compare(pubKey.PubKeys[i-1].Key, pubKey.PubKeys[i].Key))
+	}
+
+	// the address is the BIP-86 tweaked MuSig2 aggregate of the co-signer keys
+	keys, err := pubKey.parseKeys()
+	require.NoError(t, err)
+	aggKey, _, _, err := musig2.AggregateKeys(keys, true, musig2.WithBIP86KeyTweak())
+	require.NoError(t, err)
+	require.Equal(t, schnorr.SerializePubKey(aggKey.FinalKey), pubKey.Address().Bytes())
+	require.Len(t, pubKey.Address(), 32)
+
+	address, err := TweakedPubKeyToP2trAddress(pubKey.Address(), GetBitcoinNetParams())
+	require.NoError(t, err)
+	decoded, err := btcutil.DecodeAddress(address.EncodeAddress(), GetBitcoinNetParams())
+	require.NoError(t, err)
+	require.IsType(t, &btcutil.AddressTaproot{}, decoded)
+
+	_, err = NewMuSig2PubKey(pubKeys[:1])
+	require.Error(t, err)
+	_, err = NewMuSig2PubKey([]cryptotypes.PubKey{pubKeys[0], pubKeys[0]})
+	require.ErrorContains(t, err, "duplicate")
+	_, err = NewMuSig2PubKey([]cryptotypes.PubKey{pubKeys[0], secp256k1.GenPrivKey().PubKey()})
+	require.ErrorContains(t, err, "taproot")
+}
+
+func TestMuSig2SignAndVerify(t *testing.T) {
+	privKeys, pubKey := genMuSig2Signers(t, 3)
+	msg := []byte("cosmos taproot musig2")
+
+	testCases := []struct {
+		name    string
+		format  MuSig2SignatureFormat
+		schnorr bool
+	}{
+		{"schnorr", MuSig2Schnorr, true},
+		{"bip322", MuSig2BIP322, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pubNonces, partialSigs := runMuSig2Session(t, privKeys, pubKey, msg, tc.format)
+
+			sig, err := pubKey.CombineSignatures(msg, tc.format, pubNonces, partialSigs)
+			require.NoError(t, err)
+			require.Equal(t, tc.schnorr, IsSchnorrSignature(sig))
+
+			require.True(t, pubKey.VerifySignature(msg, sig))
+			require.False(t, pubKey.VerifySignature([]byte("another message"), sig))
+
+			// the signature is a regular signature of the output key
+			outputKey, err := pubKey.OutputKey()
+			require.NoError(t, err)
+			require.True(t, outputKey.VerifySignature(msg, sig))
+
+			// the signature of another co-signer set doesn't verify
+			_, other := genMuSig2Signers(t, 3)
+			require.False(t, other.VerifySignature(msg, sig))
+		})
+	}
+}
+
+func TestMuSig2CombineSignaturesErrors(t *testing.T) {
+	privKeys, pubKey := genMuSig2Signers(t, 2)
+	msg := []byte("cosmos taproot musig2")
+
+	pubNonces, partialSigs := runMuSig2Session(t, privKeys, pubKey, msg, MuSig2Schnorr)
+
+	_, err := pubKey.CombineSignatures(msg, MuSig2Schnorr, pubNonces, partialSigs[:1])
+	require.ErrorContains(t, err, "expected 2 partial signatures")
+
+	_, err = pubKey.CombineSignatures(msg, MuSig2Schnorr, pubNonces[:1], partialSigs)
+	require.ErrorContains(t, err, "expected 2 public nonces")
+
+	// partial signatures of another message are rejected
+	_, err = pubKey.CombineSignatures([]byte("another message"), MuSig2Schnorr, pubNonces, partialSigs)
+	require.ErrorContains(t, err, "invalid partial signature of co-signer 0")
+
+	// partial signatures can't be swapped between co-signers
+	_, err = pubKey.CombineSignatures(msg, MuSig2Schnorr, pubNonces, [][]byte{partialSigs[1], partialSigs[0]})
+	require.ErrorContains(t, err, "invalid partial signature")
+
+	tampered := bytes.Clone(partialSigs[1])
+	tampered[0] ^= 0x01
+	_, err = pubKey.CombineSignatures(msg, MuSig2Schnorr, pubNonces, [][]byte{partialSigs[0], tampered})
+	require.ErrorContains(t, err, "invalid partial signature of co-signer 1")
+}
+
+func TestMuSig2GenNonceRequiresCoSigner(t *testing.T) {
+	_, pubKey := genMuSig2Signers(t, 2)
+	digest, err := pubKey.SigningDigest([]byte("msg"), MuSig2Schnorr)
+	require.NoError(t, err)
+
+	_, _, err = MuSig2GenNonce(GenPrivKey(), pubKey, digest)
+	require.ErrorContains(t, err, "not a co-signer")
+}
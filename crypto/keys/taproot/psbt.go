@@ -0,0 +1,143 @@
+package taproot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/babylonlabs-io/babylon/crypto/bip322"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// psbtMagic is the prefix of binary PSBTs, "psbt" followed by 0xff.
+var psbtMagic = []byte{0x70, 0x73, 0x62, 0x74, 0xff}
+
+// Bip322ToSignPacket returns the BIP-322 to_sign virtual transaction of msg for
+// the P2TR output of the 32-byte x-only outputKey as an unsigned PSBT. Bitcoin
+// wallets sign its single input with a key spend signature, which
+// Bip322SigFromPacket turns back into a BIP-322 simple signature of msg.
+//
+// The input carries the to_spend transaction, its spent output and the
+// default sighash type. internalKey is the optional x-only internal key of
+// outputKey, set as the BIP-371 PSBT_IN_TAP_INTERNAL_KEY so that wallets can
+// recognize their key.
+func Bip322ToSignPacket(msg, outputKey, internalKey []byte, net *chaincfg.Params) (*psbt.Packet, error) {
+	toSpend, toSign, pkScript, err := bip322Txs(msg, outputKey, net)
+	if err != nil {
+		return nil, err
+	}
+
+	packet, err := psbt.NewFromUnsignedTx(toSign)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &packet.Inputs[0]
+	input.NonWitnessUtxo = toSpend
+	input.WitnessUtxo = wire.NewTxOut(0, pkScript)
+	input.SighashType = txscript.SigHashDefault
+
+	if internalKey != nil {
+		if _, err := schnorr.ParsePubKey(internalKey); err != nil {
+			return nil, fmt.Errorf("invalid internal key: %w", err)
+		}
+		input.TaprootInternalKey = internalKey
+	}
+
+	return packet, nil
+}
+
+// Bip322SigFromPacket extracts the BIP-322 simple signature of msg from a PSBT
+// produced by Bip322ToSignPacket and signed by a Bitcoin wallet. The PSBT must
+// spend the to_sign transaction of msg for outputKey, and hold either a
+// finalized witness or the PSBT_IN_TAP_KEY_SIG key spend signature.
+func Bip322SigFromPacket(packet *psbt.Packet, msg, outputKey []byte, net *chaincfg.Params) ([]byte, error) {
+	_, toSign, _, err := bip322Txs(msg, outputKey, net)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(packet.Inputs) != 1 || packet.UnsignedTx.TxHash() != toSign.TxHash() {
+		return nil, errors.New("PSBT is not the BIP-322 to_sign transaction of the message")
+	}
+
+	input := packet.Inputs[0]
+	if input.FinalScriptWitness != nil {
+		witness, err := bip322.SimpleSigToWitness(input.FinalScriptWitness)
+		if err != nil {
+			return nil, fmt.Errorf("invalid PSBT final witness: %w", err)
+		}
+		if len(witness) != 1 || !IsSchnorrSignature(witness[0]) {
+			return nil, errors.New("PSBT final witness is not a key spend witness")
+		}
+
+		return input.FinalScriptWitness, nil
+	}
+
+	if input.TaprootKeySpendSig == nil {
+		return nil, errors.New("PSBT is not signed")
+	}
+
+	// A 65-byte signature commits to a non default sighash type, which
+	// BIP-322 simple signatures don't allow.
+	return Bip322SimpleSigFromSchnorr(input.TaprootKeySpendSig)
+}
+
+// PacketOutputKey returns the x-only output key spent by a PSBT produced by
+// Bip322ToSignPacket, i.e. the address of the key signing it.
+func PacketOutputKey(packet *psbt.Packet) ([]byte, error) {
+	if len(packet.Inputs) != 1 || packet.Inputs[0].WitnessUtxo == nil {
+		return nil, errors.New("PSBT doesn't spend a single witness output")
+	}
+
+	pkScript := packet.Inputs[0].WitnessUtxo.PkScript
+	if !txscript.IsPayToTaproot(pkScript) {
+		return nil, errors.New("PSBT doesn't spend a P2TR output")
+	}
+
+	// the script is OP_1 followed by the push of the 32-byte output key
+	return pkScript[2:], nil
+}
+
+// DecodePSBT decodes a PSBT in the binary or the base64 encoding.
+func DecodePSBT(bz []byte) (*psbt.Packet, error) {
+	bz = bytes.TrimSpace(bz)
+	if bytes.HasPrefix(bz, psbtMagic) {
+		return psbt.NewFromRawBytes(bytes.NewReader(bz), false)
+	}
+
+	return psbt.NewFromRawBytes(bytes.NewReader(bz), true)
+}
+
+// IsPSBT reports whether bz looks like a binary or base64 encoded PSBT.
+func IsPSBT(bz []byte) bool {
+	bz = bytes.TrimSpace(bz)
+
+	// "cHNidP8" is the base64 encoding of the magic bytes.
+	return bytes.HasPrefix(bz, psbtMagic) || bytes.HasPrefix(bz, []byte("cHNidP8"))
+}
+
+// bip322Txs returns the BIP-322 to_spend and to_sign virtual transactions of
+// msg for outputKey, along with the P2TR script of outputKey.
+func bip322Txs(msg, outputKey []byte, net *chaincfg.Params) (toSpend, toSign *wire.MsgTx, pkScript []byte, err error) {
+	address, err := TweakedPubKeyToP2trAddress(outputKey, net)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	pkScript, err = TweakedPubKeyToTaprootScript(outputKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	toSpend, err = bip322.GetToSpendTx(msg, address)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return toSpend, bip322.GetToSignTx(toSpend), pkScript, nil
+}
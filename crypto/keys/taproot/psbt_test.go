@@ -0,0 +1,96 @@
+package taproot
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// signPacket signs the input of packet with privKey the way a Bitcoin wallet
+// does, from the fields of the PSBT alone.
+func signPacket(t *testing.T, packet *psbt.Packet, privKey *PrivKey) {
+	t.Helper()
+
+	input := &packet.Inputs[0]
+	prevFetcher := txscript.NewCannedPrevOutputFetcher(input.WitnessUtxo.PkScript, input.WitnessUtxo.Value)
+	sigHashes := txscript.NewTxSigHashes(packet.UnsignedTx, prevFetcher)
+	sigHash, err := txscript.CalcTaprootSignatureHash(sigHashes, input.SighashType, packet.UnsignedTx, 0, prevFetcher)
+	require.NoError(t, err)
+
+	sig, err := schnorr.Sign(tweakedSigningKey(privKey), sigHash)
+	require.NoError(t, err)
+	input.TaprootKeySpendSig = sig.Serialize()
+}
+
+func TestBip322Packet(t *testing.T) {
+	privKey := GenPrivKey()
+	pubKey := privKey.PubKey().(*PubKey)
+	msg := []byte("cosmos taproot psbt")
+	net := GetBitcoinNetParams()
+
+	packet, err := Bip322ToSignPacket(msg, pubKey.Address(), nil, net)
+	require.NoError(t, err)
+	require.NoError(t, packet.SanityCheck())
+	require.Equal(t, packet.UnsignedTx.TxIn[0].PreviousOutPoint.Hash, packet.Inputs[0].NonWitnessUtxo.TxHash())
+	outputKey, err := PacketOutputKey(packet)
+	require.NoError(t, err)
+	require.Equal(t, pubKey.Address().Bytes(), outputKey)
+
+	_, err = Bip322SigFromPacket(packet, msg, pubKey.Address(), net)
+	require.ErrorContains(t, err, "not signed")
+
+	signPacket(t, packet, privKey)
+
+	// the PSBT goes through the wallet in its base64 encoding
+	encoded, err := packet.B64Encode()
+	require.NoError(t, err)
+	require.True(t, IsPSBT([]byte(encoded)))
+	decoded, err := DecodePSBT([]byte(encoded + "\n"))
+	require.NoError(t, err)
+
+	sig, err := Bip322SigFromPacket(decoded, msg, pubKey.Address(), net)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// a finalized witness is used as is
+	decoded.Inputs[0].FinalScriptWitness = sig
+	decoded.Inputs[0].TaprootKeySpendSig = nil
+	finalSig, err := Bip322SigFromPacket(decoded, msg, pubKey.Address(), net)
+	require.NoError(t, err)
+	require.Equal(t, sig, finalSig)
+
+	// the PSBT commits to the message and the signer
+	_, err = Bip322SigFromPacket(decoded, []byte("another message"), pubKey.Address(), net)
+	require.ErrorContains(t, err, "not the BIP-322 to_sign transaction")
+	_, err = Bip322SigFromPacket(decoded, msg, GenPrivKey().PubKey().Address(), net)
+	require.ErrorContains(t, err, "not the BIP-322 to_sign transaction")
+}
+
+func TestBip322PacketEncodings(t *testing.T) {
+	privKey := GenPrivKey()
+	pubKey := privKey.PubKey().(*PubKey)
+	internalKey := schnorr.SerializePubKey(secp256k1.PrivKeyFromBytes(privKey.Key).PubKey())
+	net := GetBitcoinNetParams()
+
+	packet, err := Bip322ToSignPacket([]byte("msg"), pubKey.Address(), internalKey, net)
+	require.NoError(t, err)
+	require.Equal(t, internalKey, packet.Inputs[0].TaprootInternalKey)
+
+	var raw bytes.Buffer
+	require.NoError(t, packet.Serialize(&raw))
+	require.True(t, IsPSBT(raw.Bytes()))
+	decoded, err := DecodePSBT(raw.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, internalKey, decoded.Inputs[0].TaprootInternalKey)
+
+	require.False(t, IsPSBT([]byte(`{"signatures":[]}`)))
+	_, err = DecodePSBT([]byte("not a psbt"))
+	require.Error(t, err)
+	_, err = Bip322ToSignPacket([]byte("msg"), pubKey.Address(), []byte{0x01}, net)
+	require.ErrorContains(t, err, "invalid internal key")
+}
@@ -24,9 +24,36 @@ var (
 	_ cryptotypes.PrivKey  = &PrivKey{}
 	_ codec.AminoMarshaler = &PrivKey{}
 
+	// BitcoinNetParams is the Bitcoin network resolved by GetBitcoinNetParams
+	// until SetBitcoinNetParamsFn is called. The sdk types package points it to
+	// the network of the sdk.Config.
+	//
+	// Deprecated: use GetBitcoinNetParams, which follows the network set in the
+	// sdk.Config.
 	BitcoinNetParams = &chaincfg.MainNetParams
+
+	// bitcoinNetParamsFn resolves the Bitcoin network used by PrivKey.Sign and
+	// PubKey.VerifySignature. It defaults to BitcoinNetParams; the sdk types
+	// package points it to sdk.Config so the network follows the app
+	// configuration.
+	bitcoinNetParamsFn = func() *chaincfg.Params { return BitcoinNetParams }
 )
 
+// SetBitcoinNetParamsFn sets the function resolving the Bitcoin network the
+// P2TR addresses of BIP-322 signatures are bound to. It must be called during
+// initialization, before any key is used.
+func SetBitcoinNetParamsFn(fn func() *chaincfg.Params) {
+	if fn == nil {
+		panic("bitcoin network params function cannot be nil")
+	}
+	bitcoinNetParamsFn = fn
+}
+
+// GetBitcoinNetParams returns the Bitcoin network used for signing and verification.
+func GetBitcoinNetParams() *chaincfg.Params {
+	return bitcoinNetParamsFn()
+}
+
 const (
 	PrivKeySize = 32
 	keyType     = "taproot"
@@ -7,13 +7,32 @@ import (
 // Sign creates a BIP-322 simple signature.
 func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
 	privKeyObj := secp256k1.PrivKeyFromBytes(privKey.Key)
-	return Bip322Sign(msg, privKeyObj, BitcoinNetParams)
+	return Bip322Sign(msg, privKeyObj, GetBitcoinNetParams())
 }
 
-// VerifyBytes verifies a signature of the form R || S.
-// It rejects signatures which are not in lower-S form.
+// SignSchnorr creates a raw BIP-340 Schnorr signature over sha256(msg). It is
+// accepted by VerifySignature, which checks it without building the BIP-322
+// virtual transactions.
+func (privKey *PrivKey) SignSchnorr(msg []byte) ([]byte, error) {
+	privKeyObj := secp256k1.PrivKeyFromBytes(privKey.Key)
+	return Bip340Sign(msg, privKeyObj)
+}
+
+// VerifySignature verifies either a raw 64-byte BIP-340 Schnorr signature or a
+// BIP-322 simple signature, depending on the encoding of sigStr. It rejects the
+// full and legacy BIP-322 formats, which cost more to verify than the gas the
+// ante handler charges, and whose signatures have several valid encodings.
+// Those are verified offchain by Bip322VerifyOutputKey.
 func (pubKey *PubKey) VerifySignature(msg, sigStr []byte) bool {
-	res, err := Bip322Verify(msg, sigStr, pubKey, BitcoinNetParams)
+	if IsSchnorrSignature(sigStr) {
+		res, err := Bip340Verify(msg, sigStr, pubKey)
+		if err != nil {
+			return false
+		}
+		return res
+	}
+
+	res, err := Bip322Verify(msg, sigStr, pubKey, GetBitcoinNetParams())
 	if err != nil {
 		return false
 	}
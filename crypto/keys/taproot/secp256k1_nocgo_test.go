@@ -3,6 +3,7 @@ package taproot
 import (
 	"testing"
 
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/stretchr/testify/require"
 )
 
@@ -38,3 +39,21 @@ func TestSignatureVerificationAndRejectUpperS(t *testing.T) {
 		require.False(t, pub.VerifySignature(msg, malleatedSigStr), "Malleated signature should be rejected")
 	}
 }
+
+func TestSignAndVerifyWithConfiguredNetwork(t *testing.T) {
+	defaultFn := bitcoinNetParamsFn
+	t.Cleanup(func() { SetBitcoinNetParamsFn(defaultFn) })
+
+	msg := []byte("regtest")
+	priv := GenPrivKey()
+	pub := priv.PubKey()
+
+	SetBitcoinNetParamsFn(func() *chaincfg.Params { return &chaincfg.RegressionNetParams })
+	require.Equal(t, &chaincfg.RegressionNetParams, GetBitcoinNetParams())
+
+	sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	require.Panics(t, func() { SetBitcoinNetParamsFn(nil) })
+}
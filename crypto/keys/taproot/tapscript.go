@@ -0,0 +1,408 @@
+package taproot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/babylonlabs-io/babylon/crypto/bip322"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/cometbft/cometbft/crypto"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var _ cryptotypes.PubKey = &TapscriptPubKey{}
+
+const (
+	TapscriptPubKeyName = "tendermint/PubKeyTaprootScript"
+	tapscriptKeyType    = "taproot-tapscript"
+)
+
+// UnspendableInternalKey is the "nothing up my sleeve" point H of BIP-341.
+// Nobody knows its private key, so a TapscriptPubKey using it as internal key
+// can only be spent with the script path.
+var UnspendableInternalKey, _ = hex.DecodeString("50929b74c1a04954b78b4b6035e97a5e078a5a0f28ec96d547bfee9ace803ac0")
+
+// NewTapscriptPubKey returns the key committing to the leaf scripts with the
+// 32-byte x-only internalKey. A nil internalKey disables the key path by using
+// UnspendableInternalKey. The leaves are assembled into a tree in the given
+// order, which the leaf indices of the signing functions refer to.
+func NewTapscriptPubKey(internalKey []byte, leafScripts [][]byte) (*TapscriptPubKey, error) {
+	if internalKey == nil {
+		internalKey = UnspendableInternalKey
+	}
+
+	if len(leafScripts) == 0 {
+		return nil, errors.New("a tapscript key requires at least one leaf script")
+	}
+
+	for i, script := range leafScripts {
+		if len(script) == 0 {
+			return nil, fmt.Errorf("empty leaf script %d", i)
+		}
+		for _, other := range leafScripts[:i] {
+			if bytes.Equal(script, other) {
+				return nil, fmt.Errorf("duplicate leaf script %X", script)
+			}
+		}
+	}
+
+	pubKey := &TapscriptPubKey{
+		InternalKey: bytes.Clone(internalKey),
+		LeafScripts: leafScripts,
+	}
+	if _, err := pubKey.OutputKey(); err != nil {
+		return nil, err
+	}
+
+	return pubKey, nil
+}
+
+// Address returns the x-only output key, as for a single taproot key. It panics
+// if the internal key is invalid.
+func (pubKey *TapscriptPubKey) Address() crypto.Address {
+	outputKey, err := pubKey.OutputKey()
+	if err != nil {
+		panic(err)
+	}
+
+	return outputKey.Key[1:]
+}
+
+// Bytes returns the proto encoding of the key.
+func (pubKey *TapscriptPubKey) Bytes() []byte {
+	bz, err := pubKey.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// VerifySignature verifies either a raw 64-byte BIP-340 Schnorr signature of
+// sha256(msg) with the output key, i.e. a key path spend, or an encoded
+// TapscriptSignature. The latter is the witness of the BIP-322 to_sign
+// transaction of msg, whose nLockTime is the signature lock time, and is
+// verified with the btcd script engine. It can be a key path or a script path
+// spend.
+//
+// VerifySignature doesn't check the lock time against the chain, which is the
+// job of the ante handler.
+func (pubKey *TapscriptPubKey) VerifySignature(msg, sig []byte) bool {
+	outputKey, err := pubKey.OutputKey()
+	if err != nil {
+		return false
+	}
+
+	if IsSchnorrSignature(sig) {
+		res, err := Bip340Verify(msg, sig, outputKey)
+		if err != nil {
+			return false
+		}
+		return res
+	}
+
+	tapSig, err := ParseTapscriptSignature(sig)
+	if err != nil {
+		return false
+	}
+
+	toSign, prevFetcher, err := tapscriptToSignTx(msg, outputKey.Key[1:], tapSig.LockTime)
+	if err != nil {
+		return false
+	}
+	toSign.TxIn[0].Witness = tapSig.Witness
+
+	pkScript, err := TweakedPubKeyToTaprootScript(outputKey.Key[1:])
+	if err != nil {
+		return false
+	}
+
+	vm, err := txscript.NewEngine(
+		pkScript, toSign, 0, txscript.StandardVerifyFlags, nil,
+		txscript.NewTxSigHashes(toSign, prevFetcher), 0, prevFetcher,
+	)
+	if err != nil {
+		return false
+	}
+
+	return vm.Execute() == nil
+}
+
+func (pubKey *TapscriptPubKey) Equals(other cryptotypes.PubKey) bool {
+	otherKey, ok := other.(*TapscriptPubKey)
+	if !ok || !bytes.Equal(pubKey.InternalKey, otherKey.InternalKey) ||
+		len(pubKey.LeafScripts) != len(otherKey.LeafScripts) {
+		return false
+	}
+
+	for i, script := range pubKey.LeafScripts {
+		if !bytes.Equal(script, otherKey.LeafScripts[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (pubKey *TapscriptPubKey) Type() string {
+	return tapscriptKeyType
+}
+
+func (pubKey *TapscriptPubKey) String() string {
+	scripts := make([]string, len(pubKey.LeafScripts))
+	for i, script := range pubKey.LeafScripts {
+		scripts[i] = fmt.Sprintf("%X", script)
+	}
+
+	return fmt.Sprintf("PubKeyTaprootScript{%X,[%s]}", pubKey.InternalKey, strings.Join(scripts, ","))
+}
+
+// OutputKey returns the internal key tweaked with the root of the script tree,
+// i.e. the taproot key the key path signatures verify against.
+func (pubKey *TapscriptPubKey) OutputKey() (*PubKey, error) {
+	internalKey, err := schnorr.ParsePubKey(pubKey.InternalKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid internal key: %w", err)
+	}
+
+	rootHash := pubKey.tree().RootNode.TapHash()
+	outputKey := txscript.ComputeTaprootOutputKey(internalKey, rootHash[:])
+
+	key := make([]byte, PubKeySize)
+	key[0] = 0x02
+	copy(key[1:], schnorr.SerializePubKey(outputKey))
+
+	return &PubKey{Key: key}, nil
+}
+
+// ControlBlock returns the control block proving that the leaf script at index
+// leaf is committed to by the output key.
+func (pubKey *TapscriptPubKey) ControlBlock(leaf int) ([]byte, error) {
+	if err := pubKey.checkLeaf(leaf); err != nil {
+		return nil, err
+	}
+
+	internalKey, err := schnorr.ParsePubKey(pubKey.InternalKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid internal key: %w", err)
+	}
+
+	proof := pubKey.tree().LeafMerkleProofs[leaf]
+	controlBlock := proof.ToControlBlock(internalKey)
+
+	return controlBlock.ToBytes()
+}
+
+// ScriptPathSignature encodes the signature spending msg with the leaf script
+// at index leaf. The stack holds the items satisfying the script, the last one
+// being the top of the stack, and lockTime must be the one the signatures of
+// the stack committed to. The leaf script and its control block are appended
+// to the witness.
+func (pubKey *TapscriptPubKey) ScriptPathSignature(leaf int, lockTime uint32, stack [][]byte) ([]byte, error) {
+	controlBlock, err := pubKey.ControlBlock(leaf)
+	if err != nil {
+		return nil, err
+	}
+
+	witness := make([][]byte, 0, len(stack)+2)
+	witness = append(witness, stack...)
+	witness = append(witness, pubKey.LeafScripts[leaf], controlBlock)
+
+	sig := TapscriptSignature{Witness: witness, LockTime: lockTime}
+	return sig.Marshal()
+}
+
+// TapscriptKeyPathSign signs msg on behalf of pubKey with the key path, which
+// requires privKey to be the internal key, i.e. its PubKey address to be the
+// internal key of pubKey. It returns a raw BIP-340 Schnorr signature of
+// sha256(msg).
+func TapscriptKeyPathSign(privKey *PrivKey, pubKey *TapscriptPubKey, msg []byte) ([]byte, error) {
+	if !bytes.Equal(privKey.PubKey().Address(), pubKey.InternalKey) {
+		return nil, errors.New("the key is not the internal key of the tapscript key")
+	}
+
+	rootHash := pubKey.tree().RootNode.TapHash()
+	outputKey := txscript.TweakTaprootPrivKey(*tweakedSigningKey(privKey), rootHash[:])
+	hash := sha256.Sum256(msg)
+
+	sig, err := schnorr.Sign(outputKey, hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return sig.Serialize(), nil
+}
+
+// TapscriptLeafSign returns the raw BIP-340 Schnorr signature of privKey
+// spending msg with the leaf script at index leaf, to be pushed on the stack of
+// ScriptPathSignature. Leaf scripts refer to the key of privKey by the x-only
+// form of its PubKey, i.e. its address. The signature commits to lockTime.
+func TapscriptLeafSign(privKey *PrivKey, pubKey *TapscriptPubKey, msg []byte, leaf int, lockTime uint32) ([]byte, error) {
+	if err := pubKey.checkLeaf(leaf); err != nil {
+		return nil, err
+	}
+
+	outputKey, err := pubKey.OutputKey()
+	if err != nil {
+		return nil, err
+	}
+
+	toSign, prevFetcher, err := tapscriptToSignTx(msg, outputKey.Key[1:], lockTime)
+	if err != nil {
+		return nil, err
+	}
+
+	sigHash, err := txscript.CalcTapscriptSignaturehash(
+		txscript.NewTxSigHashes(toSign, prevFetcher), txscript.SigHashDefault,
+		toSign, 0, prevFetcher, txscript.NewBaseTapLeaf(pubKey.LeafScripts[leaf]),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := schnorr.Sign(tweakedSigningKey(privKey), sigHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return sig.Serialize(), nil
+}
+
+// ParseTapscriptSignature decodes a TapscriptSignature from its proto encoding.
+func ParseTapscriptSignature(sig []byte) (*TapscriptSignature, error) {
+	var tapSig TapscriptSignature
+	if err := tapSig.Unmarshal(sig); err != nil {
+		return nil, fmt.Errorf("invalid tapscript signature: %w", err)
+	}
+
+	if len(tapSig.Witness) == 0 {
+		return nil, errors.New("invalid tapscript signature: empty witness")
+	}
+
+	return &tapSig, nil
+}
+
+// LockTimeReached reports whether a block at height and blockTime satisfies the
+// lock time of the signature. As for nLockTime in Bitcoin, a lock time below
+// 500,000,000 is a block height and a unix timestamp otherwise.
+func (sig *TapscriptSignature) LockTimeReached(height int64, blockTime time.Time) bool {
+	if sig.LockTime < txscript.LockTimeThreshold {
+		return int64(sig.LockTime) <= height
+	}
+
+	return int64(sig.LockTime) <= blockTime.Unix()
+}
+
+// NumSignatures returns the number of Schnorr signatures the script engine may
+// check to verify the signature.
+func (sig *TapscriptSignature) NumSignatures() int {
+	stack := sig.Witness
+	if len(stack) > 1 {
+		// the leaf script and the control block of a script path spend
+		stack = stack[:len(stack)-2]
+	}
+
+	n := 0
+	for _, item := range stack {
+		if len(item) == schnorr.SignatureSize || len(item) == schnorr.SignatureSize+1 {
+			n++
+		}
+	}
+
+	return n
+}
+
+// NewTimelockScript returns a leaf script spendable by pubKey once the block
+// height, or block time, reached lockTime:
+//
+//	<lockTime> OP_CHECKLOCKTIMEVERIFY OP_DROP <pubKey> OP_CHECKSIG
+//
+// Its stack is the single signature of TapscriptLeafSign, with a lock time of
+// at least lockTime.
+func NewTimelockScript(pubKey *PubKey, lockTime uint32) ([]byte, error) {
+	return txscript.NewScriptBuilder().
+		AddInt64(int64(lockTime)).
+		AddOp(txscript.OP_CHECKLOCKTIMEVERIFY).
+		AddOp(txscript.OP_DROP).
+		AddData(pubKey.Address()).
+		AddOp(txscript.OP_CHECKSIG).
+		Script()
+}
+
+// NewMultiSigScript returns a leaf script spendable by threshold signatures of
+// pubKeys:
+//
+//	<pubKey1> OP_CHECKSIG <pubKey2> OP_CHECKSIGADD ... <threshold> OP_NUMEQUAL
+//
+// Its stack holds one item per key, in the reverse order of pubKeys: the
+// signature of the key, or an empty item if the key doesn't sign.
+func NewMultiSigScript(threshold int, pubKeys []*PubKey) ([]byte, error) {
+	if threshold <= 0 || threshold > len(pubKeys) {
+		return nil, fmt.Errorf("invalid threshold %d of %d keys", threshold, len(pubKeys))
+	}
+
+	builder := txscript.NewScriptBuilder()
+	for i, pk := range pubKeys {
+		builder.AddData(pk.Address())
+		if i == 0 {
+			builder.AddOp(txscript.OP_CHECKSIG)
+		} else {
+			builder.AddOp(txscript.OP_CHECKSIGADD)
+		}
+	}
+
+	return builder.
+		AddInt64(int64(threshold)).
+		AddOp(txscript.OP_NUMEQUAL).
+		Script()
+}
+
+func (pubKey *TapscriptPubKey) tree() *txscript.IndexedTapScriptTree {
+	leaves := make([]txscript.TapLeaf, len(pubKey.LeafScripts))
+	for i, script := range pubKey.LeafScripts {
+		leaves[i] = txscript.NewBaseTapLeaf(script)
+	}
+
+	return txscript.AssembleTaprootScriptTree(leaves...)
+}
+
+func (pubKey *TapscriptPubKey) checkLeaf(leaf int) error {
+	if leaf < 0 || leaf >= len(pubKey.LeafScripts) {
+		return fmt.Errorf("invalid leaf %d of %d leaf scripts", leaf, len(pubKey.LeafScripts))
+	}
+
+	return nil
+}
+
+// tapscriptToSignTx returns the BIP-322 to_sign transaction of msg for the P2TR
+// output of the x-only outputKey, with the given nLockTime. Its input sequence
+// is 0, which enables OP_CHECKLOCKTIMEVERIFY.
+func tapscriptToSignTx(msg, outputKey []byte, lockTime uint32) (*wire.MsgTx, txscript.PrevOutputFetcher, error) {
+	address, err := TweakedPubKeyToP2trAddress(outputKey, GetBitcoinNetParams())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pkScript, err := TweakedPubKeyToTaprootScript(outputKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	toSpend, err := bip322.GetToSpendTx(msg, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	toSign := bip322.GetToSignTx(toSpend)
+	toSign.LockTime = lockTime
+
+	return toSign, txscript.NewCannedPrevOutputFetcher(pkScript, 0), nil
+}
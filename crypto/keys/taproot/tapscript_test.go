@@ -0,0 +1,191 @@
+package taproot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTapscriptPubKey(t *testing.T) {
+	owner, recovery := GenPrivKey(), GenPrivKey()
+	timelock, err := NewTimelockScript(recovery.PubKey().(*PubKey), 1000)
+	require.NoError(t, err)
+	multisig, err := NewMultiSigScript(1, []*PubKey{owner.PubKey().(*PubKey), recovery.PubKey().(*PubKey)})
+	require.NoError(t, err)
+
+	pubKey, err := NewTapscriptPubKey(owner.PubKey().Address(), [][]byte{timelock, multisig})
+	require.NoError(t, err)
+
+	// the address is the output key committing to the script tree
+	internalKey, err := schnorr.ParsePubKey(pubKey.InternalKey)
+	require.NoError(t, err)
+	tree := txscript.AssembleTaprootScriptTree(txscript.NewBaseTapLeaf(timelock), txscript.NewBaseTapLeaf(multisig))
+	rootHash := tree.RootNode.TapHash()
+	outputKey := txscript.ComputeTaprootOutputKey(internalKey, rootHash[:])
+	require.Equal(t, schnorr.SerializePubKey(outputKey), pubKey.Address().Bytes())
+
+	address, err := TweakedPubKeyToP2trAddress(pubKey.Address(), GetBitcoinNetParams())
+	require.NoError(t, err)
+	decoded, err := btcutil.DecodeAddress(address.EncodeAddress(), GetBitcoinNetParams())
+	require.NoError(t, err)
+	require.IsType(t, &btcutil.AddressTaproot{}, decoded)
+
+	// the leaf order is part of the key
+	swapped, err := NewTapscriptPubKey(owner.PubKey().Address(), [][]byte{multisig, timelock})
+	require.NoError(t, err)
+	require.False(t, pubKey.Equals(swapped))
+
+	// a nil internal key disables the key path
+	scriptOnly, err := NewTapscriptPubKey(nil, [][]byte{timelock})
+	require.NoError(t, err)
+	require.Equal(t, UnspendableInternalKey, scriptOnly.InternalKey)
+	_, err = TapscriptKeyPathSign(owner, scriptOnly, []byte("msg"))
+	require.ErrorContains(t, err, "not the internal key")
+
+	_, err = NewTapscriptPubKey(nil, nil)
+	require.Error(t, err)
+	_, err = NewTapscriptPubKey(nil, [][]byte{timelock, timelock})
+	require.ErrorContains(t, err, "duplicate")
+	_, err = NewTapscriptPubKey([]byte{0x01}, [][]byte{timelock})
+	require.ErrorContains(t, err, "invalid internal key")
+	_, err = NewMultiSigScript(3, []*PubKey{owner.PubKey().(*PubKey), recovery.PubKey().(*PubKey)})
+	require.ErrorContains(t, err, "invalid threshold")
+}
+
+func TestTapscriptKeyPath(t *testing.T) {
+	owner, recovery := GenPrivKey(), GenPrivKey()
+	timelock, err := NewTimelockScript(recovery.PubKey().(*PubKey), 1000)
+	require.NoError(t, err)
+	pubKey, err := NewTapscriptPubKey(owner.PubKey().Address(), [][]byte{timelock})
+	require.NoError(t, err)
+	msg := []byte("cosmos taproot tapscript")
+
+	sig, err := TapscriptKeyPathSign(owner, pubKey, msg)
+	require.NoError(t, err)
+	require.True(t, IsSchnorrSignature(sig))
+	require.True(t, pubKey.VerifySignature(msg, sig))
+	require.False(t, pubKey.VerifySignature([]byte("another message"), sig))
+
+	// the signature of the internal key alone doesn't spend the output key
+	ownerSig, err := owner.SignSchnorr(msg)
+	require.NoError(t, err)
+	require.False(t, pubKey.VerifySignature(msg, ownerSig))
+}
+
+func TestTapscriptTimelockPath(t *testing.T) {
+	owner, recovery := GenPrivKey(), GenPrivKey()
+	timelock, err := NewTimelockScript(recovery.PubKey().(*PubKey), 1000)
+	require.NoError(t, err)
+	pubKey, err := NewTapscriptPubKey(owner.PubKey().Address(), [][]byte{timelock})
+	require.NoError(t, err)
+	msg := []byte("cosmos taproot tapscript")
+
+	testCases := []struct {
+		name     string
+		signer   *PrivKey
+		lockTime uint32
+		valid    bool
+	}{
+		{"lock time reached", recovery, 1000, true},
+		{"lock time after the script lock time", recovery, 1200, true},
+		{"lock time before the script lock time", recovery, 999, false},
+		{"lock time as a timestamp", recovery, uint32(time.Now().Unix()), false},
+		{"wrong signer", owner, 1000, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			leafSig, err := TapscriptLeafSign(tc.signer, pubKey, msg, 0, tc.lockTime)
+			require.NoError(t, err)
+			sig, err := pubKey.ScriptPathSignature(0, tc.lockTime, [][]byte{leafSig})
+			require.NoError(t, err)
+			require.Equal(t, tc.valid, pubKey.VerifySignature(msg, sig))
+
+			tapSig, err := ParseTapscriptSignature(sig)
+			require.NoError(t, err)
+			require.Equal(t, tc.lockTime, tapSig.LockTime)
+			require.Equal(t, 1, tapSig.NumSignatures())
+		})
+	}
+
+	// the signature commits to its lock time
+	leafSig, err := TapscriptLeafSign(recovery, pubKey, msg, 0, 1000)
+	require.NoError(t, err)
+	sig, err := pubKey.ScriptPathSignature(0, 1001, [][]byte{leafSig})
+	require.NoError(t, err)
+	require.False(t, pubKey.VerifySignature(msg, sig))
+}
+
+func TestTapscriptMultiSigPath(t *testing.T) {
+	privKeys := []*PrivKey{GenPrivKey(), GenPrivKey(), GenPrivKey()}
+	pubKeys := []*PubKey{privKeys[0].PubKey().(*PubKey), privKeys[1].PubKey().(*PubKey), privKeys[2].PubKey().(*PubKey)}
+
+	timelock, err := NewTimelockScript(pubKeys[0], 1000)
+	require.NoError(t, err)
+	multisig, err := NewMultiSigScript(2, pubKeys)
+	require.NoError(t, err)
+	pubKey, err := NewTapscriptPubKey(nil, [][]byte{timelock, multisig})
+	require.NoError(t, err)
+	msg := []byte("cosmos taproot tapscript")
+
+	// the stack lists the signatures in the reverse order of the keys
+	stack := func(signers ...bool) [][]byte {
+		items := make([][]byte, len(privKeys))
+		for i, signs := range signers {
+			if signs {
+				items[len(privKeys)-1-i], err = TapscriptLeafSign(privKeys[i], pubKey, msg, 1, 0)
+				require.NoError(t, err)
+			} else {
+				items[len(privKeys)-1-i] = []byte{}
+			}
+		}
+		return items
+	}
+
+	sig, err := pubKey.ScriptPathSignature(1, 0, stack(true, false, true))
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+	require.False(t, pubKey.VerifySignature([]byte("another message"), sig))
+
+	tapSig, err := ParseTapscriptSignature(sig)
+	require.NoError(t, err)
+	require.Equal(t, 2, tapSig.NumSignatures())
+	require.True(t, tapSig.LockTimeReached(0, time.Time{}))
+
+	sig, err = pubKey.ScriptPathSignature(1, 0, stack(false, true, false))
+	require.NoError(t, err)
+	require.False(t, pubKey.VerifySignature(msg, sig))
+
+	// the control block of another leaf doesn't prove the leaf script
+	sig, err = pubKey.ScriptPathSignature(0, 0, stack(true, true, true))
+	require.NoError(t, err)
+	require.False(t, pubKey.VerifySignature(msg, sig))
+
+	_, err = pubKey.ScriptPathSignature(2, 0, nil)
+	require.ErrorContains(t, err, "invalid leaf")
+	require.False(t, pubKey.VerifySignature(msg, []byte("not a signature")))
+}
+
+func TestTapscriptSignatureLockTimeReached(t *testing.T) {
+	blockTime := time.Unix(1_700_000_000, 0)
+
+	testCases := []struct {
+		lockTime uint32
+		reached  bool
+	}{
+		{0, true},
+		{100, true},
+		{101, false},
+		{1_700_000_000, true},
+		{1_700_000_001, false},
+	}
+
+	for _, tc := range testCases {
+		sig := TapscriptSignature{LockTime: tc.lockTime}
+		require.Equal(t, tc.reached, sig.LockTimeReached(100, blockTime), tc.lockTime)
+	}
+}
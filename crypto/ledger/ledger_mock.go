@@ -14,6 +14,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	csecp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -25,6 +26,9 @@ func init() {
 	options.discoverLedger = func() (SECP256K1, error) {
 		return LedgerSECP256K1Mock{}, nil
 	}
+	options.discoverTaprootLedger = func() (TAPROOT, error) {
+		return LedgerTaprootMock{}, nil
+	}
 
 	initOptionsDefault()
 }
@@ -110,3 +114,69 @@ func (mock LedgerSECP256K1Mock) ShowAddressSECP256K1(bip32Path []uint32, hrp str
 	fmt.Printf("Request to show address for %v at %v", hrp, bip32Path)
 	return nil
 }
+
+// LedgerTaprootMock mocks a Ledger app deriving Taproot keys on BIP-86 paths
+// from the test mnemonic.
+type LedgerTaprootMock struct{}
+
+func (mock LedgerTaprootMock) Close() error {
+	return nil
+}
+
+// GetPublicKeyTaproot mocks a ledger device
+// as per the TAPROOT API, it returns the compressed internal key
+func (mock LedgerTaprootMock) GetPublicKeyTaproot(derivationPath []uint32) ([]byte, error) {
+	priv, err := mock.derivePrivKey(derivationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return priv.PubKey().SerializeCompressed(), nil
+}
+
+// GetAddressPubKeyTaproot mocks a ledger device
+// as per the TAPROOT API, it returns the compressed internal key and the address
+// of the tweaked output key
+func (mock LedgerTaprootMock) GetAddressPubKeyTaproot(derivationPath []uint32, hrp string) ([]byte, string, error) {
+	priv, err := mock.derivePrivKey(derivationPath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pub := &taproot.PubKey{Key: taproot.TweakPubKey(priv.PubKey())}
+	addr := sdk.AccAddress(pub.Address()).String()
+	return priv.PubKey().SerializeCompressed(), addr, nil
+}
+
+func (mock LedgerTaprootMock) SignBIP322Taproot(derivationPath []uint32, message []byte) ([]byte, error) {
+	priv, err := mock.derivePrivKey(derivationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return taproot.Bip322Sign(message, priv, taproot.GetBitcoinNetParams())
+}
+
+func (mock LedgerTaprootMock) derivePrivKey(derivationPath []uint32) (*secp.PrivateKey, error) {
+	if derivationPath[0] != TaprootPurpose {
+		return nil, errors.New("invalid derivation path")
+	}
+
+	if derivationPath[1] != sdk.GetConfig().GetCoinType() {
+		return nil, errors.New("invalid derivation path")
+	}
+
+	seed, err := bip39.NewSeedWithErrorChecking(testdata.TestMnemonic, "")
+	if err != nil {
+		return nil, err
+	}
+
+	path := hd.NewParams(derivationPath[0], derivationPath[1], derivationPath[2], derivationPath[3] != 0, derivationPath[4])
+	masterPriv, ch := hd.ComputeMastersFromSeed(seed)
+	derivedPriv, err := hd.DerivePrivateKeyForPath(masterPriv, ch, path.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return secp.PrivKeyFromBytes(derivedPriv), nil
+}
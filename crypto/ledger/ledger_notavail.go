@@ -16,6 +16,9 @@ func init() {
 	options.discoverLedger = func() (SECP256K1, error) {
 		return nil, errors.New("support for ledger devices is not available in this executable")
 	}
+	options.discoverTaprootLedger = func() (TAPROOT, error) {
+		return nil, errors.New("support for ledger devices is not available in this executable")
+	}
 
 	initOptionsDefault()
 }
@@ -4,6 +4,8 @@
 package ledger
 
 import (
+	"errors"
+
 	ledger "github.com/cosmos/ledger-cosmos-go"
 )
 
@@ -19,6 +21,12 @@ func init() {
 
 		return device, nil
 	}
+	// The Cosmos Ledger app only signs with secp256k1 ECDSA keys. Chains using
+	// Taproot keys must register a driver for a BIP-86 capable app with
+	// SetDiscoverTaprootLedger.
+	options.discoverTaprootLedger = func() (TAPROOT, error) {
+		return nil, errors.New("no Ledger app driver for taproot keys has been registered")
+	}
 
 	initOptionsDefault()
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
@@ -44,10 +45,11 @@ type (
 	// Options hosts customization options to account for differences in Ledger
 	// signing and usage across chains.
 	Options struct {
-		discoverLedger    discoverLedgerFn
-		createPubkey      createPubkeyFn
-		appName           string
-		skipDERConversion bool
+		discoverLedger        discoverLedgerFn
+		discoverTaprootLedger discoverTaprootLedgerFn
+		createPubkey          createPubkeyFn
+		appName               string
+		skipDERConversion     bool
 	}
 
 	// PrivKeyLedgerSecp256k1 implements PrivKey, calling the ledger nano we
@@ -158,6 +160,10 @@ func (pkl PrivKeyLedgerSecp256k1) SignLedgerAminoJSON(message []byte) ([]byte, e
 
 // ShowAddress triggers a ledger device to show the corresponding address.
 func ShowAddress(path hd.BIP44Params, expectedPubKey types.PubKey, accountAddressPrefix string) error {
+	if _, ok := expectedPubKey.(*taproot.PubKey); ok {
+		return showTaprootAddress(path, expectedPubKey, accountAddressPrefix)
+	}
+
 	device, err := getDevice()
 	if err != nil {
 		return err
@@ -0,0 +1,284 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+
+	secp "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// TaprootPurpose is the BIP-86 purpose of the derivation paths Taproot keys
+// are derived on, e.g. m/86'/0'/0'/0/0.
+const TaprootPurpose = 86
+
+type (
+	// discoverTaprootLedgerFn defines a Ledger discovery function that returns a
+	// connected device able to derive Taproot keys or an error upon failure.
+	discoverTaprootLedgerFn func() (TAPROOT, error)
+
+	// TAPROOT reflects an interface a Ledger API must implement for Taproot keys.
+	// Keys are derived on BIP-86 paths and messages are signed as BIP-322 simple
+	// signatures of the key spend path.
+	TAPROOT interface {
+		Close() error
+		// Returns the compressed internal (untweaked) pubkey
+		GetPublicKeyTaproot([]uint32) ([]byte, error)
+		// Returns the compressed internal pubkey and the address (requires user confirmation)
+		GetAddressPubKeyTaproot([]uint32, string) ([]byte, string, error)
+		// Signs a message and returns a serialized BIP-322 simple signature
+		// (requires user confirmation)
+		SignBIP322Taproot([]uint32, []byte) ([]byte, error)
+	}
+
+	// PrivKeyLedgerTaproot implements PrivKey for Taproot keys held on a
+	// Ledger device. As for PrivKeyLedgerSecp256k1, the PubKey retrieved on the
+	// first call is cached so that it can be used without the device attached.
+	PrivKeyLedgerTaproot struct {
+		// CachedPubKey is the tweaked output key the account address is
+		// derived from.
+		CachedPubKey types.PubKey
+		Path         hd.BIP44Params
+	}
+)
+
+// SetDiscoverTaprootLedger sets the function used to discover a Ledger device
+// able to derive and sign with Taproot keys.
+func SetDiscoverTaprootLedger(fn discoverTaprootLedgerFn) {
+	options.discoverTaprootLedger = fn
+}
+
+// NewPrivKeyTaprootUnsafe will generate a new key and store the public key for later use.
+//
+// This function is marked as unsafe as it will retrieve a pubkey without user verification.
+// It can only be used to verify a pubkey but never to create new accounts/keys. In that case,
+// please refer to NewPrivKeyTaproot
+func NewPrivKeyTaprootUnsafe(path hd.BIP44Params) (types.LedgerPrivKeyAminoJSON, error) {
+	if err := validateTaprootPath(path); err != nil {
+		return nil, err
+	}
+
+	device, err := getTaprootDevice()
+	if err != nil {
+		return nil, err
+	}
+	defer warnIfErrors(device.Close)
+
+	pubKey, err := getTaprootPubKeyUnsafe(device, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return PrivKeyLedgerTaproot{pubKey, path}, nil
+}
+
+// NewPrivKeyTaproot will generate a new key and store the public key for later use.
+// The request will require user confirmation and will show the address in the device.
+func NewPrivKeyTaproot(path hd.BIP44Params, hrp string) (types.LedgerPrivKey, string, error) {
+	if err := validateTaprootPath(path); err != nil {
+		return nil, "", err
+	}
+
+	device, err := getTaprootDevice()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to retrieve device: %w", err)
+	}
+	defer warnIfErrors(device.Close)
+
+	pubKey, addr, err := getTaprootPubKeyAddrSafe(device, path, hrp)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to recover pubkey: %w", err)
+	}
+
+	return PrivKeyLedgerTaproot{pubKey, path}, addr, nil
+}
+
+// PubKey returns the cached public key.
+func (pkl PrivKeyLedgerTaproot) PubKey() types.PubKey {
+	return pkl.CachedPubKey
+}
+
+// Sign returns a BIP-322 simple signature for the corresponding message.
+func (pkl PrivKeyLedgerTaproot) Sign(message []byte) ([]byte, error) {
+	device, err := getTaprootDevice()
+	if err != nil {
+		return nil, err
+	}
+	defer warnIfErrors(device.Close)
+
+	return signTaproot(device, pkl, message)
+}
+
+// SignLedgerAminoJSON returns a BIP-322 simple signature for the corresponding
+// message. BIP-322 signs the raw sign bytes, so the device does not need to
+// know which sign mode produced them and this is equivalent to Sign.
+func (pkl PrivKeyLedgerTaproot) SignLedgerAminoJSON(message []byte) ([]byte, error) {
+	return pkl.Sign(message)
+}
+
+// ValidateKey allows us to verify the sanity of a public key after loading it
+// from disk.
+func (pkl PrivKeyLedgerTaproot) ValidateKey() error {
+	device, err := getTaprootDevice()
+	if err != nil {
+		return err
+	}
+	defer warnIfErrors(device.Close)
+
+	return validateTaprootKey(device, pkl)
+}
+
+// TaprootInternalKey reads the x-only internal key of the Taproot key on the
+// given path from a ledger device, the key its output key is tweaked from.
+func TaprootInternalKey(path hd.BIP44Params) ([]byte, error) {
+	if err := validateTaprootPath(path); err != nil {
+		return nil, err
+	}
+
+	device, err := getTaprootDevice()
+	if err != nil {
+		return nil, err
+	}
+	defer warnIfErrors(device.Close)
+
+	publicKey, err := device.GetPublicKeyTaproot(path.DerivationPath())
+	if err != nil {
+		return nil, fmt.Errorf("please open the %v app on the Ledger device - error: %w", options.appName, err)
+	}
+	if len(publicKey) != taproot.PubKeySize {
+		return nil, fmt.Errorf("invalid public key length %d", len(publicKey))
+	}
+
+	return publicKey[1:], nil
+}
+
+// showTaprootAddress triggers a ledger device to show the address of the
+// Taproot key on the given path.
+func showTaprootAddress(path hd.BIP44Params, expectedPubKey types.PubKey, accountAddressPrefix string) error {
+	device, err := getTaprootDevice()
+	if err != nil {
+		return err
+	}
+	defer warnIfErrors(device.Close)
+
+	pubKey, _, err := getTaprootPubKeyAddrSafe(device, path, accountAddressPrefix)
+	if err != nil {
+		return err
+	}
+
+	if !pubKey.Equals(expectedPubKey) {
+		return fmt.Errorf("the key's pubkey does not match with the one retrieved from Ledger. Check that the HD path and device are the correct ones")
+	}
+
+	return nil
+}
+
+// AssertIsPrivKeyInner implements the PrivKey interface. It performs a no-op.
+func (pkl *PrivKeyLedgerTaproot) AssertIsPrivKeyInner() {}
+
+// Bytes implements the PrivKey interface. It stores the cached public key so
+// we can verify the same key when we reconnect to a ledger.
+func (pkl PrivKeyLedgerTaproot) Bytes() []byte {
+	return cdc.MustMarshal(pkl)
+}
+
+// Equals implements the PrivKey interface. It makes sure two private keys
+// refer to the same public key.
+func (pkl PrivKeyLedgerTaproot) Equals(other types.LedgerPrivKey) bool {
+	if otherKey, ok := other.(PrivKeyLedgerTaproot); ok {
+		return pkl.CachedPubKey.Equals(otherKey.CachedPubKey)
+	}
+	return false
+}
+
+func (pkl PrivKeyLedgerTaproot) Type() string { return "PrivKeyLedgerTaproot" }
+
+func getTaprootDevice() (TAPROOT, error) {
+	if options.discoverTaprootLedger == nil {
+		return nil, errors.New("no Ledger discovery function defined for taproot keys")
+	}
+
+	device, err := options.discoverTaprootLedger()
+	if err != nil {
+		return nil, fmt.Errorf("ledger nano S: %w", err)
+	}
+
+	return device, nil
+}
+
+func validateTaprootPath(path hd.BIP44Params) error {
+	if path.Purpose != TaprootPurpose {
+		return fmt.Errorf("taproot keys must be derived on a BIP-86 path, got %s", path)
+	}
+
+	return nil
+}
+
+func validateTaprootKey(device TAPROOT, pkl PrivKeyLedgerTaproot) error {
+	pub, err := getTaprootPubKeyUnsafe(device, pkl.Path)
+	if err != nil {
+		return err
+	}
+
+	// verify this matches cached address
+	if !pub.Equals(pkl.CachedPubKey) {
+		return fmt.Errorf("cached key does not match retrieved key")
+	}
+
+	return nil
+}
+
+// signTaproot calls the ledger to sign msg after checking that the device
+// still holds the cached key.
+func signTaproot(device TAPROOT, pkl PrivKeyLedgerTaproot, msg []byte) ([]byte, error) {
+	err := validateTaprootKey(device, pkl)
+	if err != nil {
+		return nil, err
+	}
+
+	return device.SignBIP322Taproot(pkl.Path.DerivationPath(), msg)
+}
+
+// getTaprootPubKeyUnsafe reads the internal pubkey from a ledger device and
+// tweaks it into the output key committed to in the P2TR address.
+//
+// This function is marked as unsafe as it will retrieve a pubkey without user verification
+// It can only be used to verify a pubkey but never to create new accounts/keys. In that case,
+// please refer to getTaprootPubKeyAddrSafe
+func getTaprootPubKeyUnsafe(device TAPROOT, path hd.BIP44Params) (types.PubKey, error) {
+	publicKey, err := device.GetPublicKeyTaproot(path.DerivationPath())
+	if err != nil {
+		return nil, fmt.Errorf("please open the %v app on the Ledger device - error: %w", options.appName, err)
+	}
+
+	return tweakTaprootPubKey(publicKey)
+}
+
+// getTaprootPubKeyAddrSafe reads the pubkey and the address from a ledger device.
+// This function is marked as Safe as it will require user confirmation and
+// the address will be shown in the device.
+func getTaprootPubKeyAddrSafe(device TAPROOT, path hd.BIP44Params, hrp string) (types.PubKey, string, error) {
+	publicKey, addr, err := device.GetAddressPubKeyTaproot(path.DerivationPath(), hrp)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: address rejected for path %s", err, path)
+	}
+
+	pubKey, err := tweakTaprootPubKey(publicKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return pubKey, addr, nil
+}
+
+func tweakTaprootPubKey(internalKey []byte) (types.PubKey, error) {
+	cmp, err := secp.ParsePubKey(internalKey)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %w", err)
+	}
+
+	return &taproot.PubKey{Key: taproot.TweakPubKey(cmp)}, nil
+}
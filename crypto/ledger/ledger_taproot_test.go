@@ -0,0 +1,76 @@
+//go:build ledger
+// +build ledger
+
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTaprootRejectsNonBIP86Path(t *testing.T) {
+	path := *hd.NewParams(44, sdk.CoinType, 0, false, 0)
+	_, err := NewPrivKeyTaprootUnsafe(path)
+	require.ErrorContains(t, err, "BIP-86")
+
+	_, _, err = NewPrivKeyTaproot(path, "cosmos")
+	require.ErrorContains(t, err, "BIP-86")
+}
+
+func TestTaprootPublicKeyMatchesSoftwareKey(t *testing.T) {
+	path := *hd.NewFundraiserParams(0, sdk.CoinType, 0)
+	require.Equal(t, "m/86'/0'/0'/0/0", path.String())
+
+	priv, err := NewPrivKeyTaprootUnsafe(path)
+	require.NoError(t, err)
+	require.IsType(t, &taproot.PubKey{}, priv.PubKey())
+
+	// the device must derive the same key as the keyring does from the mnemonic
+	derived, err := hd.Taproot.Derive()(testdata.TestMnemonic, "", path.String())
+	require.NoError(t, err)
+	expected := hd.Taproot.Generate()(derived).PubKey()
+	require.True(t, expected.Equals(priv.PubKey()), "Is your device using test mnemonic: %s ?", testdata.TestMnemonic)
+
+	safePriv, addr, err := NewPrivKeyTaproot(path, "cosmos")
+	require.NoError(t, err)
+	require.True(t, priv.Equals(safePriv))
+	require.Equal(t, sdk.AccAddress(priv.PubKey().Address()).String(), addr)
+	require.NoError(t, ShowAddress(path, priv.PubKey(), "cosmos"))
+
+	tmp := priv.(PrivKeyLedgerTaproot)
+	require.NoError(t, tmp.ValidateKey())
+	(&tmp).AssertIsPrivKeyInner()
+
+	// Store and restore
+	var restored PrivKeyLedgerTaproot
+	require.NoError(t, cdc.Unmarshal(priv.Bytes(), &restored))
+	require.True(t, priv.Equals(restored))
+}
+
+func TestTaprootSignaturesHD(t *testing.T) {
+	for account := uint32(0); account < 100; account += 30 {
+		msg := getFakeTx(account)
+
+		path := *hd.NewFundraiserParams(account, sdk.CoinType, account/5)
+		priv, err := NewPrivKeyTaprootUnsafe(path)
+		require.NoError(t, err)
+
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+		require.False(t, taproot.IsSchnorrSignature(sig), "expected a BIP-322 simple signature")
+		require.True(t, priv.PubKey().VerifySignature(msg, sig))
+
+		sig, err = priv.SignLedgerAminoJSON(msg)
+		require.NoError(t, err)
+		require.True(t, priv.PubKey().VerifySignature(msg, sig))
+
+		// a signature must not verify for another message
+		require.False(t, priv.PubKey().VerifySignature(getFakeTx(account+1), sig))
+	}
+}
@@ -0,0 +1,299 @@
+// Package memory implements an indexer target which keeps the decoded state
+// of the modules in memory. It's registered as the "memory" indexer type, and
+// is meant for tests: its view of the indexed state is returned in the
+// IndexerInfos of indexer.StartIndexing.
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
+	"cosmossdk.io/schema/view"
+)
+
+// IndexerType is the type of the memory indexer.
+const IndexerType = "memory"
+
+func init() {
+	indexer.Register(IndexerType, indexer.Initializer{
+		InitFunc:   initIndexer,
+		ConfigType: Config{},
+	})
+}
+
+// Config is the configuration of the memory indexer, which has no options.
+type Config struct{}
+
+func initIndexer(indexer.InitParams) (indexer.InitResult, error) {
+	idx := NewIndexer()
+	return indexer.InitResult{
+		Listener: idx.Listener(),
+		View:     idx,
+	}, nil
+}
+
+var (
+	_ view.AppData  = (*Indexer)(nil)
+	_ view.AppState = (*Indexer)(nil)
+)
+
+// Indexer keeps the decoded state of the modules in memory.
+type Indexer struct {
+	mtx      sync.RWMutex
+	height   uint64
+	pending  uint64
+	modules  map[string]*moduleState
+	ordering []string
+}
+
+// NewIndexer returns an empty Indexer.
+func NewIndexer() *Indexer {
+	return &Indexer{modules: make(map[string]*moduleState)}
+}
+
+// Listener returns the app data listener which updates the Indexer.
+func (idx *Indexer) Listener() appdata.Listener {
+	return appdata.Listener{
+		InitializeModuleData: idx.initializeModuleData,
+		StartBlock:           idx.startBlock,
+		OnObjectUpdate:       idx.onObjectUpdate,
+		Commit:               idx.commit,
+	}
+}
+
+// BlockNum implements view.AppData, it returns the height of the last
+// committed block.
+func (idx *Indexer) BlockNum() (uint64, error) {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	return idx.height, nil
+}
+
+// AppState implements view.AppData.
+func (idx *Indexer) AppState() view.AppState {
+	return idx
+}
+
+// GetModule implements view.AppState, it returns nil for an unknown module.
+func (idx *Indexer) GetModule(moduleName string) (view.ModuleState, error) {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	mod, ok := idx.modules[moduleName]
+	if !ok {
+		return nil, nil
+	}
+	return mod, nil
+}
+
+// Modules implements view.AppState.
+func (idx *Indexer) Modules(f func(view.ModuleState, error) bool) {
+	idx.mtx.RLock()
+	names := append([]string(nil), idx.ordering...)
+	idx.mtx.RUnlock()
+
+	for _, name := range names {
+		mod, err := idx.GetModule(name)
+		if !f(mod, err) {
+			return
+		}
+	}
+}
+
+// NumModules implements view.AppState.
+func (idx *Indexer) NumModules() (int, error) {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	return len(idx.modules), nil
+}
+
+func (idx *Indexer) initializeModuleData(data appdata.ModuleInitializationData) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if _, ok := idx.modules[data.ModuleName]; ok {
+		return fmt.Errorf("module %s already initialized", data.ModuleName)
+	}
+
+	mod := &moduleState{
+		idx:         idx,
+		name:        data.ModuleName,
+		schema:      data.Schema,
+		collections: make(map[string]*objectCollection),
+	}
+	data.Schema.StateObjectTypes(func(objectType schema.StateObjectType) bool {
+		mod.collections[objectType.Name] = &objectCollection{
+			idx:        idx,
+			objectType: objectType,
+			objects:    make(map[string]schema.StateObjectUpdate),
+		}
+		mod.ordering = append(mod.ordering, objectType.Name)
+		return true
+	})
+	idx.modules[data.ModuleName] = mod
+	idx.ordering = append(idx.ordering, data.ModuleName)
+	sort.Strings(idx.ordering)
+
+	return nil
+}
+
+func (idx *Indexer) startBlock(data appdata.StartBlockData) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	idx.pending = data.Height
+	return nil
+}
+
+func (idx *Indexer) onObjectUpdate(data appdata.ObjectUpdateData) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	mod, ok := idx.modules[data.ModuleName]
+	if !ok {
+		return fmt.Errorf("module %s isn't initialized", data.ModuleName)
+	}
+
+	for _, update := range data.Updates {
+		coll, ok := mod.collections[update.TypeName]
+		if !ok {
+			return fmt.Errorf("unknown object type %s in module %s", update.TypeName, data.ModuleName)
+		}
+		if err := coll.apply(update); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (idx *Indexer) commit(appdata.CommitData) (func() error, error) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	idx.height = idx.pending
+	return nil, nil
+}
+
+var _ view.ModuleState = (*moduleState)(nil)
+
+type moduleState struct {
+	idx         *Indexer
+	name        string
+	schema      schema.ModuleSchema
+	collections map[string]*objectCollection
+	ordering    []string
+}
+
+func (m *moduleState) ModuleName() string {
+	return m.name
+}
+
+func (m *moduleState) ModuleSchema() schema.ModuleSchema {
+	return m.schema
+}
+
+// GetObjectCollection implements view.ModuleState, it returns nil for an
+// unknown object type.
+func (m *moduleState) GetObjectCollection(objectType string) (view.ObjectCollection, error) {
+	coll, ok := m.collections[objectType]
+	if !ok {
+		return nil, nil
+	}
+	return coll, nil
+}
+
+func (m *moduleState) ObjectCollections(f func(view.ObjectCollection, error) bool) {
+	for _, name := range m.ordering {
+		if !f(m.collections[name], nil) {
+			return
+		}
+	}
+}
+
+func (m *moduleState) NumObjectCollections() (int, error) {
+	return len(m.collections), nil
+}
+
+var _ view.ObjectCollection = (*objectCollection)(nil)
+
+type objectCollection struct {
+	idx        *Indexer
+	objectType schema.StateObjectType
+	objects    map[string]schema.StateObjectUpdate
+}
+
+func (c *objectCollection) ObjectType() schema.StateObjectType {
+	return c.objectType
+}
+
+// GetObject implements view.ObjectCollection. A deleted object is only found
+// if the object type retains its deletions.
+func (c *objectCollection) GetObject(key any) (schema.StateObjectUpdate, bool, error) {
+	c.idx.mtx.RLock()
+	defer c.idx.mtx.RUnlock()
+
+	obj, ok := c.objects[objectKey(key)]
+	return obj, ok, nil
+}
+
+// AllState implements view.ObjectCollection, the objects are iterated in the
+// order of their formatted keys.
+func (c *objectCollection) AllState(f func(schema.StateObjectUpdate, error) bool) {
+	c.idx.mtx.RLock()
+	keys := make([]string, 0, len(c.objects))
+	for key := range c.objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	objects := make([]schema.StateObjectUpdate, len(keys))
+	for i, key := range keys {
+		objects[i] = c.objects[key]
+	}
+	c.idx.mtx.RUnlock()
+
+	for _, obj := range objects {
+		if !f(obj, nil) {
+			return
+		}
+	}
+}
+
+func (c *objectCollection) Len() (int, error) {
+	c.idx.mtx.RLock()
+	defer c.idx.mtx.RUnlock()
+
+	return len(c.objects), nil
+}
+
+func (c *objectCollection) apply(update schema.StateObjectUpdate) error {
+	key := objectKey(update.Key)
+	if update.Delete {
+		if c.objectType.RetainDeletions {
+			obj := c.objects[key]
+			obj.Delete = true
+			c.objects[key] = obj
+			return nil
+		}
+		delete(c.objects, key)
+		return nil
+	}
+
+	if _, ok := update.Value.(schema.ValueUpdates); ok {
+		return fmt.Errorf("partial value updates of %s aren't supported", c.objectType.Name)
+	}
+	c.objects[key] = update
+	return nil
+}
+
+// objectKey formats the key of an object, the kinds of the key fields being
+// the same for all the objects of a type.
+func objectKey(key any) string {
+	return fmt.Sprintf("%#v", key)
+}
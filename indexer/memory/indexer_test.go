@@ -0,0 +1,117 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/view"
+)
+
+var testSchema = schema.MustCompileModuleSchema(
+	schema.StateObjectType{
+		Name:        "balances",
+		KeyFields:   []schema.Field{{Name: "address", Kind: schema.AddressKind}, {Name: "denom", Kind: schema.StringKind}},
+		ValueFields: []schema.Field{{Name: "amount", Kind: schema.IntegerKind}},
+	},
+	schema.StateObjectType{
+		Name:            "proposals",
+		KeyFields:       []schema.Field{{Name: "id", Kind: schema.Uint64Kind}},
+		ValueFields:     []schema.Field{{Name: "title", Kind: schema.StringKind}},
+		RetainDeletions: true,
+	},
+)
+
+func collectState(t *testing.T, coll view.ObjectCollection) []schema.StateObjectUpdate {
+	t.Helper()
+	var objects []schema.StateObjectUpdate
+	coll.AllState(func(obj schema.StateObjectUpdate, err error) bool {
+		require.NoError(t, err)
+		objects = append(objects, obj)
+		return true
+	})
+	return objects
+}
+
+func TestIndexer(t *testing.T) {
+	idx := NewIndexer()
+	listener := idx.Listener()
+
+	require.NoError(t, listener.InitializeModuleData(appdata.ModuleInitializationData{ModuleName: "bank", Schema: testSchema}))
+	require.Error(t, listener.InitializeModuleData(appdata.ModuleInitializationData{ModuleName: "bank", Schema: testSchema}))
+
+	addr := []byte("addr")
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: 1}))
+	require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "bank",
+		Updates: []schema.StateObjectUpdate{
+			{TypeName: "balances", Key: []interface{}{addr, "atom"}, Value: "10"},
+			{TypeName: "balances", Key: []interface{}{addr, "stake"}, Value: "20"},
+			{TypeName: "proposals", Key: uint64(1), Value: "first"},
+			{TypeName: "proposals", Key: uint64(2), Value: "second"},
+		},
+	}))
+
+	// the height is only updated on commit
+	height, err := idx.BlockNum()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), height)
+	_, err = listener.Commit(appdata.CommitData{})
+	require.NoError(t, err)
+	height, err = idx.BlockNum()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), height)
+
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: 2}))
+	require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "bank",
+		Updates: []schema.StateObjectUpdate{
+			{TypeName: "balances", Key: []interface{}{addr, "atom"}, Value: "15"},
+			{TypeName: "balances", Key: []interface{}{addr, "stake"}, Delete: true},
+			{TypeName: "proposals", Key: uint64(1), Delete: true},
+		},
+	}))
+	_, err = listener.Commit(appdata.CommitData{})
+	require.NoError(t, err)
+
+	n, err := idx.AppState().NumModules()
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	mod, err := idx.AppState().GetModule("bank")
+	require.NoError(t, err)
+	require.Equal(t, "bank", mod.ModuleName())
+
+	balances, err := mod.GetObjectCollection("balances")
+	require.NoError(t, err)
+	require.Equal(t, []schema.StateObjectUpdate{
+		{TypeName: "balances", Key: []interface{}{addr, "atom"}, Value: "15"},
+	}, collectState(t, balances))
+	_, found, err := balances.GetObject([]interface{}{addr, "stake"})
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// the deletions of the proposals are retained
+	proposals, err := mod.GetObjectCollection("proposals")
+	require.NoError(t, err)
+	obj, found, err := proposals.GetObject(uint64(1))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, schema.StateObjectUpdate{TypeName: "proposals", Key: uint64(1), Value: "first", Delete: true}, obj)
+	length, err := proposals.Len()
+	require.NoError(t, err)
+	require.Equal(t, 2, length)
+
+	require.Error(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{ModuleName: "gov"}))
+	require.Error(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "bank",
+		Updates:    []schema.StateObjectUpdate{{TypeName: "unknown", Key: uint64(1)}},
+	}))
+	require.Error(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "bank",
+		Updates: []schema.StateObjectUpdate{
+			{TypeName: "proposals", Key: uint64(2), Value: schema.MapValueUpdates{"title": "updated"}},
+		},
+	}))
+}
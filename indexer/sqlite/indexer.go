@@ -0,0 +1,224 @@
+// Package sqlite implements an indexer target which writes the decoded state
+// of the modules to an embedded SQL database, SQLite by default. It's
+// registered as the "sqlite" indexer type.
+//
+// The target registers the pure Go SQLite driver of modernc.org/sqlite as the
+// "sqlite" database/sql driver. Another driver of a database supporting the
+// same SQL dialect can be configured by name, once imported by the app.
+//
+// Each object type of a module is written to the table named
+// "<module>_<object type>", whose columns are the key and value fields of the
+// type. The updates of a block are written in a single transaction, along with
+// the height of the block in the "_indexer_meta" table.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	_ "modernc.org/sqlite" // register the sqlite database/sql driver
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
+	"cosmossdk.io/schema/logutil"
+)
+
+const (
+	// IndexerType is the type of the sqlite indexer.
+	IndexerType = "sqlite"
+
+	// DefaultDriver is the database/sql driver used when none is configured.
+	DefaultDriver = "sqlite"
+)
+
+func init() {
+	indexer.Register(IndexerType, indexer.Initializer{
+		InitFunc:   initIndexer,
+		ConfigType: Config{},
+	})
+}
+
+// Config is the configuration of the sqlite indexer.
+type Config struct {
+	// Driver is the name of the database/sql driver, "sqlite" by default.
+	Driver string `json:"driver,omitempty"`
+	// Path is the data source name passed to the driver, usually the path of
+	// the database file.
+	Path string `json:"path"`
+}
+
+func initIndexer(params indexer.InitParams) (indexer.InitResult, error) {
+	cfg, ok := params.Config.Config.(Config)
+	if !ok {
+		return indexer.InitResult{}, fmt.Errorf("invalid sqlite indexer config type %T", params.Config.Config)
+	}
+	if cfg.Path == "" {
+		return indexer.InitResult{}, errors.New("empty sqlite indexer path")
+	}
+	if cfg.Driver == "" {
+		cfg.Driver = DefaultDriver
+	}
+
+	db, err := sql.Open(cfg.Driver, cfg.Path)
+	if err != nil {
+		return indexer.InitResult{}, err
+	}
+
+	idx, err := newIndexer(params.Context, db, params.AddressCodec, params.Logger)
+	if err != nil {
+		db.Close()
+		return indexer.InitResult{}, err
+	}
+
+	return indexer.InitResult{Listener: idx.listener()}, nil
+}
+
+// indexerImpl writes the object updates to the database. Its listener is
+// called from a single goroutine by the indexer manager.
+type indexerImpl struct {
+	db           *sql.DB
+	addressCodec addressutil.AddressCodec
+	logger       logutil.Logger
+
+	tables map[string]map[string]*table
+
+	// tx and height are the transaction and the height of the block being
+	// indexed, tx is nil between two blocks
+	tx     *sql.Tx
+	height uint64
+}
+
+func newIndexer(ctx context.Context, db *sql.DB, addressCodec addressutil.AddressCodec, logger logutil.Logger) (*indexerImpl, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if logger == nil {
+		logger = logutil.NoopLogger{}
+	}
+
+	if _, err := db.ExecContext(ctx, createMetaTableSQL); err != nil {
+		return nil, fmt.Errorf("failed to create the sqlite indexer meta table: %w", err)
+	}
+
+	idx := &indexerImpl{
+		db:           db,
+		addressCodec: addressCodec,
+		logger:       logger,
+		tables:       make(map[string]map[string]*table),
+	}
+
+	go func() {
+		<-ctx.Done()
+		if err := db.Close(); err != nil {
+			logger.Error("failed to close the sqlite indexer database", "error", err)
+		}
+	}()
+
+	return idx, nil
+}
+
+func (idx *indexerImpl) listener() appdata.Listener {
+	return appdata.Listener{
+		InitializeModuleData: idx.initializeModuleData,
+		StartBlock:           idx.startBlock,
+		OnObjectUpdate:       idx.onObjectUpdate,
+		Commit:               idx.commit,
+	}
+}
+
+// execer is implemented by sql.DB and sql.Tx.
+type execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}
+
+// execer returns the transaction of the current block, or the database outside
+// of a block.
+func (idx *indexerImpl) execer() execer {
+	if idx.tx != nil {
+		return idx.tx
+	}
+	return idx.db
+}
+
+func (idx *indexerImpl) initializeModuleData(data appdata.ModuleInitializationData) error {
+	if _, ok := idx.tables[data.ModuleName]; ok {
+		return fmt.Errorf("module %s already initialized", data.ModuleName)
+	}
+
+	tables := make(map[string]*table)
+	var err error
+	data.Schema.StateObjectTypes(func(objectType schema.StateObjectType) bool {
+		t := newTable(data.ModuleName, objectType, idx.addressCodec)
+		if _, err = idx.execer().Exec(t.createSQL()); err != nil {
+			err = fmt.Errorf("failed to create table %s: %w", t.name, err)
+			return false
+		}
+		tables[objectType.Name] = t
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	idx.tables[data.ModuleName] = tables
+	return nil
+}
+
+func (idx *indexerImpl) startBlock(data appdata.StartBlockData) error {
+	if idx.tx != nil {
+		// the block which was started isn't committed
+		if err := idx.tx.Rollback(); err != nil {
+			return err
+		}
+	}
+
+	tx, err := idx.db.Begin()
+	if err != nil {
+		return err
+	}
+	idx.tx = tx
+	idx.height = data.Height
+	return nil
+}
+
+func (idx *indexerImpl) onObjectUpdate(data appdata.ObjectUpdateData) error {
+	tables, ok := idx.tables[data.ModuleName]
+	if !ok {
+		return fmt.Errorf("module %s isn't initialized", data.ModuleName)
+	}
+
+	for _, update := range data.Updates {
+		t, ok := tables[update.TypeName]
+		if !ok {
+			return fmt.Errorf("unknown object type %s in module %s", update.TypeName, data.ModuleName)
+		}
+
+		query, args, err := t.updateSQL(update)
+		if err != nil {
+			return err
+		}
+		if _, err := idx.execer().Exec(query, args...); err != nil {
+			return fmt.Errorf("failed to update table %s: %w", t.name, err)
+		}
+	}
+
+	return nil
+}
+
+func (idx *indexerImpl) commit(appdata.CommitData) (func() error, error) {
+	if idx.tx == nil {
+		return nil, errors.New("commit without a started block")
+	}
+
+	tx := idx.tx
+	idx.tx = nil
+	if _, err := tx.Exec(updateMetaHeightSQL, int64(idx.height)); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	return nil, tx.Commit()
+}
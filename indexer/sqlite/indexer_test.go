@@ -0,0 +1,291 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
+)
+
+// recorder is a database/sql driver which records the executed statements.
+type recorder struct {
+	mtx        sync.Mutex
+	statements []string
+	failOn     string
+}
+
+func (r *recorder) Open(string) (driver.Conn, error) {
+	return &recorderConn{r: r}, nil
+}
+
+func (r *recorder) record(statement string) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.failOn != "" && strings.Contains(statement, r.failOn) {
+		return errors.New("recorder failure")
+	}
+	r.statements = append(r.statements, statement)
+	return nil
+}
+
+func (r *recorder) reset() []string {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	statements := r.statements
+	r.statements = nil
+	return statements
+}
+
+type recorderConn struct {
+	r *recorder
+}
+
+func (c *recorderConn) Prepare(query string) (driver.Stmt, error) {
+	return &recorderStmt{r: c.r, query: query}, nil
+}
+
+func (c *recorderConn) Close() error { return nil }
+
+func (c *recorderConn) Begin() (driver.Tx, error) {
+	return &recorderTx{r: c.r}, c.r.record("BEGIN")
+}
+
+type recorderTx struct {
+	r *recorder
+}
+
+func (tx *recorderTx) Commit() error   { return tx.r.record("COMMIT") }
+func (tx *recorderTx) Rollback() error { return tx.r.record("ROLLBACK") }
+
+type recorderStmt struct {
+	r     *recorder
+	query string
+}
+
+func (s *recorderStmt) Close() error  { return nil }
+func (s *recorderStmt) NumInput() int { return -1 }
+
+func (s *recorderStmt) Exec(args []driver.Value) (driver.Result, error) {
+	statement := s.query
+	if len(args) > 0 {
+		statement += fmt.Sprintf(" %v", args)
+	}
+	return driver.RowsAffected(1), s.r.record(statement)
+}
+
+func (s *recorderStmt) Query([]driver.Value) (driver.Rows, error) {
+	return nil, errors.New("queries aren't supported")
+}
+
+var testRecorder = &recorder{}
+
+func init() {
+	sql.Register("recorder", testRecorder)
+}
+
+var testSchema = schema.MustCompileModuleSchema(
+	schema.StateObjectType{
+		Name:        "balances",
+		KeyFields:   []schema.Field{{Name: "address", Kind: schema.AddressKind}, {Name: "denom", Kind: schema.StringKind}},
+		ValueFields: []schema.Field{{Name: "amount", Kind: schema.IntegerKind}},
+	},
+	schema.StateObjectType{
+		Name:      "params",
+		KeyFields: []schema.Field{},
+		ValueFields: []schema.Field{
+			{Name: "max", Kind: schema.Uint64Kind},
+			{Name: "period", Kind: schema.DurationKind},
+			{Name: "memo", Kind: schema.StringKind, Nullable: true},
+		},
+	},
+	schema.StateObjectType{
+		Name:            "proposals",
+		KeyFields:       []schema.Field{{Name: "id", Kind: schema.Uint64Kind}},
+		ValueFields:     []schema.Field{{Name: "submit_time", Kind: schema.TimeKind}},
+		RetainDeletions: true,
+	},
+)
+
+func startIndexer(t *testing.T, cfg Config) appdata.Listener {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	res, err := initIndexer(indexer.InitParams{
+		Config:       indexer.Config{Type: IndexerType, Config: cfg},
+		Context:      ctx,
+		AddressCodec: addressutil.HexAddressCodec{},
+	})
+	require.NoError(t, err)
+	return res.Listener
+}
+
+func TestIndexer(t *testing.T) {
+	testRecorder.reset()
+	listener := startIndexer(t, Config{Driver: "recorder", Path: "test.db"})
+	require.Equal(t, []string{
+		`CREATE TABLE IF NOT EXISTS "_indexer_meta" (id INTEGER NOT NULL PRIMARY KEY, height INTEGER NOT NULL)`,
+	}, testRecorder.reset())
+
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: 7}))
+	require.NoError(t, listener.InitializeModuleData(appdata.ModuleInitializationData{ModuleName: "bank", Schema: testSchema}))
+	require.Error(t, listener.InitializeModuleData(appdata.ModuleInitializationData{ModuleName: "bank", Schema: testSchema}))
+
+	submitTime := time.Unix(10, 0)
+	require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "bank",
+		Updates: []schema.StateObjectUpdate{
+			{TypeName: "balances", Key: []interface{}{[]byte{0xab}, "atom"}, Value: "10"},
+			{TypeName: "balances", Key: []interface{}{[]byte{0xcd}, "atom"}, Delete: true},
+			{TypeName: "params", Value: []interface{}{uint64(1 << 63), time.Second, nil}},
+			{TypeName: "params", Value: schema.MapValueUpdates{"memo": "updated"}},
+			{TypeName: "proposals", Key: uint64(1), Value: submitTime},
+			{TypeName: "proposals", Key: uint64(2), Delete: true},
+		},
+	}))
+	_, err := listener.Commit(appdata.CommitData{})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		`BEGIN`,
+		`CREATE TABLE IF NOT EXISTS "bank_balances" ("address" TEXT NOT NULL, "denom" TEXT NOT NULL, "amount" TEXT NOT NULL, PRIMARY KEY ("address", "denom"))`,
+		`CREATE TABLE IF NOT EXISTS "bank_params" ("_id" INTEGER NOT NULL, "max" TEXT NOT NULL, "period" INTEGER NOT NULL, "memo" TEXT, PRIMARY KEY ("_id"))`,
+		`CREATE TABLE IF NOT EXISTS "bank_proposals" ("id" TEXT NOT NULL, "submit_time" INTEGER NOT NULL, "_deleted" INTEGER NOT NULL DEFAULT 0, PRIMARY KEY ("id"))`,
+		`INSERT INTO "bank_balances" ("address", "denom", "amount") VALUES (?, ?, ?) ON CONFLICT ("address", "denom") DO UPDATE SET "amount" = excluded."amount" [0xab atom 10]`,
+		`DELETE FROM "bank_balances" WHERE "address" = ? AND "denom" = ? [0xcd atom]`,
+		`INSERT INTO "bank_params" ("_id", "max", "period", "memo") VALUES (?, ?, ?, ?) ON CONFLICT ("_id") DO UPDATE SET "max" = excluded."max", "period" = excluded."period", "memo" = excluded."memo" [0 9223372036854775808 1000000000 <nil>]`,
+		`UPDATE "bank_params" SET "memo" = ? WHERE "_id" = ? [updated 0]`,
+		fmt.Sprintf(`INSERT INTO "bank_proposals" ("id", "submit_time", "_deleted") VALUES (?, ?, ?) ON CONFLICT ("id") DO UPDATE SET "submit_time" = excluded."submit_time", "_deleted" = excluded."_deleted" [1 %d 0]`, submitTime.UnixNano()),
+		`UPDATE "bank_proposals" SET "_deleted" = 1 WHERE "id" = ? [2]`,
+		`INSERT INTO "_indexer_meta" (id, height) VALUES (0, ?) ON CONFLICT (id) DO UPDATE SET height = excluded.height [7]`,
+		`COMMIT`,
+	}, testRecorder.reset())
+
+	// an invalid update fails the block, which is rolled back by the next one
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: 8}))
+	for _, update := range []schema.StateObjectUpdate{
+		{TypeName: "unknown", Key: uint64(1)},
+		{TypeName: "balances", Key: []byte{0xab}, Value: "10"},
+		{TypeName: "balances", Key: []interface{}{[]byte{0xab}, "atom"}, Value: nil},
+		{TypeName: "params", Value: schema.MapValueUpdates{"unknown": "value"}},
+		{TypeName: "proposals", Key: 1, Value: submitTime},
+	} {
+		require.Error(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+			ModuleName: "bank",
+			Updates:    []schema.StateObjectUpdate{update},
+		}), "%v", update)
+	}
+	require.Error(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{ModuleName: "gov"}))
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: 8}))
+	require.Equal(t, []string{`BEGIN`, `ROLLBACK`, `BEGIN`}, testRecorder.reset())
+}
+
+func TestIndexer_Config(t *testing.T) {
+	_, err := initIndexer(indexer.InitParams{Config: indexer.Config{Type: IndexerType, Config: Config{}}})
+	require.ErrorContains(t, err, "empty sqlite indexer path")
+
+	_, err = initIndexer(indexer.InitParams{Config: indexer.Config{Type: IndexerType, Config: Config{Driver: "unknown", Path: "test.db"}}})
+	require.ErrorContains(t, err, `unknown driver "unknown"`)
+
+	_, err = initIndexer(indexer.InitParams{Config: indexer.Config{Type: IndexerType, Config: map[string]any{}}})
+	require.Error(t, err)
+
+	testRecorder.failOn = "_indexer_meta"
+	defer func() { testRecorder.failOn = "" }()
+	_, err = initIndexer(indexer.InitParams{Config: indexer.Config{Type: IndexerType, Config: Config{Driver: "recorder", Path: "test.db"}}})
+	require.ErrorContains(t, err, "failed to create the sqlite indexer meta table")
+}
+
+func TestIndexer_SQLite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.db")
+	listener := startIndexer(t, Config{Path: path})
+
+	submitTime := time.Unix(10, 0)
+	require.NoError(t, listener.InitializeModuleData(appdata.ModuleInitializationData{ModuleName: "bank", Schema: testSchema}))
+	for height, updates := range [][]schema.StateObjectUpdate{
+		{
+			{TypeName: "balances", Key: []interface{}{[]byte{0xab}, "atom"}, Value: "10"},
+			{TypeName: "balances", Key: []interface{}{[]byte{0xcd}, "atom"}, Value: "20"},
+			{TypeName: "params", Value: []interface{}{uint64(1 << 63), time.Second, nil}},
+			{TypeName: "proposals", Key: uint64(1), Value: submitTime},
+			{TypeName: "proposals", Key: uint64(2), Value: submitTime},
+		},
+		{
+			{TypeName: "balances", Key: []interface{}{[]byte{0xab}, "atom"}, Value: "15"},
+			{TypeName: "balances", Key: []interface{}{[]byte{0xcd}, "atom"}, Delete: true},
+			{TypeName: "params", Value: schema.MapValueUpdates{"memo": "updated"}},
+			{TypeName: "proposals", Key: uint64(2), Delete: true},
+		},
+	} {
+		require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: uint64(height + 1)}))
+		require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{ModuleName: "bank", Updates: updates}))
+		_, err := listener.Commit(appdata.CommitData{})
+		require.NoError(t, err)
+	}
+
+	// the state is read back from the database file
+	db, err := sql.Open(DefaultDriver, path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	var height int64
+	require.NoError(t, db.QueryRow(`SELECT height FROM "_indexer_meta"`).Scan(&height))
+	require.Equal(t, int64(2), height)
+
+	rows, err := db.Query(`SELECT "address", "denom", "amount" FROM "bank_balances"`)
+	require.NoError(t, err)
+	var balances [][3]string
+	for rows.Next() {
+		var balance [3]string
+		require.NoError(t, rows.Scan(&balance[0], &balance[1], &balance[2]))
+		balances = append(balances, balance)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, [][3]string{{"0xab", "atom", "15"}}, balances)
+
+	var (
+		maxValue string
+		period   int64
+		memo     sql.NullString
+	)
+	require.NoError(t, db.QueryRow(`SELECT "max", "period", "memo" FROM "bank_params"`).Scan(&maxValue, &period, &memo))
+	require.Equal(t, "9223372036854775808", maxValue)
+	require.Equal(t, int64(time.Second), period)
+	require.Equal(t, sql.NullString{String: "updated", Valid: true}, memo)
+
+	rows, err = db.Query(`SELECT "id", "submit_time", "_deleted" FROM "bank_proposals" ORDER BY "id"`)
+	require.NoError(t, err)
+	type proposal struct {
+		id         string
+		submitTime int64
+		deleted    bool
+	}
+	var proposals []proposal
+	for rows.Next() {
+		var p proposal
+		require.NoError(t, rows.Scan(&p.id, &p.submitTime, &p.deleted))
+		proposals = append(proposals, p)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, []proposal{
+		{id: "1", submitTime: submitTime.UnixNano()},
+		{id: "2", submitTime: submitTime.UnixNano(), deleted: true},
+	}, proposals)
+}
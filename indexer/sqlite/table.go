@@ -0,0 +1,282 @@
+package sqlite
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+)
+
+const (
+	metaTable = "_indexer_meta"
+
+	// singletonColumn is the key column of the tables of the object types
+	// without key fields, which have a single row.
+	singletonColumn = "_id"
+	// deletedColumn marks the deleted rows of the object types which retain
+	// their deletions.
+	deletedColumn = "_deleted"
+)
+
+var (
+	createMetaTableSQL = fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s (id INTEGER NOT NULL PRIMARY KEY, height INTEGER NOT NULL)",
+		quoteIdentifier(metaTable),
+	)
+	updateMetaHeightSQL = fmt.Sprintf(
+		"INSERT INTO %s (id, height) VALUES (0, ?) ON CONFLICT (id) DO UPDATE SET height = excluded.height",
+		quoteIdentifier(metaTable),
+	)
+)
+
+// table is the table of an object type.
+type table struct {
+	name         string
+	objectType   schema.StateObjectType
+	addressCodec addressutil.AddressCodec
+}
+
+func newTable(moduleName string, objectType schema.StateObjectType, addressCodec addressutil.AddressCodec) *table {
+	return &table{
+		name:         moduleName + "_" + objectType.Name,
+		objectType:   objectType,
+		addressCodec: addressCodec,
+	}
+}
+
+// createSQL returns the statement which creates the table if it doesn't exist.
+func (t *table) createSQL() string {
+	var columns, keys []string
+	if len(t.objectType.KeyFields) == 0 {
+		columns = append(columns, quoteIdentifier(singletonColumn)+" INTEGER NOT NULL")
+		keys = append(keys, quoteIdentifier(singletonColumn))
+	}
+	for _, field := range t.objectType.KeyFields {
+		columns = append(columns, t.columnDefinition(field, true))
+		keys = append(keys, quoteIdentifier(field.Name))
+	}
+	for _, field := range t.objectType.ValueFields {
+		columns = append(columns, t.columnDefinition(field, false))
+	}
+	if t.objectType.RetainDeletions {
+		columns = append(columns, quoteIdentifier(deletedColumn)+" INTEGER NOT NULL DEFAULT 0")
+	}
+	columns = append(columns, fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(keys, ", ")))
+
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", quoteIdentifier(t.name), strings.Join(columns, ", "))
+}
+
+func (t *table) columnDefinition(field schema.Field, key bool) string {
+	def := quoteIdentifier(field.Name) + " " + t.columnType(field.Kind)
+	if key || !field.Nullable {
+		def += " NOT NULL"
+	}
+	return def
+}
+
+// columnType returns the SQLite type of the column of a field kind. The
+// unsigned 64-bit integers, which can overflow the SQLite integers, and the
+// arbitrary precision numbers are stored as text, the times and durations as
+// nanoseconds.
+func (t *table) columnType(kind schema.Kind) string {
+	switch kind {
+	case schema.BytesKind:
+		return "BLOB"
+	case schema.AddressKind:
+		if t.addressCodec == nil {
+			return "BLOB"
+		}
+		return "TEXT"
+	case schema.Int8Kind, schema.Uint8Kind, schema.Int16Kind, schema.Uint16Kind, schema.Int32Kind,
+		schema.Uint32Kind, schema.Int64Kind, schema.BoolKind, schema.TimeKind, schema.DurationKind:
+		return "INTEGER"
+	case schema.Float32Kind, schema.Float64Kind:
+		return "REAL"
+	default:
+		return "TEXT"
+	}
+}
+
+// updateSQL returns the statement and the arguments which apply an update to
+// the table.
+func (t *table) updateSQL(update schema.StateObjectUpdate) (string, []any, error) {
+	keyColumns, keyArgs, err := t.keyArgs(update.Key)
+	if err != nil {
+		return "", nil, err
+	}
+
+	where := make([]string, len(keyColumns))
+	for i, column := range keyColumns {
+		where[i] = column + " = ?"
+	}
+
+	if update.Delete {
+		if t.objectType.RetainDeletions {
+			return fmt.Sprintf("UPDATE %s SET %s = 1 WHERE %s",
+				quoteIdentifier(t.name), quoteIdentifier(deletedColumn), strings.Join(where, " AND ")), keyArgs, nil
+		}
+		return fmt.Sprintf("DELETE FROM %s WHERE %s", quoteIdentifier(t.name), strings.Join(where, " AND ")), keyArgs, nil
+	}
+
+	valueColumns, valueArgs, err := t.valueArgs(update.Value)
+	if err != nil {
+		return "", nil, err
+	}
+	if t.objectType.RetainDeletions {
+		valueColumns = append(valueColumns, quoteIdentifier(deletedColumn))
+		valueArgs = append(valueArgs, 0)
+	}
+
+	// the updates of some fields apply to an existing object, they can't be
+	// upserted as the other fields may not be null
+	if _, ok := update.Value.(schema.ValueUpdates); ok && len(valueColumns) > 0 {
+		set := make([]string, len(valueColumns))
+		for i, column := range valueColumns {
+			set[i] = column + " = ?"
+		}
+		return fmt.Sprintf("UPDATE %s SET %s WHERE %s",
+			quoteIdentifier(t.name), strings.Join(set, ", "), strings.Join(where, " AND ")), append(valueArgs, keyArgs...), nil
+	}
+
+	columns := append(append([]string{}, keyColumns...), valueColumns...)
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ")
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) ON CONFLICT (%s) DO ",
+		quoteIdentifier(t.name), strings.Join(columns, ", "), placeholders, strings.Join(keyColumns, ", "))
+	if len(valueColumns) == 0 {
+		query += "NOTHING"
+	} else {
+		set := make([]string, len(valueColumns))
+		for i, column := range valueColumns {
+			set[i] = fmt.Sprintf("%s = excluded.%s", column, column)
+		}
+		query += "UPDATE SET " + strings.Join(set, ", ")
+	}
+
+	return query, append(keyArgs, valueArgs...), nil
+}
+
+func (t *table) keyArgs(key any) ([]string, []any, error) {
+	fields := t.objectType.KeyFields
+	if len(fields) == 0 {
+		return []string{quoteIdentifier(singletonColumn)}, []any{0}, nil
+	}
+
+	values, err := splitValues(fields, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid key of %s: %w", t.name, err)
+	}
+	return t.columnArgs(fields, values)
+}
+
+func (t *table) valueArgs(value any) ([]string, []any, error) {
+	fields := t.objectType.ValueFields
+	if updates, ok := value.(schema.ValueUpdates); ok {
+		// only the updated fields are set
+		byName := make(map[string]schema.Field, len(fields))
+		for _, field := range fields {
+			byName[field.Name] = field
+		}
+		var (
+			updatedFields []schema.Field
+			values        []any
+			err           error
+		)
+		iterErr := updates.Iterate(func(name string, value any) bool {
+			field, ok := byName[name]
+			if !ok {
+				err = fmt.Errorf("unknown value field %s of %s", name, t.name)
+				return false
+			}
+			updatedFields = append(updatedFields, field)
+			values = append(values, value)
+			return true
+		})
+		if iterErr != nil {
+			return nil, nil, iterErr
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		return t.columnArgs(updatedFields, values)
+	}
+
+	values, err := splitValues(fields, value)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid value of %s: %w", t.name, err)
+	}
+	return t.columnArgs(fields, values)
+}
+
+func (t *table) columnArgs(fields []schema.Field, values []any) ([]string, []any, error) {
+	columns := make([]string, len(fields))
+	args := make([]any, len(fields))
+	for i, field := range fields {
+		arg, err := t.encodeValue(field, values[i])
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid field %s of %s: %w", field.Name, t.name, err)
+		}
+		columns[i] = quoteIdentifier(field.Name)
+		args[i] = arg
+	}
+	return columns, args, nil
+}
+
+// encodeValue converts the value of a field to the argument of its column.
+func (t *table) encodeValue(field schema.Field, value any) (any, error) {
+	if value == nil {
+		if !field.Nullable {
+			return nil, errors.New("nil value of a non nullable field")
+		}
+		return nil, nil
+	}
+
+	switch v := value.(type) {
+	case uint64:
+		return strconv.FormatUint(v, 10), nil
+	case time.Time:
+		return v.UnixNano(), nil
+	case time.Duration:
+		return int64(v), nil
+	case json.RawMessage:
+		return string(v), nil
+	case []byte:
+		if field.Kind == schema.AddressKind && t.addressCodec != nil {
+			return t.addressCodec.BytesToString(v)
+		}
+		return v, nil
+	case string, bool, int8, uint8, int16, uint16, int32, uint32, int64, float32, float64:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+// splitValues returns the values of the fields, a single field having its
+// value as is and several fields having a slice of values.
+func splitValues(fields []schema.Field, value any) ([]any, error) {
+	switch len(fields) {
+	case 0:
+		return nil, nil
+	case 1:
+		return []any{value}, nil
+	}
+
+	values, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expected a slice of %d values, got %T", len(fields), value)
+	}
+	if len(values) != len(fields) {
+		return nil, fmt.Errorf("expected %d values, got %d", len(fields), len(values))
+	}
+	return values, nil
+}
+
+// quoteIdentifier quotes an SQL identifier.
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
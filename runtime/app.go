@@ -223,9 +223,11 @@ func (a *App) RegisterTendermintService(clientCtx client.Context) {
 	)
 }
 
-// RegisterNodeService registers the node gRPC service on the app gRPC router.
+// RegisterNodeService registers the node and mempool gRPC services on the app
+// gRPC router.
 func (a *App) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
 	nodeservice.RegisterNodeService(clientCtx, a.GRPCQueryRouter(), cfg)
+	nodeservice.RegisterMempoolService(clientCtx, a.GRPCQueryRouter(), a.Mempool())
 }
 
 // Configurator returns the app's configurator.
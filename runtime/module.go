@@ -316,8 +316,10 @@ func ProvideAddressCodec(in AddressCodecInputs) (address.Codec, ValidatorAddress
 		in.StakingConfig.Bech32PrefixConsensus = fmt.Sprintf("%svalcons", in.AuthConfig.Bech32Prefix)
 	}
 
-	// @nubit: We change the default codec to taproot.
-	return addresscodec.NewTaprootCodec(&types.BitcoinNetParams),
-		addresscodec.NewBech32Codec(in.StakingConfig.Bech32PrefixValidator),
-		addresscodec.NewBech32Codec(in.StakingConfig.Bech32PrefixConsensus)
+	// @nubit: The address formats are set by the sdk config, which defaults to
+	// taproot accounts and accepts the bech32 encoding of the same bytes.
+	config := types.GetConfig()
+	return addresscodec.NewFormatCodec(config.GetAccountAddrFormat(), in.AuthConfig.Bech32Prefix, config.GetBitcoinNetParams(), config.IsDualAddressMode()),
+		addresscodec.NewFormatCodec(config.GetValidatorAddrFormat(), in.StakingConfig.Bech32PrefixValidator, config.GetBitcoinNetParams(), config.IsDualAddressMode()),
+		addresscodec.NewFormatCodec(config.GetConsensusAddrFormat(), in.StakingConfig.Bech32PrefixConsensus, config.GetBitcoinNetParams(), config.IsDualAddressMode())
 }
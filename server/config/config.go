@@ -88,9 +88,19 @@ type BaseConfig struct {
 	// IAVLDisableFastNode enables or disables the fast sync node.
 	IAVLDisableFastNode bool `mapstructure:"iavl-disable-fastnode"`
 
+	// ArchiveDBDir defines the directory of the archive database, to which the
+	// versions pruned from the application database are moved instead of being
+	// deleted. An empty string disables the archive.
+	ArchiveDBDir string `mapstructure:"archive-db-dir"`
+
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the CometBFT config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
+
+	// BitcoinNetwork defines the Bitcoin network of Taproot addresses and
+	// BIP-322 signatures. An empty string keeps the network selected by the
+	// application.
+	BitcoinNetwork string `mapstructure:"bitcoin-network"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -159,6 +169,10 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotDeltaChainLength sets the number of delta snapshots taken on top of
+	// a full snapshot before the next full snapshot. 0 disables delta snapshots.
+	SnapshotDeltaChainLength uint32 `mapstructure:"snapshot-delta-chain-length"`
 }
 
 // MempoolConfig defines the configurations for the SDK built-in app-side mempool
@@ -169,6 +183,11 @@ type MempoolConfig struct {
 	// unbounded in how many txs it may contain, and a positive value indicates
 	// the maximum amount of txs it may contain.
 	MaxTxs int `mapstructure:"max-txs"`
+
+	// Journal defines whether the transactions of the mempool are recorded in
+	// a journal in the node home, and inserted again on a restart. It requires
+	// the app-side mempool, i.e. a non negative MaxTxs.
+	Journal bool `mapstructure:"journal"`
 }
 
 // State Streaming configuration
@@ -176,6 +195,7 @@ type (
 	// StreamingConfig defines application configuration for external streaming services
 	StreamingConfig struct {
 		ABCI ABCIListenerConfig `mapstructure:"abci"`
+		File FileListenerConfig `mapstructure:"file"`
 	}
 	// ABCIListenerConfig defines application configuration for ABCIListener streaming service
 	ABCIListenerConfig struct {
@@ -183,6 +203,16 @@ type (
 		Plugin        string   `mapstructure:"plugin"`
 		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
 	}
+	// FileListenerConfig defines application configuration for the file streaming service
+	FileListenerConfig struct {
+		Dir           string   `mapstructure:"dir"`
+		Keys          []string `mapstructure:"keys"`
+		MaxBlocks     int64    `mapstructure:"max-blocks"`
+		MaxBytes      int64    `mapstructure:"max-bytes"`
+		Compress      bool     `mapstructure:"compress"`
+		Fsync         bool     `mapstructure:"fsync"`
+		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
+	}
 )
 
 // Config defines the server's top level configuration
@@ -265,6 +295,10 @@ func DefaultConfig() *Config {
 				Keys:          []string{},
 				StopNodeOnErr: true,
 			},
+			File: FileListenerConfig{
+				Keys:      []string{},
+				MaxBlocks: 10000,
+			},
 		},
 		Mempool: MempoolConfig{
 			MaxTxs: -1,
@@ -291,6 +325,9 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	if c.Mempool.Journal && c.Mempool.MaxTxs < 0 {
+		return sdkerrors.ErrAppConfig.Wrap("cannot enable the mempool journal with the app-side mempool disabled (max-txs < 0)")
+	}
 
 	return nil
 }
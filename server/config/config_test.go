@@ -52,6 +52,15 @@ func TestStreamingConfig(t *testing.T) {
 				Plugin:        "plugin-A",
 				StopNodeOnErr: false,
 			},
+			File: FileListenerConfig{
+				Dir:           "data/streaming",
+				Keys:          []string{"three"},
+				MaxBlocks:     100,
+				MaxBytes:      1 << 20,
+				Compress:      true,
+				Fsync:         true,
+				StopNodeOnErr: true,
+			},
 		},
 	}
 
@@ -68,6 +77,12 @@ func TestStreamingConfig(t *testing.T) {
 		`keys = ["one", "two", ]`,
 		`plugin = "plugin-A"`,
 		`stop-node-on-err = false`,
+		`dir = "data/streaming"`,
+		`keys = ["three", ]`,
+		`max-blocks = 100`,
+		`max-bytes = 1048576`,
+		`compress = true`,
+		`fsync = true`,
 	}
 
 	for _, line := range expectedLines {
@@ -234,3 +249,15 @@ func TestAppConfig(t *testing.T) {
 	require.NoError(t, v.Unmarshal(appCfg))
 	require.EqualValues(t, appCfg, defAppConfig)
 }
+
+func TestValidateBasicMempoolJournal(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	cfg.Mempool.MaxTxs = 0
+	cfg.Mempool.Journal = true
+	require.NoError(t, cfg.ValidateBasic())
+
+	// the journal requires the app-side mempool
+	cfg.Mempool.MaxTxs = -1
+	require.ErrorContains(t, cfg.ValidateBasic(), "mempool journal")
+}
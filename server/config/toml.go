@@ -81,11 +81,22 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Default is false.
 iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 
+# ArchiveDBDir defines the directory of the archive database, to which the versions
+# pruned from the application database are moved instead of being deleted, so that
+# queries at historical heights keep being served. A relative directory is relative
+# to the node home. An empty string disables the archive.
+archive-db-dir = "{{ .BaseConfig.ArchiveDBDir }}"
+
 # AppDBBackend defines the database backend type to use for the application and snapshots DBs.
 # An empty string indicates that a fallback will be used.
 # The fallback is the db_backend value set in CometBFT's config.toml.
 app-db-backend = "{{ .BaseConfig.AppDBBackend }}"
 
+# BitcoinNetwork defines the Bitcoin network of Taproot addresses and BIP-322 signatures
+# (mainnet|testnet3|signet|regtest|simnet). It must match the network of the client.toml.
+# An empty string keeps the network selected by the application, mainnet by default.
+bitcoin-network = "{{ .BaseConfig.BitcoinNetwork }}"
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -208,6 +219,11 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+# snapshot-delta-chain-length specifies the number of delta snapshots, which only record the
+# state changed since the previous snapshot, taken between two full snapshots (0 to disable).
+# The delta snapshots aren't served to state sync, they're restored locally with their chain.
+snapshot-delta-chain-length = {{ .StateSync.SnapshotDeltaChainLength }}
+
 ###############################################################################
 ###                              State Streaming                            ###
 ###############################################################################
@@ -234,6 +250,35 @@ plugin = "{{ .Streaming.ABCI.Plugin }}"
 # stop-node-on-err specifies whether to stop the node on message delivery error.
 stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 
+# streaming.file specifies the configuration for the file streaming service, which
+# writes the FinalizeBlock requests and responses and the state changes of the
+# blocks to local files.
+[streaming.file]
+
+# The directory of the files, relative to the node home if not absolute.
+# Streaming to files is only enabled if this is set.
+dir = "{{ .Streaming.File.Dir }}"
+
+# List of kv store keys to stream out to the files.
+# The store key names MUST match the module's StoreKey name.
+# ["*"] to expose all keys.
+keys = [{{ range .Streaming.File.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# max-blocks is the number of blocks after which a file is rotated, 0 for no limit.
+max-blocks = {{ .Streaming.File.MaxBlocks }}
+
+# max-bytes is the size in bytes after which a file is rotated, 0 for no limit.
+max-bytes = {{ .Streaming.File.MaxBytes }}
+
+# compress specifies whether the files are compressed with gzip.
+compress = {{ .Streaming.File.Compress }}
+
+# fsync specifies whether a file is synced to the disk after each block.
+fsync = {{ .Streaming.File.Fsync }}
+
+# stop-node-on-err specifies whether to stop the node on message delivery error.
+stop-node-on-err = {{ .Streaming.File.StopNodeOnErr }}
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
@@ -246,6 +291,12 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = {{ .Mempool.MaxTxs }}
+
+# journal defines whether the accepted transactions and their removals are
+# recorded in a journal, data/mempool.wal in the node home, whose transactions
+# are checked again with CheckTx and inserted in the mempool on a restart. The
+# journal requires the app-side mempool, it can't be enabled with max-txs = -1.
+journal = {{ .Mempool.Journal }}
 `
 
 var configTemplate *template.Template
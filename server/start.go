@@ -75,11 +75,14 @@ const (
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 	FlagIAVLSyncPruning     = "iavl-sync-pruning"
+	FlagArchiveDBDir        = "archive-db-dir"
+	FlagBitcoinNetwork      = "bitcoin-network"
 	FlagShutdownGrace       = "shutdown-grace"
 
 	// state sync-related flags
-	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
-	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotInterval         = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent       = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotDeltaChainLength = "state-sync.snapshot-delta-chain-length"
 
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
@@ -99,7 +102,8 @@ const (
 	flagGRPCSkipCheckHeader = "grpc.skip-check-header"
 
 	// mempool flags
-	FlagMempoolMaxTxs = "mempool.max-txs"
+	FlagMempoolMaxTxs  = "mempool.max-txs"
+	FlagMempoolJournal = "mempool.journal"
 
 	// testnet keys
 	KeyIsTestnet             = "is-testnet"
@@ -990,8 +994,11 @@ func addStartNodeFlags(cmd *cobra.Command, opts StartCmdOptions) {
 	cmd.Flags().Bool(flagGRPCWebEnable, true, "Define if the gRPC-Web server should be enabled. (Note: gRPC must also be enabled)")
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotDeltaChainLength, 0, "State sync delta snapshots taken between two full snapshots")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().String(FlagArchiveDBDir, "", "Directory of the archive database to which the pruned versions are moved instead of being deleted")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().Bool(FlagMempoolJournal, false, "Persist the app-side mempool in a journal replayed on restart")
 	cmd.Flags().Duration(FlagShutdownGrace, 0*time.Second, "On Shutdown, duration to wait for resource clean up")
 
 	// support old flags name for backwards compatibility
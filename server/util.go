@@ -163,6 +163,13 @@ func InterceptConfigsAndCreateContext(cmd *cobra.Command, customAppConfigTemplat
 		return nil, err
 	}
 
+	// the Bitcoin network must be selected before the SDK config is sealed
+	if network := serverCtx.Viper.GetString(FlagBitcoinNetwork); network != "" {
+		if err := sdk.GetConfig().SetBitcoinNetwork(network); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", FlagBitcoinNetwork, err)
+		}
+	}
+
 	return serverCtx, nil
 }
 
@@ -535,21 +542,35 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		panic(err)
 	}
 
+	archiveDB, err := GetArchiveDB(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
 	snapshotOptions := snapshottypes.NewSnapshotOptions(
 		cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotInterval)),
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
 	)
+	snapshotOptions.DeltaChainLength = cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotDeltaChainLength))
 
 	defaultMempool := baseapp.SetMempool(mempool.NoOpMempool{})
+	var mempoolJournal *mempool.Journal
 	if maxTxs := cast.ToInt(appOpts.Get(FlagMempoolMaxTxs)); maxTxs >= 0 {
 		defaultMempool = baseapp.SetMempool(
 			mempool.NewSenderNonceMempool(
 				mempool.SenderNonceMaxTxOpt(maxTxs),
 			),
 		)
+
+		if cast.ToBool(appOpts.Get(FlagMempoolJournal)) {
+			mempoolJournal, err = GetMempoolJournal(appOpts)
+			if err != nil {
+				panic(err)
+			}
+		}
 	}
 
-	return []func(*baseapp.BaseApp){
+	options := []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(FlagHaltHeight))),
@@ -566,6 +587,43 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		baseapp.SetChainID(chainID),
 		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(FlagQueryGasLimit))),
 	}
+	if mempoolJournal != nil {
+		options = append(options, baseapp.SetMempoolJournal(mempoolJournal))
+	}
+	if archiveDB != nil {
+		options = append(options, baseapp.SetArchiveDB(archiveDB))
+	}
+	return options
+}
+
+// GetMempoolJournal opens the mempool journal in the data directory of the node
+// home.
+func GetMempoolJournal(appOpts types.AppOptions) (*mempool.Journal, error) {
+	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+	dataDir := filepath.Join(homeDir, "data")
+	if err := os.MkdirAll(dataDir, 0o744); err != nil {
+		return nil, fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	return mempool.OpenJournal(filepath.Join(dataDir, "mempool.wal"))
+}
+
+// GetArchiveDB opens the archive database of the pruned versions in the
+// configured directory, relative to the node home if it isn't absolute. It
+// returns nil if no directory is configured.
+func GetArchiveDB(appOpts types.AppOptions) (dbm.DB, error) {
+	archiveDir := cast.ToString(appOpts.Get(FlagArchiveDBDir))
+	if archiveDir == "" {
+		return nil, nil
+	}
+	if !filepath.IsAbs(archiveDir) {
+		archiveDir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), archiveDir)
+	}
+	if err := os.MkdirAll(archiveDir, 0o744); err != nil {
+		return nil, fmt.Errorf("failed to create archive directory: %w", err)
+	}
+
+	return dbm.NewDB("archive", GetAppDBBackend(appOpts), archiveDir)
 }
 
 func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
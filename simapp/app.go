@@ -59,7 +59,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
-	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
@@ -197,10 +196,8 @@ func NewSimApp(
 	interfaceRegistry, _ := types.NewInterfaceRegistryWithOptions(types.InterfaceRegistryOptions{
 		ProtoFiles: proto.HybridResolver,
 		SigningOptions: signing.Options{
-			AddressCodec: address.NewTaprootCodec(&sdk.BitcoinNetParams),
-			ValidatorAddressCodec: address.Bech32Codec{
-				Bech32Prefix: sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
-			},
+			AddressCodec:          address.NewAccAddressCodec(),
+			ValidatorAddressCodec: address.NewValAddressCodec(),
 		},
 	})
 	appCodec := codec.NewProtoCodec(interfaceRegistry)
@@ -304,7 +301,7 @@ func NewSimApp(
 		runtime.NewKVStoreService(keys[authtypes.StoreKey]),
 		authtypes.ProtoBaseAccount,
 		maccPerms,
-		address.NewTaprootCodec(&sdk.BitcoinNetParams),
+		address.NewAccAddressCodec(),
 		"",
 		govAddr,
 		authkeeper.WithUnorderedTransactions(true),
@@ -340,8 +337,8 @@ func NewSimApp(
 		app.AccountKeeper,
 		app.BankKeeper,
 		govAddr,
-		authcodec.NewBech32Codec(sdk.Bech32PrefixValAddr),
-		authcodec.NewBech32Codec(sdk.Bech32PrefixConsAddr),
+		address.NewValAddressCodec(),
+		address.NewConsAddressCodec(),
 	)
 	app.MintKeeper = mintkeeper.NewKeeper(
 		appCodec,
@@ -661,6 +658,11 @@ func NewSimApp(
 
 	app.sm.RegisterStoreDecoders()
 
+	// enable the state indexer
+	if err := enableIndexer(app.BaseApp, appOpts, keys, app.ModuleManager); err != nil {
+		panic(err)
+	}
+
 	// initialize stores
 	app.MountKVStores(keys)
 
@@ -810,9 +812,9 @@ func (app *SimApp) AutoCliOpts() autocli.AppOptions {
 	return autocli.AppOptions{
 		Modules:               modules,
 		ModuleOptions:         runtimeservices.ExtractAutoCLIOptions(app.ModuleManager.Modules),
-		AddressCodec:          address.NewTaprootCodec(&sdk.BitcoinNetParams),
-		ValidatorAddressCodec: authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
-		ConsensusAddressCodec: authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ConsensusAddrPrefix()),
+		AddressCodec:          address.NewAccAddressCodec(),
+		ValidatorAddressCodec: address.NewValAddressCodec(),
+		ConsensusAddressCodec: address.NewConsAddressCodec(),
 	}
 }
 
@@ -883,6 +885,7 @@ func (app *SimApp) RegisterTendermintService(clientCtx client.Context) {
 
 func (app *SimApp) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
+	nodeservice.RegisterMempoolService(clientCtx, app.GRPCQueryRouter(), app.Mempool())
 }
 
 // GetMaccPerms returns a copy of the module account permissions
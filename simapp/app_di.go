@@ -247,6 +247,11 @@ func NewSimApp(
 
 	app.sm.RegisterStoreDecoders()
 
+	// enable the state indexer
+	if err := enableIndexer(app.BaseApp, appOpts, app.kvStoreKeys(), app.ModuleManager); err != nil {
+		panic(err)
+	}
+
 	// A custom InitChainer can be set if extra pre-init-genesis logic is required.
 	// By default, when using app wiring enabled module, this is not required.
 	// For instance, the upgrade module will set automatically the module version map in its init genesis thanks to app wiring.
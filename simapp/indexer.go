@@ -0,0 +1,33 @@
+package simapp
+
+import (
+	_ "cosmossdk.io/indexer/sqlite" // register the sqlite indexer
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	_ "github.com/cosmos/cosmos-sdk/indexer/memory" // register the memory indexer
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// enableIndexer enables the state indexer if it's configured in the indexer
+// section of app.toml.
+func enableIndexer(bApp *baseapp.BaseApp, appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey, moduleManager *module.Manager) error {
+	indexerOpts := appOpts.Get(baseapp.IndexerTomlKey)
+	if indexerOpts == nil {
+		return nil
+	}
+
+	// the state changes are decoded by the module keyed by their store name
+	modules := make(map[string]any, len(moduleManager.Modules))
+	for name, mod := range moduleManager.Modules {
+		modules[name] = mod
+	}
+	if mod, ok := modules[authtypes.ModuleName]; ok {
+		delete(modules, authtypes.ModuleName)
+		modules[authtypes.StoreKey] = mod
+	}
+
+	return bApp.EnableIndexer(indexerOpts, keys, modules)
+}
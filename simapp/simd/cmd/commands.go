@@ -22,7 +22,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -93,7 +92,13 @@ func initAppConfig() (string, interface{}) {
 [custom]
 # That field will be parsed by server.InterceptConfigsPreRunHandler and held by viper.
 # Do not forget to add quotes around the value if it is a string.
-custom-field = "{{ .Custom.CustomField }}"`
+custom-field = "{{ .Custom.CustomField }}"
+
+# The state indexer is enabled by uncommenting its targets. The sqlite target
+# requires the app to import the database/sql driver of SQLite.
+# [indexer.target.sqlite]
+# type = "sqlite"
+# config = { path = "indexer.db" }`
 
 	return customAppTemplate, customAppConfig
 }
@@ -103,9 +108,6 @@ func initRootCmd(
 	txConfig client.TxConfig,
 	basicManager module.BasicManager,
 ) {
-	cfg := sdk.GetConfig()
-	cfg.Seal()
-
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(basicManager, simapp.DefaultNodeHome),
 		NewTestnetCmd(basicManager, banktypes.GenesisBalancesIterator{}),
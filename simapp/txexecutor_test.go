@@ -0,0 +1,226 @@
+package simapp
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/blockstm"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// interleaved wraps a TxExecutor so that the first executions of the
+// transactions overlap even on a single CPU: they are delayed by decreasing
+// durations, so that they read the state before the writes of the preceding
+// transactions. The executions are counted in executions.
+func interleaved(executor baseapp.TxExecutor, executions *atomic.Int64) baseapp.TxExecutor {
+	return func(
+		ctx context.Context,
+		block [][]byte,
+		cms storetypes.MultiStore,
+		deliverTx func(int, storetypes.MultiStore) *abci.ExecTxResult,
+	) ([]*abci.ExecTxResult, error) {
+		executed := make([]atomic.Bool, len(block))
+		return executor(ctx, block, cms, func(txIndex int, ms storetypes.MultiStore) *abci.ExecTxResult {
+			executions.Add(1)
+			if !executed[txIndex].Swap(true) {
+				time.Sleep(time.Duration(len(block)-txIndex) * 200 * time.Microsecond)
+			}
+			return deliverTx(txIndex, ms)
+		})
+	}
+}
+
+// TestTxExecutorDifferential executes the same blocks of conflicting
+// transactions with the sequential and the Block-STM executors, and checks
+// that the responses of FinalizeBlock are the same byte for byte.
+func TestTxExecutorDifferential(t *testing.T) {
+	const (
+		numAccounts = 20
+		numBlocks   = 10
+		maxBlockGas = 2_000_000
+	)
+
+	r := rand.New(rand.NewSource(1))
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	privs := make([]*taproot.PrivKey, numAccounts)
+	addrs := make([]sdk.AccAddress, numAccounts)
+	genAccs := make([]authtypes.GenesisAccount, numAccounts)
+	balances := make([]banktypes.Balance, numAccounts)
+	for i := range privs {
+		privs[i] = taproot.GenPrivKey()
+		addrs[i] = sdk.AccAddress(privs[i].PubKey().Address())
+		genAccs[i] = authtypes.NewBaseAccount(addrs[i], privs[i].PubKey(), uint64(i), 0)
+		balances[i] = banktypes.Balance{
+			Address: addrs[i].String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10_000))),
+		}
+	}
+
+	newApp := func(baseAppOptions ...func(*baseapp.BaseApp)) *SimApp {
+		appOptions := make(simtestutil.AppOptionsMap, 0)
+		appOptions[flags.FlagHome] = t.TempDir()
+		return NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions, baseAppOptions...)
+	}
+	seqApp := newApp()
+	var executions atomic.Int64
+	stmApp := newApp(baseapp.SetTxExecutor(interleaved(blockstm.NewTxExecutor(8), &executions)))
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(seqApp.AppCodec(), seqApp.DefaultGenesis(), valSet, genAccs, balances...)
+	require.NoError(t, err)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	consensusParams := *simtestutil.DefaultConsensusParams
+	consensusParams.Block = &cmtproto.BlockParams{MaxBytes: 1_000_000, MaxGas: maxBlockGas}
+	for _, app := range []*SimApp{seqApp, stmApp} {
+		_, err := app.InitChain(&abci.RequestInitChain{
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: &consensusParams,
+			AppStateBytes:   stateBytes,
+		})
+		require.NoError(t, err)
+	}
+
+	finalizeBlock := func(height int64, txs [][]byte) *abci.ResponseFinalizeBlock {
+		t.Helper()
+
+		req := &abci.RequestFinalizeBlock{Height: height, Txs: txs, NextValidatorsHash: valSet.Hash()}
+		seqRes, err := seqApp.FinalizeBlock(req)
+		require.NoError(t, err)
+		stmRes, err := stmApp.FinalizeBlock(req)
+		require.NoError(t, err)
+
+		require.Equal(t, seqRes.TxResults, stmRes.TxResults, "height %d", height)
+		seqBz, err := seqRes.Marshal()
+		require.NoError(t, err)
+		stmBz, err := stmRes.Marshal()
+		require.NoError(t, err)
+		require.Equal(t, seqBz, stmBz, "height %d", height)
+
+		_, err = seqApp.Commit()
+		require.NoError(t, err)
+		_, err = stmApp.Commit()
+		require.NoError(t, err)
+
+		return seqRes
+	}
+
+	// signTx signs the messages of the account i, whose sequence is
+	// incremented unless the transaction is expected to fail in the ante
+	// handler.
+	seqs := make([]uint64, numAccounts)
+	signTx := func(i int, gas uint64, seqOffset uint64, msgs ...sdk.Msg) []byte {
+		t.Helper()
+
+		tx, err := simtestutil.GenSignedMockTx(r, seqApp.TxConfig(), msgs, sdk.NewCoins(), gas, "",
+			[]uint64{uint64(i)}, []uint64{seqs[i] + seqOffset}, privs[i])
+		require.NoError(t, err)
+		bz, err := seqApp.TxConfig().TxEncoder()(tx)
+		require.NoError(t, err)
+
+		if seqOffset == 0 && gas >= 100_000 {
+			seqs[i]++
+		}
+		return bz
+	}
+	send := func(from int, to sdk.AccAddress, amount int64) sdk.Msg {
+		return banktypes.NewMsgSend(addrs[from], to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))
+	}
+	recipient := func() sdk.AccAddress {
+		if r.Intn(3) == 0 {
+			// a new account
+			return sdk.AccAddress(taproot.GenPrivKey().PubKey().Address())
+		}
+		return addrs[r.Intn(numAccounts)]
+	}
+
+	finalizeBlock(1, nil)
+
+	var numTxs, succeeded, failed int
+	for height := int64(2); height < 2+numBlocks; height++ {
+		var txs [][]byte
+		for range 1 + r.Intn(15) {
+			// the first accounts send most transactions, so that they conflict
+			from := r.Intn(numAccounts)
+			if r.Intn(2) == 0 {
+				from = r.Intn(3)
+			}
+
+			switch kind := r.Intn(10); {
+			case kind < 5:
+				txs = append(txs, signTx(from, 200_000, 0, send(from, recipient(), 1+r.Int63n(500))))
+			case kind == 5:
+				// insufficient funds
+				txs = append(txs, signTx(from, 200_000, 0, send(from, recipient(), 1_000_000)))
+			case kind == 6:
+				txs = append(txs, signTx(from, 200_000, 0, send(from, recipient(), 1+r.Int63n(500)), send(from, recipient(), 1+r.Int63n(500))))
+			case kind == 7:
+				// invalid sequence
+				txs = append(txs, signTx(from, 200_000, 1, send(from, recipient(), 1)))
+			case kind == 8:
+				// out of gas
+				txs = append(txs, signTx(from, 30_000, 0, send(from, recipient(), 1)))
+			default:
+				txs = append(txs, []byte("not a transaction"))
+			}
+		}
+
+		res := finalizeBlock(height, txs)
+		numTxs += len(txs)
+		for _, txResult := range res.TxResults {
+			if txResult.Code == abci.CodeTypeOK {
+				succeeded++
+			} else {
+				failed++
+			}
+		}
+
+		// resynchronize the sequences with the state
+		ctx := seqApp.NewUncachedContext(false, cmtproto.Header{})
+		for i, addr := range addrs {
+			seqs[i] = seqApp.AccountKeeper.GetAccount(ctx, addr).GetSequence()
+		}
+	}
+	require.Positive(t, succeeded)
+	require.Positive(t, failed)
+	// the conflicts were detected and the transactions executed again
+	require.Greater(t, executions.Load(), int64(numTxs))
+
+	// a block exceeding the block gas limit is executed again sequentially, the
+	// transactions after the limit fail as when executed sequentially
+	var txs [][]byte
+	for i := range 3 * numAccounts {
+		from := i % numAccounts
+		txs = append(txs, signTx(from, 200_000, 0, send(from, recipient(), 1)))
+	}
+	res := finalizeBlock(2+numBlocks, txs)
+	require.Equal(t, abci.CodeTypeOK, res.TxResults[0].Code)
+	require.Equal(t, sdkerrors.ErrOutOfGas.ABCICode(), res.TxResults[len(txs)-1].Code)
+}
@@ -2,13 +2,27 @@ package simapp
 
 import (
 	"context"
+	"strings"
 
 	storetypes "cosmossdk.io/store/types"
+	circuittypes "cosmossdk.io/x/circuit/types"
+	"cosmossdk.io/x/feegrant"
+	"cosmossdk.io/x/nft"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	taprootmigration "github.com/cosmos/cosmos-sdk/x/genutil/migrations/taproot"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
 	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // UpgradeName defines the on-chain upgrade name for the sample SimApp upgrade
@@ -19,6 +33,11 @@ import (
 // v0.50.x to v0.53.x.
 const UpgradeName = "v050-to-v053"
 
+// TaprootUpgradeName defines the on-chain upgrade moving the 20-byte accounts
+// of a chain to taproot addresses. The plan info may hold a JSON list of
+// address mapping entries, see taprootmigration.MappingEntry.
+const TaprootUpgradeName = "bech32-to-taproot"
+
 func (app SimApp) RegisterUpgradeHandlers() {
 	app.UpgradeKeeper.SetUpgradeHandler(
 		UpgradeName,
@@ -27,6 +46,39 @@ func (app SimApp) RegisterUpgradeHandlers() {
 		},
 	)
 
+	app.UpgradeKeeper.SetUpgradeHandler(
+		TaprootUpgradeName,
+		func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			versionMap, err := app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+			if err != nil {
+				return nil, err
+			}
+
+			var entries []taprootmigration.MappingEntry
+			if info := strings.TrimSpace(plan.Info); strings.HasPrefix(info, "[") {
+				entries, err = taprootmigration.ParseMapping([]byte(info))
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			sdkCtx := sdk.UnwrapSDKContext(ctx)
+			report, err := taprootmigration.MigrateStores(sdkCtx, app.ModuleManager, app.AppCodec(), app.taprootMigrationStoreKeys(),
+				entries, taprootmigration.DefaultOptions())
+			if err != nil {
+				return nil, err
+			}
+
+			sdkCtx.Logger().Info("moved accounts to taproot addresses",
+				"accounts", len(report.Accounts), "module_accounts", len(report.ModuleAccounts), "unknown", len(report.Unknown))
+			for _, addr := range report.Unknown {
+				sdkCtx.Logger().Info("account without known public key keeps its address", "address", addr)
+			}
+
+			return versionMap, nil
+		},
+	)
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(err)
@@ -44,3 +96,22 @@ func (app SimApp) RegisterUpgradeHandlers() {
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
 	}
 }
+
+// taprootMigrationStoreKeys returns the store keys of the modules whose state
+// holds account addresses, which the taproot upgrade migrates. The state of
+// the other modules only holds consensus addresses, which don't change.
+func (app SimApp) taprootMigrationStoreKeys() map[string]storetypes.StoreKey {
+	return map[string]storetypes.StoreKey{
+		authtypes.ModuleName:         app.GetKey(authtypes.StoreKey),
+		banktypes.ModuleName:         app.GetKey(banktypes.StoreKey),
+		stakingtypes.ModuleName:      app.GetKey(stakingtypes.StoreKey),
+		distrtypes.ModuleName:        app.GetKey(distrtypes.StoreKey),
+		govtypes.ModuleName:          app.GetKey(govtypes.StoreKey),
+		authz.ModuleName:             app.GetKey(authz.ModuleName),
+		feegrant.ModuleName:          app.GetKey(feegrant.StoreKey),
+		group.ModuleName:             app.GetKey(group.StoreKey),
+		nft.ModuleName:               app.GetKey(nftkeeper.StoreKey),
+		protocolpooltypes.ModuleName: app.GetKey(protocolpooltypes.StoreKey),
+		circuittypes.ModuleName:      app.GetKey(circuittypes.StoreKey),
+	}
+}
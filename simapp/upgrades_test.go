@@ -0,0 +1,166 @@
+package simapp
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto"
+	dcrsecp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	taprootmigration "github.com/cosmos/cosmos-sdk/x/genutil/migrations/taproot"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// legacyAccount sets an account with the 20-byte address of a new secp256k1
+// key, and returns it with the address of its taproot key.
+func legacyAccount(t *testing.T, app *SimApp, ctx sdk.Context) (legacyAddr, taprootAddr sdk.AccAddress) {
+	t.Helper()
+
+	secpKey := secp256k1.GenPrivKey().PubKey().(*secp256k1.PubKey)
+	legacyAddr = sdk.AccAddress(secpKey.Address())
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, legacyAddr)
+	require.NoError(t, acc.SetPubKey(secpKey))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	internalKey, err := dcrsecp256k1.ParsePubKey(secpKey.Key)
+	require.NoError(t, err)
+	taprootKey := &taproot.PubKey{Key: taproot.TweakPubKey(internalKey)}
+
+	return legacyAddr, sdk.AccAddress(taprootKey.Address())
+}
+
+func TestTaprootMigrationStores(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.NewContext(false)
+
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+
+	// a legacy account with a 20-byte address and a secp256k1 key
+	legacyAddr, taprootAddr := legacyAccount(t, app, ctx)
+	acc := app.AccountKeeper.GetAccount(ctx, legacyAddr)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	require.NoError(t, banktestutil.FundAccount(ctx, app.BankKeeper, legacyAddr, coins))
+
+	report, err := taprootmigration.MigrateStores(ctx, app.ModuleManager, app.AppCodec(), app.taprootMigrationStoreKeys(),
+		nil, taprootmigration.DefaultOptions())
+	require.NoError(t, err)
+	require.Len(t, report.Accounts, 1)
+	require.Empty(t, report.Unknown)
+
+	require.Nil(t, app.AccountKeeper.GetAccount(ctx, legacyAddr))
+	migrated := app.AccountKeeper.GetAccount(ctx, taprootAddr)
+	require.NotNil(t, migrated)
+	require.Equal(t, acc.GetAccountNumber(), migrated.GetAccountNumber())
+	require.Equal(t, taprootAddr, sdk.AccAddress(migrated.GetPubKey().Address()))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, legacyAddr).IsZero())
+	require.Equal(t, coins, app.BankKeeper.GetAllBalances(ctx, taprootAddr))
+
+	// the rest of the state is imported back unchanged
+	migratedValidators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.Equal(t, validators, migratedValidators)
+	require.Equal(t, supply.Add(coins[0]), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+}
+
+func TestTaprootMigrationStoresModuleState(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.NewContext(false)
+
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].OperatorAddress)
+	require.NoError(t, err)
+	supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+
+	// a legacy delegator withdrawing its rewards to another legacy account,
+	// and member of a group
+	delAddr, taprootDelAddr := legacyAccount(t, app, ctx)
+	withdrawAddr, taprootWithdrawAddr := legacyAccount(t, app, ctx)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	require.NoError(t, banktestutil.FundAccount(ctx, app.BankKeeper, delAddr, coins))
+	_, err = stakingkeeper.NewMsgServerImpl(app.StakingKeeper).Delegate(ctx, &stakingtypes.MsgDelegate{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: validators[0].OperatorAddress,
+		Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+	})
+	require.NoError(t, err)
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, delAddr, withdrawAddr))
+	groupRes, err := app.GroupKeeper.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin:   delAddr.String(),
+		Members: []group.MemberRequest{{Address: delAddr.String(), Weight: "1"}},
+	})
+	require.NoError(t, err)
+	delegation, err := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.NoError(t, err)
+
+	// the distribution module account at its legacy 20-byte address, holding
+	// the rewards of the validator
+	distrAcc := app.AccountKeeper.GetModuleAccount(ctx, distrtypes.ModuleName)
+	legacyDistrAddr := sdk.AccAddress(crypto.AddressHash([]byte(distrtypes.ModuleName)))
+	require.NoError(t, banktestutil.FundModuleAccount(ctx, app.BankKeeper, distrtypes.ModuleName, coins))
+	require.NoError(t, app.DistrKeeper.AllocateTokensToValidator(ctx, validators[0], sdk.NewDecCoinsFromCoins(coins...)))
+	distrBalance := app.BankKeeper.GetAllBalances(ctx, distrAcc.GetAddress())
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, distrAcc.GetAddress(), legacyDistrAddr, distrBalance))
+	app.AccountKeeper.RemoveAccount(ctx, distrAcc)
+	app.AccountKeeper.RemoveAccount(ctx, app.AccountKeeper.GetAccount(ctx, legacyDistrAddr))
+	legacyDistrAcc := authtypes.NewModuleAccount(authtypes.NewBaseAccount(legacyDistrAddr, nil, distrAcc.GetAccountNumber(), 0),
+		distrtypes.ModuleName)
+	app.AccountKeeper.SetModuleAccount(ctx, legacyDistrAcc)
+
+	report, err := taprootmigration.MigrateStores(ctx, app.ModuleManager, app.AppCodec(), app.taprootMigrationStoreKeys(),
+		nil, taprootmigration.DefaultOptions())
+	require.NoError(t, err)
+	require.Len(t, report.Accounts, 2)
+	require.Equal(t, []taprootmigration.RemappedAccount{{
+		Name: distrtypes.ModuleName,
+		From: sdk.MustBech32ifyAddressBytes(taprootmigration.DefaultOptions().AccountPrefix, legacyDistrAddr),
+		To:   authtypes.NewModuleAddress(distrtypes.ModuleName).String(),
+	}}, report.ModuleAccounts)
+
+	// x/staking
+	_, err = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.ErrorIs(t, err, stakingtypes.ErrNoDelegation)
+	migratedDelegation, err := app.StakingKeeper.GetDelegation(ctx, taprootDelAddr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, delegation.Shares, migratedDelegation.Shares)
+
+	// x/distribution
+	has, err := app.DistrKeeper.HasDelegatorStartingInfo(ctx, valAddr, delAddr)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = app.DistrKeeper.HasDelegatorStartingInfo(ctx, valAddr, taprootDelAddr)
+	require.NoError(t, err)
+	require.True(t, has)
+	migratedWithdrawAddr, err := app.DistrKeeper.GetDelegatorWithdrawAddr(ctx, taprootDelAddr)
+	require.NoError(t, err)
+	require.Equal(t, taprootWithdrawAddr, migratedWithdrawAddr)
+
+	// x/group
+	groupInfo, err := app.GroupKeeper.GroupInfo(ctx, &group.QueryGroupInfoRequest{GroupId: groupRes.GroupId})
+	require.NoError(t, err)
+	require.Equal(t, taprootDelAddr.String(), groupInfo.Info.Admin)
+	members, err := app.GroupKeeper.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: groupRes.GroupId})
+	require.NoError(t, err)
+	require.Len(t, members.Members, 1)
+	require.Equal(t, taprootDelAddr.String(), members.Members[0].Member.Address)
+
+	// the module account moved back to its address with its balance
+	migratedDistrAcc := app.AccountKeeper.GetModuleAccount(ctx, distrtypes.ModuleName)
+	require.Equal(t, authtypes.NewModuleAddress(distrtypes.ModuleName), migratedDistrAcc.GetAddress())
+	require.Equal(t, distrAcc.GetAccountNumber(), migratedDistrAcc.GetAccountNumber())
+	require.Nil(t, app.AccountKeeper.GetAccount(ctx, legacyDistrAddr))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, legacyDistrAddr).IsZero())
+	require.Equal(t, distrBalance, app.BankKeeper.GetAllBalances(ctx, migratedDistrAcc.GetAddress()))
+
+	require.Equal(t, supply.Add(coins[0]).Add(coins[0]), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+}
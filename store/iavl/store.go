@@ -291,6 +291,15 @@ func (st *Store) Import(version int64) (*iavl.Importer, error) {
 	return tree.Import(version)
 }
 
+// SaveChangeSet saves the changes of a change set as the next version of the IAVL store.
+func (st *Store) SaveChangeSet(changeSet *iavl.ChangeSet) (int64, error) {
+	tree, ok := st.tree.(*iavl.MutableTree)
+	if !ok {
+		return 0, errors.New("iavl save change set failed: unable to find mutable tree")
+	}
+	return tree.SaveChangeSet(changeSet)
+}
+
 // Handle gatest the latest height, if height is 0
 func getHeight(tree Tree, req *types.RequestQuery) int64 {
 	height := req.Height
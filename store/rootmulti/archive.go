@@ -0,0 +1,214 @@
+package rootmulti
+
+import (
+	"errors"
+	"fmt"
+
+	dbm "github.com/cosmos/cosmos-db"
+	iavltree "github.com/cosmos/iavl"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/types"
+	"cosmossdk.io/store/wrapper"
+)
+
+var _ types.ArchivingMultiStore = (*Store)(nil)
+
+// errArchiveClosed is returned when the archiving is stopped by CloseArchive.
+var errArchiveClosed = errors.New("archive closed")
+
+// SetArchiveDB sets the archive database, to which the versions pruned from
+// the IAVL stores are moved instead of being deleted. CacheMultiStoreWithVersion
+// falls back to the archive for the versions which were pruned. It must be set
+// before a version is loaded.
+//
+// The versions are archived in the background, unless the pruning is
+// synchronous, and a version is only deleted from an IAVL store once it's
+// archived. The archived versions are replayed from their changes, so they
+// hold the same state as the pruned ones but not the same IAVL nodes. Their
+// hashes may thus differ from the committed ones, and they can't prove the
+// queried state.
+func (rs *Store) SetArchiveDB(db dbm.DB) {
+	rs.archiveDB = db
+}
+
+// CloseArchive stops the archiving in progress after the version being
+// archived, and waits for it to stop. The versions which aren't archived are no
+// longer pruned.
+func (rs *Store) CloseArchive() {
+	rs.archiveRunMtx.Lock()
+	rs.archiveClosed = true
+	rs.archiveRunMtx.Unlock()
+
+	rs.archiveWg.Wait()
+}
+
+// loadArchiveStores loads the archive stores of the IAVL stores at their
+// latest archived version.
+func (rs *Store) loadArchiveStores() error {
+	if rs.archiveDB == nil {
+		return nil
+	}
+
+	// the archiving in progress uses the stores of the previous version
+	rs.archiveWg.Wait()
+
+	archiveStores := make(map[types.StoreKey]*archiveStore)
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL || rs.removalMap[key] {
+			continue
+		}
+
+		db := dbm.NewPrefixDB(rs.archiveDB, []byte("s/k:"+key.Name()+"/"))
+		archive, err := iavl.LoadStoreWithOpts(db, rs.logger, key, types.CommitID{}, 0, rs.iavlCacheSize, true, rs.metrics)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to load archive store %s", key.Name())
+		}
+		archiveStores[key] = &archiveStore{
+			sourceDB: rs.storeDB(rs.storesParams[key]),
+			archive:  archive.(*iavl.Store),
+		}
+	}
+
+	rs.archiveMtx.Lock()
+	rs.archiveStores = archiveStores
+	rs.archiveMtx.Unlock()
+	return nil
+}
+
+// archiveStore is the archive of an IAVL store.
+type archiveStore struct {
+	// sourceDB is the database of the IAVL store, whose changes are read by a
+	// tree of their own, as the tree of the store is written by the commits
+	// running meanwhile.
+	sourceDB dbm.DB
+	archive  *iavl.Store
+}
+
+// archiveVersions archives the versions of the IAVL stores up to the given
+// version, in the background unless the pruning is synchronous.
+func (rs *Store) archiveVersions(version int64) {
+	if rs.iavlSyncPruning {
+		rs.archiveStoresTo(version)
+		return
+	}
+
+	rs.archiveRunMtx.Lock()
+	defer rs.archiveRunMtx.Unlock()
+
+	rs.archiveTarget = max(rs.archiveTarget, version)
+	if rs.archiveRunning || rs.archiveClosed {
+		return
+	}
+	rs.archiveRunning = true
+	rs.archiveWg.Add(1)
+	go rs.runArchive()
+}
+
+// runArchive archives the versions of the IAVL stores until the target version
+// of the archiving, which may grow in the meantime, is reached.
+func (rs *Store) runArchive() {
+	defer rs.archiveWg.Done()
+
+	var archived int64
+	for {
+		rs.archiveRunMtx.Lock()
+		target := rs.archiveTarget
+		if rs.archiveClosed || target <= archived {
+			rs.archiveRunning = false
+			rs.archiveRunMtx.Unlock()
+			return
+		}
+		rs.archiveRunMtx.Unlock()
+
+		rs.archiveStoresTo(target)
+		archived = target
+	}
+}
+
+// archiveStoresTo archives the versions of the IAVL stores up to the given
+// version. A store which fails to be archived is skipped, its versions aren't
+// pruned until they're archived.
+func (rs *Store) archiveStoresTo(version int64) {
+	for key, store := range rs.archiveStores {
+		if err := rs.archiveVersionsTo(key, store, version); err != nil && !errors.Is(err, errArchiveClosed) {
+			rs.logger.Error("failed to archive store, skipping its pruning", "key", key, "err", err)
+		}
+	}
+}
+
+// archiveVersionsTo archives the versions of an IAVL store up to the given
+// version, replaying the changes of each version into its archive store. The
+// changes of the first version of an empty archive store are its whole state.
+func (rs *Store) archiveVersionsTo(key types.StoreKey, store *archiveStore, version int64) error {
+	archived := rs.archivedVersion(key)
+	if archived >= version {
+		return nil
+	}
+
+	// the versions read are committed, and they're only pruned once archived
+	source := iavltree.NewMutableTree(wrapper.NewDBWrapper(store.sourceDB), 0, true, rs.logger)
+	versions := source.AvailableVersions()
+	if len(versions) == 0 {
+		return fmt.Errorf("no version of store %s to archive", key.Name())
+	}
+	first := int64(versions[0])
+	switch {
+	case archived == 0:
+		rs.archiveMtx.Lock()
+		store.archive.SetInitialVersion(first)
+		rs.archiveMtx.Unlock()
+	case archived < first:
+		// the changes of the next version are taken from the latest archived
+		// version, which is retained by the pruning but may have been deleted
+		// otherwise, e.g. by a state sync, and the archive can't be continued
+		return fmt.Errorf("archive of store %s at version %d is behind its first version %d", key.Name(), archived, first)
+	}
+
+	return source.TraverseStateChanges(archived+1, version, func(version int64, changeSet *iavltree.ChangeSet) error {
+		rs.archiveRunMtx.Lock()
+		closed := rs.archiveClosed
+		rs.archiveRunMtx.Unlock()
+		if closed {
+			return errArchiveClosed
+		}
+
+		rs.archiveMtx.Lock()
+		archivedVersion, err := store.archive.SaveChangeSet(changeSet)
+		rs.archiveMtx.Unlock()
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to archive version %d of store %s", version, key.Name())
+		}
+		if archivedVersion != version {
+			return fmt.Errorf("archived version %d of store %s as version %d", version, key.Name(), archivedVersion)
+		}
+		return nil
+	})
+}
+
+// archivedVersion returns the latest archived version of an IAVL store, 0 if
+// it isn't archived.
+func (rs *Store) archivedVersion(key types.StoreKey) int64 {
+	rs.archiveMtx.RLock()
+	defer rs.archiveMtx.RUnlock()
+
+	store, ok := rs.archiveStores[key]
+	if !ok {
+		return 0
+	}
+	return store.archive.LastCommitID().Version
+}
+
+// getArchivedImmutable returns the archive store of an IAVL store at a version
+// which was archived.
+func (rs *Store) getArchivedImmutable(key types.StoreKey, version int64) (*iavl.Store, error) {
+	rs.archiveMtx.RLock()
+	defer rs.archiveMtx.RUnlock()
+
+	store, ok := rs.archiveStores[key]
+	if !ok {
+		return nil, fmt.Errorf("no archive of store %s", key.Name())
+	}
+	return store.archive.GetImmutable(version)
+}
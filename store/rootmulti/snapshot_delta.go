@@ -0,0 +1,418 @@
+package rootmulti
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	protoio "github.com/cosmos/gogoproto/io"
+	iavltree "github.com/cosmos/iavl"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/iavl"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/types"
+)
+
+// A delta snapshot holds the IAVL nodes of the exported trees which are newer
+// than its base snapshot. As the nodes of an IAVL tree are immutable, the nodes
+// of a tree which aren't newer than the base height are the nodes of the base
+// tree, so every maximal subtree of such nodes is written as a single
+// SnapshotIAVLSubtreeItem, in the depth-first post-order of the exported nodes.
+// A delta snapshot is restored by merging the exported nodes of its base
+// snapshot into its own, which gives the exported nodes of the tree.
+
+// SnapshotDelta implements snapshottypes.DeltaSnapshotter.
+func (rs *Store) SnapshotDelta(height, baseHeight uint64, protoWriter protoio.Writer) error {
+	if baseHeight == 0 || baseHeight >= height {
+		return errorsmod.Wrapf(types.ErrLogic, "invalid base height %v of a delta snapshot at height %v", baseHeight, height)
+	}
+	stores, err := rs.snapshotStores(height)
+	if err != nil {
+		return err
+	}
+
+	for _, store := range stores {
+		rs.logger.Debug("starting delta snapshot", "store", store.name, "height", height, "base", baseHeight)
+		exporter, err := store.Export(int64(height))
+		if err != nil {
+			rs.logger.Error("delta snapshot failed; exporter error", "store", store.name, "err", err)
+			return err
+		}
+
+		err = func() error {
+			defer exporter.Close()
+
+			err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+				Item: &snapshottypes.SnapshotItem_Store{
+					Store: &snapshottypes.SnapshotStoreItem{
+						Name: store.name,
+					},
+				},
+			})
+			if err != nil {
+				return err
+			}
+			return writeDeltaNodes(exporter, int64(baseHeight), protoWriter)
+		}()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deltaSubtree is a subtree of an exported tree, whose root is the last
+// exported node.
+type deltaSubtree struct {
+	firstKey []byte
+	root     *iavltree.ExportNode
+	// pending is set for a subtree of the base tree whose item isn't written
+	pending bool
+}
+
+// writeDeltaNodes writes the nodes exported by the exporter which are newer
+// than the base height, and the subtrees of the base tree.
+func writeDeltaNodes(exporter *iavltree.Exporter, baseHeight int64, protoWriter protoio.Writer) error {
+	// stack holds the subtrees whose parent isn't exported yet
+	var stack []deltaSubtree
+
+	writeSubtree := func(subtree *deltaSubtree) error {
+		if !subtree.pending {
+			return nil
+		}
+		subtree.pending = false
+		return protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_IAVLSubtree{
+				IAVLSubtree: &snapshottypes.SnapshotIAVLSubtreeItem{
+					FirstKey: subtree.firstKey,
+					Key:      subtree.root.Key,
+					Version:  subtree.root.Version,
+					Height:   int32(subtree.root.Height),
+				},
+			},
+		})
+	}
+
+	for {
+		node, err := exporter.Next()
+		if errors.Is(err, iavltree.ErrorExportDone) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		subtree := deltaSubtree{firstKey: node.Key, root: node, pending: node.Version <= baseHeight}
+		var children []deltaSubtree
+		if node.Height > 0 {
+			if len(stack) < 2 {
+				return fmt.Errorf("invalid exported node at height %d without children", node.Height)
+			}
+			children = stack[len(stack)-2:]
+			stack = stack[:len(stack)-2]
+			subtree.firstKey = children[0].firstKey
+			if subtree.pending && (!children[0].pending || !children[1].pending) {
+				return fmt.Errorf("exported node of version %d has a newer child", node.Version)
+			}
+		}
+
+		if !subtree.pending {
+			// the subtrees of the base tree before the node are all maximal,
+			// as its ancestors are newer
+			for i := range stack {
+				if err := writeSubtree(&stack[i]); err != nil {
+					return err
+				}
+			}
+			for i := range children {
+				if err := writeSubtree(&children[i]); err != nil {
+					return err
+				}
+			}
+			err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+				Item: &snapshottypes.SnapshotItem_IAVL{
+					IAVL: &snapshottypes.SnapshotIAVLItem{
+						Key:     node.Key,
+						Value:   node.Value,
+						Height:  int32(node.Height),
+						Version: node.Version,
+					},
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		stack = append(stack, subtree)
+	}
+
+	for i := range stack {
+		if err := writeSubtree(&stack[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RestoreDelta implements snapshottypes.DeltaSnapshotter.
+func (rs *Store) RestoreDelta(
+	height uint64, format uint32, base protoio.Reader, deltas []protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	if len(deltas) == 0 {
+		return snapshottypes.SnapshotItem{}, errorsmod.Wrap(types.ErrLogic, "no delta snapshot to restore")
+	}
+	if !snapshottypes.IsSupportedFormat(format) {
+		return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(snapshottypes.ErrUnknownFormat, "snapshot format %v", format)
+	}
+
+	layers := make([]*snapshotLayer, 0, len(deltas)+1)
+	for _, reader := range append([]protoio.Reader{base}, deltas...) {
+		layer, err := newSnapshotLayer(reader)
+		if err != nil {
+			return snapshottypes.SnapshotItem{}, err
+		}
+		layers = append(layers, layer)
+	}
+
+	// the stores are the ones of the last delta snapshot
+	top := layers[len(layers)-1]
+	for {
+		storeItem := top.item.GetStore()
+		if storeItem == nil {
+			break
+		}
+		if err := top.read(); err != nil {
+			return snapshottypes.SnapshotItem{}, err
+		}
+
+		var nodes nodeReader = emptyNodeReader{}
+		found, err := layers[0].seek(storeItem.Name)
+		if err != nil {
+			return snapshottypes.SnapshotItem{}, err
+		}
+		if found {
+			nodes = &fullNodeReader{layer: layers[0]}
+		}
+		for _, layer := range layers[1 : len(layers)-1] {
+			found, err := layer.seek(storeItem.Name)
+			if err != nil {
+				return snapshottypes.SnapshotItem{}, err
+			}
+			if !found {
+				nodes = emptyNodeReader{}
+				continue
+			}
+			nodes = &deltaNodeReader{layer: layer, base: nodes}
+		}
+		nodes = &deltaNodeReader{layer: top, base: nodes}
+
+		if err := rs.importNodes(storeItem.Name, height, nodes); err != nil {
+			return snapshottypes.SnapshotItem{}, err
+		}
+	}
+
+	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
+	return top.item, rs.LoadLatestVersion()
+}
+
+// importNodes imports the nodes into the IAVL store with the given name.
+func (rs *Store) importNodes(name string, height uint64, nodes nodeReader) error {
+	store, ok := rs.GetStoreByName(name).(*iavl.Store)
+	if !ok || store == nil {
+		return errorsmod.Wrapf(types.ErrLogic, "cannot import into non-IAVL store %q", name)
+	}
+	rs.logger.Debug("restoring delta snapshot", "store", name)
+
+	importer, err := store.Import(int64(height))
+	if err != nil {
+		return errorsmod.Wrap(err, "import failed")
+	}
+	defer importer.Close()
+
+	for {
+		node, err := nodes.Next()
+		if errors.Is(err, iavltree.ErrorExportDone) {
+			break
+		} else if err != nil {
+			return errorsmod.Wrapf(err, "store %q", name)
+		}
+		if err := importer.Add(node); err != nil {
+			return errorsmod.Wrap(err, "IAVL node import failed")
+		}
+	}
+
+	return errorsmod.Wrap(importer.Commit(), "IAVL commit failed")
+}
+
+// snapshotLayer reads the stores of a snapshot stream in name order.
+type snapshotLayer struct {
+	reader protoio.Reader
+	// item is the next item of the stream, which has no item at its end
+	item snapshottypes.SnapshotItem
+}
+
+func newSnapshotLayer(reader protoio.Reader) (*snapshotLayer, error) {
+	layer := &snapshotLayer{reader: reader}
+	return layer, layer.read()
+}
+
+// read reads the next item of the stream.
+func (l *snapshotLayer) read() error {
+	l.item = snapshottypes.SnapshotItem{}
+	err := l.reader.ReadMsg(&l.item)
+	if errors.Is(err, io.EOF) {
+		l.item = snapshottypes.SnapshotItem{}
+		return nil
+	}
+	return errorsmod.Wrap(err, "invalid protobuf message")
+}
+
+// nodeItem returns whether the next item is a node item of the current store.
+func (l *snapshotLayer) nodeItem() bool {
+	return l.item.GetIAVL() != nil || l.item.GetIAVLSubtree() != nil
+}
+
+// seek skips the stores before the one with the given name, and its store
+// item if it's found.
+func (l *snapshotLayer) seek(name string) (bool, error) {
+	for {
+		for l.nodeItem() {
+			if err := l.read(); err != nil {
+				return false, err
+			}
+		}
+
+		storeItem := l.item.GetStore()
+		if storeItem == nil || storeItem.Name > name {
+			return false, nil
+		}
+		if err := l.read(); err != nil {
+			return false, err
+		}
+		if storeItem.Name == name {
+			return true, nil
+		}
+	}
+}
+
+// nodeReader reads the exported nodes of an IAVL tree, it returns
+// iavltree.ErrorExportDone after the last one.
+type nodeReader interface {
+	Next() (*iavltree.ExportNode, error)
+}
+
+type emptyNodeReader struct{}
+
+func (emptyNodeReader) Next() (*iavltree.ExportNode, error) {
+	return nil, iavltree.ErrorExportDone
+}
+
+// fullNodeReader reads the nodes of a store of a full snapshot.
+type fullNodeReader struct {
+	layer *snapshotLayer
+}
+
+func (r *fullNodeReader) Next() (*iavltree.ExportNode, error) {
+	item := r.layer.item.GetIAVL()
+	if item == nil {
+		if r.layer.item.GetIAVLSubtree() != nil {
+			return nil, errorsmod.Wrap(types.ErrLogic, "subtree item in a full snapshot")
+		}
+		return nil, iavltree.ErrorExportDone
+	}
+	if err := r.layer.read(); err != nil {
+		return nil, err
+	}
+	return exportNode(item)
+}
+
+// deltaNodeReader reads the nodes of a store of a delta snapshot, merged with
+// the nodes of its base.
+type deltaNodeReader struct {
+	layer *snapshotLayer
+	base  nodeReader
+	// subtree is the subtree of the base whose nodes are being read
+	subtree *snapshottypes.SnapshotIAVLSubtreeItem
+}
+
+func (r *deltaNodeReader) Next() (*iavltree.ExportNode, error) {
+	if r.subtree != nil {
+		node, err := r.nextBaseNode()
+		if err != nil {
+			return nil, err
+		}
+		if isSubtreeRoot(node, r.subtree) {
+			r.subtree = nil
+		}
+		return node, nil
+	}
+
+	if item := r.layer.item.GetIAVL(); item != nil {
+		if err := r.layer.read(); err != nil {
+			return nil, err
+		}
+		return exportNode(item)
+	}
+
+	subtree := r.layer.item.GetIAVLSubtree()
+	if subtree == nil {
+		return nil, iavltree.ErrorExportDone
+	}
+	if err := r.layer.read(); err != nil {
+		return nil, err
+	}
+
+	// skip the base nodes until the first leaf of the subtree, its nodes being
+	// contiguous in the depth-first post-order
+	for {
+		node, err := r.nextBaseNode()
+		if err != nil {
+			return nil, err
+		}
+		if node.Height != 0 || !bytes.Equal(node.Key, subtree.FirstKey) {
+			continue
+		}
+		if !isSubtreeRoot(node, subtree) {
+			r.subtree = subtree
+		}
+		return node, nil
+	}
+}
+
+func (r *deltaNodeReader) nextBaseNode() (*iavltree.ExportNode, error) {
+	node, err := r.base.Next()
+	if errors.Is(err, iavltree.ErrorExportDone) {
+		return nil, errorsmod.Wrap(types.ErrLogic, "delta snapshot subtree not found in its base snapshot")
+	}
+	return node, err
+}
+
+func isSubtreeRoot(node *iavltree.ExportNode, subtree *snapshottypes.SnapshotIAVLSubtreeItem) bool {
+	return int32(node.Height) == subtree.Height && node.Version == subtree.Version && bytes.Equal(node.Key, subtree.Key)
+}
+
+// exportNode converts a snapshot item to an exported node.
+func exportNode(item *snapshottypes.SnapshotIAVLItem) (*iavltree.ExportNode, error) {
+	if item.Height > math.MaxInt8 {
+		return nil, errorsmod.Wrapf(types.ErrLogic, "node height %v cannot exceed %v",
+			item.Height, math.MaxInt8)
+	}
+	node := &iavltree.ExportNode{
+		Key:     item.Key,
+		Value:   item.Value,
+		Height:  int8(item.Height),
+		Version: item.Version,
+	}
+	// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 && node.Value == nil {
+		node.Value = []byte{}
+	}
+	return node, nil
+}
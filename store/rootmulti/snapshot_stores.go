@@ -0,0 +1,71 @@
+package rootmulti
+
+import (
+	"bytes"
+
+	protoio "github.com/cosmos/gogoproto/io"
+
+	errorsmod "cosmossdk.io/errors"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/types"
+)
+
+var _ snapshottypes.StoreSnapshotter = (*Store)(nil)
+
+// SnapshotStores implements snapshottypes.StoreSnapshotter.
+func (rs *Store) SnapshotStores(height uint64) ([]string, []byte, error) {
+	stores, err := rs.snapshotStores(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	cInfo, err := rs.GetCommitInfo(int64(height))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names := make([]string, len(stores))
+	for i, store := range stores {
+		names[i] = store.name
+	}
+	return names, cInfo.Hash(), nil
+}
+
+// SnapshotStore implements snapshottypes.StoreSnapshotter.
+func (rs *Store) SnapshotStore(height uint64, name string, protoWriter protoio.Writer) error {
+	stores, err := rs.snapshotStores(height)
+	if err != nil {
+		return err
+	}
+	for _, store := range stores {
+		if store.name == name {
+			return rs.snapshotStore(store, height, protoWriter)
+		}
+	}
+	return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot unknown store %q", name)
+}
+
+// RestoreStore implements snapshottypes.StoreSnapshotter.
+func (rs *Store) RestoreStore(height uint64, name string, protoReader protoio.Reader) error {
+	layer, err := newSnapshotLayer(protoReader)
+	if err != nil {
+		return err
+	}
+	if err := rs.importNodes(name, height, &fullNodeReader{layer: layer}); err != nil {
+		return err
+	}
+	if layer.item.Item != nil {
+		return errorsmod.Wrapf(types.ErrLogic, "unexpected snapshot item %T in store %q", layer.item.Item, name)
+	}
+	return nil
+}
+
+// CompleteRestore implements snapshottypes.StoreSnapshotter.
+func (rs *Store) CompleteRestore(height uint64, appHash []byte) error {
+	cInfo := rs.buildCommitInfo(int64(height))
+	if len(appHash) > 0 && !bytes.Equal(cInfo.Hash(), appHash) {
+		return errorsmod.Wrapf(snapshottypes.ErrAppHashMismatch, "expected %X, got %X", appHash, cInfo.Hash())
+	}
+
+	rs.flushMetadata(rs.db, int64(height), cInfo)
+	return rs.LoadLatestVersion()
+}
@@ -1,6 +1,7 @@
 package rootmulti_test
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
@@ -11,6 +12,8 @@ import (
 	"testing"
 
 	dbm "github.com/cosmos/cosmos-db"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -317,3 +320,114 @@ func BenchmarkMultistoreSnapshotRestore100K(b *testing.B) {
 func BenchmarkMultistoreSnapshotRestore1M(b *testing.B) {
 	benchmarkMultistoreSnapshotRestore(b, 10, 100000)
 }
+
+func TestMultistoreSnapshotRestoreDelta(t *testing.T) {
+	source := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	store1 := source.GetStoreByName("iavl1").(types.CommitKVStore)
+	store2 := source.GetStoreByName("iavl2").(types.CommitKVStore)
+	store3 := source.GetStoreByName("iavl3").(types.CommitKVStore)
+
+	// snapshot writes a full snapshot of the source at height, or a delta one if baseHeight isn't 0
+	snapshot := func(height, baseHeight uint64) (*bytes.Buffer, int) {
+		buf := &bytes.Buffer{}
+		writer := &countingWriter{WriteCloser: protoio.NewDelimitedWriter(buf)}
+		if baseHeight == 0 {
+			require.NoError(t, source.Snapshot(height, writer))
+		} else {
+			require.NoError(t, source.SnapshotDelta(height, baseHeight, writer))
+		}
+		return buf, writer.count
+	}
+	reader := func(buf *bytes.Buffer) protoio.Reader {
+		return protoio.NewDelimitedReader(bytes.NewReader(buf.Bytes()), 1e6)
+	}
+
+	for i := 0; i < 200; i++ {
+		store1.Set([]byte(fmt.Sprintf("key%03d", i)), []byte{byte(i)})
+		store2.Set([]byte(fmt.Sprintf("key%03d", i)), []byte{byte(i)})
+	}
+	source.Commit()
+	base, baseCount := snapshot(1, 0)
+
+	store1.Set([]byte("key010"), []byte("updated"))
+	store1.Delete([]byte("key150"))
+	store2.Set([]byte("key500"), []byte("added"))
+	source.Commit()
+	delta2, delta2Count := snapshot(2, 1)
+	require.Less(t, delta2Count, baseCount/4)
+
+	store1.Set([]byte("key190"), []byte("updated"))
+	store3.Set([]byte("key"), []byte("added"))
+	source.Commit()
+	store2.Delete([]byte("key000"))
+	source.Commit()
+	delta4, _ := snapshot(4, 2)
+
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	_, err := target.RestoreDelta(4, snapshottypes.CurrentFormat, reader(base), []protoio.Reader{reader(delta2), reader(delta4)})
+	require.NoError(t, err)
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, name := range []string{"iavl1", "iavl2", "iavl3"} {
+		assertStoresEqual(t, source.GetStoreByName(name).(types.CommitKVStore),
+			target.GetStoreByName(name).(types.CommitKVStore), "store %q not equal", name)
+	}
+
+	// the second delta snapshot can't be applied on the first snapshot
+	target = newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	_, err = target.RestoreDelta(4, snapshottypes.CurrentFormat, reader(base), []protoio.Reader{reader(delta4)})
+	require.ErrorContains(t, err, "subtree not found in its base snapshot")
+
+	require.Error(t, source.SnapshotDelta(4, 4, nil))
+	require.Error(t, source.SnapshotDelta(4, 0, nil))
+}
+
+// countingWriter counts the written snapshot items.
+type countingWriter struct {
+	protoio.WriteCloser
+	count int
+}
+
+func (w *countingWriter) WriteMsg(msg proto.Message) error {
+	w.count++
+	return w.WriteCloser.WriteMsg(msg)
+}
+
+func TestMultistoreSnapshotStores(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+	names, appHash, err := source.SnapshotStores(version)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"iavl1", "iavl2", "iavl3"}, names)
+	assert.Equal(t, source.LastCommitID().Hash, appHash)
+
+	// the stores are snapshotted and restored separately, in any order
+	bufs := make(map[string]*bytes.Buffer, len(names))
+	for _, name := range names {
+		bufs[name] = &bytes.Buffer{}
+		require.NoError(t, source.SnapshotStore(version, name, protoio.NewDelimitedWriter(bufs[name])))
+	}
+	require.Error(t, source.SnapshotStore(version, "unknown", protoio.NewDelimitedWriter(&bytes.Buffer{})))
+
+	restore := func(appHash []byte) (*rootmulti.Store, error) {
+		target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+		for i := len(names) - 1; i >= 0; i-- {
+			var item snapshottypes.SnapshotItem
+			reader := protoio.NewDelimitedReader(bytes.NewReader(bufs[names[i]].Bytes()), 1e6)
+			require.NoError(t, reader.ReadMsg(&item))
+			require.Equal(t, names[i], item.GetStore().Name)
+			require.NoError(t, target.RestoreStore(version, names[i], reader))
+		}
+		return target, target.CompleteRestore(version, appHash)
+	}
+	target, err := restore(appHash)
+	require.NoError(t, err)
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, name := range names {
+		assertStoresEqual(t, source.GetStoreByName(name).(types.CommitKVStore),
+			target.GetStoreByName(name).(types.CommitKVStore), "store %q not equal", name)
+	}
+
+	_, err = restore([]byte{1, 2, 3})
+	require.ErrorIs(t, err, snapshottypes.ErrAppHashMismatch)
+}
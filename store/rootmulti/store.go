@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"io"
 	"maps"
-	"math"
 	"sort"
 	"strings"
 	"sync"
@@ -79,6 +78,19 @@ type Store struct {
 	listeners         map[types.StoreKey]*types.MemoryListener
 	metrics           metrics.StoreMetrics
 	commitHeader      cmtproto.Header
+	archiveDB         dbm.DB
+
+	// archiveMtx guards archiveStores and their archive trees, written by the
+	// archiving and read by the queries at archived versions.
+	archiveMtx    sync.RWMutex
+	archiveStores map[types.StoreKey]*archiveStore
+
+	// archiveRunMtx guards the state of the archiving in the background.
+	archiveRunMtx  sync.Mutex
+	archiveTarget  int64
+	archiveRunning bool
+	archiveClosed  bool
+	archiveWg      sync.WaitGroup
 }
 
 var (
@@ -299,6 +311,10 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	rs.lastCommitInfo = cInfo
 	rs.stores = newStores
 
+	if err := rs.loadArchiveStores(); err != nil {
+		return err
+	}
+
 	// load any snapshot heights we missed from disk to be pruned on the next run
 	if err := rs.pruningManager.LoadSnapshotHeights(rs.db); err != nil {
 		return err
@@ -589,9 +605,15 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 			store = rs.GetCommitKVStore(key)
 
 			// Attempt to lazy-load an already saved IAVL store version. If the
-			// version does not exist or is pruned, an error should be returned.
+			// version does not exist or is pruned, an error should be returned
+			// unless it was archived.
 			var err error
 			cacheStore, err = store.(*iavl.Store).GetImmutable(version)
+			if err != nil && rs.archiveDB != nil {
+				if archivedStore, archiveErr := rs.getArchivedImmutable(key, version); archiveErr == nil {
+					cacheStore, err = archivedStore, nil
+				}
+			}
 			// if we got error from loading a module store
 			// we fetch commit info of this version
 			// we use commit info to check if the store existed at this version or not
@@ -686,6 +708,10 @@ func (rs *Store) PruneStores(pruningHeight int64) (err error) {
 
 	rs.logger.Debug("pruning store", "heights", pruningHeight)
 
+	if rs.archiveStores != nil {
+		rs.archiveVersions(pruningHeight)
+	}
+
 	for key, store := range rs.stores {
 		rs.logger.Debug("pruning store", "key", key) // Also log store.name (a private variable)?
 
@@ -697,7 +723,17 @@ func (rs *Store) PruneStores(pruningHeight int64) (err error) {
 
 		store = rs.GetCommitKVStore(key)
 
-		err := store.(*iavl.Store).DeleteVersionsTo(pruningHeight)
+		deleteHeight := pruningHeight
+		if _, ok := rs.archiveStores[key]; ok {
+			// only the archived versions are pruned, retaining the last one whose
+			// changes are the base of the changes of the next archived version
+			deleteHeight = min(pruningHeight, rs.archivedVersion(key)) - 1
+			if deleteHeight <= 0 {
+				continue
+			}
+		}
+
+		err := store.(*iavl.Store).DeleteVersionsTo(deleteHeight)
 		if err == nil {
 			continue
 		}
@@ -814,23 +850,22 @@ func parsePath(path string) (storeName, subpath string, err error) {
 
 //---------------------- Snapshotting ------------------
 
-// Snapshot implements snapshottypes.Snapshotter. The snapshot output for a given format must be
-// identical across nodes such that chunks from different sources fit together. If the output for a
-// given format changes (at the byte level), the snapshot format must be bumped - see
-// TestMultistoreSnapshot_Checksum test.
-func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
+// namedStore is an IAVL store to snapshot, with its name.
+type namedStore struct {
+	*iavl.Store
+	name string
+}
+
+// snapshotStores returns the stores to snapshot at height sorted by name, only IAVL stores
+// being supported.
+func (rs *Store) snapshotStores(height uint64) ([]namedStore, error) {
 	if height == 0 {
-		return errorsmod.Wrap(types.ErrLogic, "cannot snapshot height 0")
+		return nil, errorsmod.Wrap(types.ErrLogic, "cannot snapshot height 0")
 	}
 	if height > uint64(GetLatestVersion(rs.db)) {
-		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
+		return nil, errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
 	}
 
-	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
-	}
 	stores := []namedStore{}
 	keys := keysFromStoreKeyMap(rs.stores)
 	for _, key := range keys {
@@ -841,71 +876,84 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 			// Non-persisted stores shouldn't be snapshotted
 			continue
 		default:
-			return errorsmod.Wrapf(types.ErrLogic,
+			return nil, errorsmod.Wrapf(types.ErrLogic,
 				"don't know how to snapshot store %q of type %T", key.Name(), store)
 		}
 	}
 	sort.Slice(stores, func(i, j int) bool {
 		return strings.Compare(stores[i].name, stores[j].name) == -1
 	})
+	return stores, nil
+}
+
+// Snapshot implements snapshottypes.Snapshotter. The snapshot output for a given format must be
+// identical across nodes such that chunks from different sources fit together. If the output for a
+// given format changes (at the byte level), the snapshot format must be bumped - see
+// TestMultistoreSnapshot_Checksum test.
+func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	stores, err := rs.snapshotStores(height)
+	if err != nil {
+		return err
+	}
 
 	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
 	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
 	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
 	// are demarcated by new SnapshotStore items.
 	for _, store := range stores {
-		rs.logger.Debug("starting snapshot", "store", store.name, "height", height)
-		exporter, err := store.Export(int64(height))
-		if err != nil {
-			rs.logger.Error("snapshot failed; exporter error", "store", store.name, "err", err)
+		if err := rs.snapshotStore(store, height, protoWriter); err != nil {
 			return err
 		}
+	}
 
-		err = func() error {
-			defer exporter.Close()
+	return nil
+}
 
-			err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-				Item: &snapshottypes.SnapshotItem_Store{
-					Store: &snapshottypes.SnapshotStoreItem{
-						Name: store.name,
-					},
-				},
-			})
-			if err != nil {
-				rs.logger.Error("snapshot failed; item store write failed", "store", store.name, "err", err)
-				return err
-			}
+// snapshotStore writes the snapshot items of an IAVL store at height.
+func (rs *Store) snapshotStore(store namedStore, height uint64, protoWriter protoio.Writer) error {
+	rs.logger.Debug("starting snapshot", "store", store.name, "height", height)
+	exporter, err := store.Export(int64(height))
+	if err != nil {
+		rs.logger.Error("snapshot failed; exporter error", "store", store.name, "err", err)
+		return err
+	}
+	defer exporter.Close()
 
-			nodeCount := 0
-			for {
-				node, err := exporter.Next()
-				if errors.Is(err, iavltree.ErrorExportDone) {
-					rs.logger.Debug("snapshot Done", "store", store.name, "nodeCount", nodeCount)
-					break
-				} else if err != nil {
-					return err
-				}
-				err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-					Item: &snapshottypes.SnapshotItem_IAVL{
-						IAVL: &snapshottypes.SnapshotIAVLItem{
-							Key:     node.Key,
-							Value:   node.Value,
-							Height:  int32(node.Height),
-							Version: node.Version,
-						},
-					},
-				})
-				if err != nil {
-					return err
-				}
-				nodeCount++
-			}
+	err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Store{
+			Store: &snapshottypes.SnapshotStoreItem{
+				Name: store.name,
+			},
+		},
+	})
+	if err != nil {
+		rs.logger.Error("snapshot failed; item store write failed", "store", store.name, "err", err)
+		return err
+	}
 
-			return nil
-		}()
+	nodeCount := 0
+	for {
+		node, err := exporter.Next()
+		if errors.Is(err, iavltree.ErrorExportDone) {
+			rs.logger.Debug("snapshot Done", "store", store.name, "nodeCount", nodeCount)
+			break
+		} else if err != nil {
+			return err
+		}
+		err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_IAVL{
+				IAVL: &snapshottypes.SnapshotIAVLItem{
+					Key:     node.Key,
+					Value:   node.Value,
+					Height:  int32(node.Height),
+					Version: node.Version,
+				},
+			},
+		})
 		if err != nil {
 			return err
 		}
+		nodeCount++
 	}
 
 	return nil
@@ -957,25 +1005,11 @@ loop:
 				rs.logger.Error("failed to restore; received IAVL node item before store item")
 				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(types.ErrLogic, "received IAVL node item before store item")
 			}
-			if item.IAVL.Height > math.MaxInt8 {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
-			}
-			node := &iavltree.ExportNode{
-				Key:     item.IAVL.Key,
-				Value:   item.IAVL.Value,
-				Height:  int8(item.IAVL.Height),
-				Version: item.IAVL.Version,
-			}
-			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 && node.Value == nil {
-				node.Value = []byte{}
+			node, err := exportNode(item.IAVL)
+			if err != nil {
+				return snapshottypes.SnapshotItem{}, err
 			}
-			err := importer.Add(node)
+			err = importer.Add(node)
 			if err != nil {
 				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL node import failed")
 			}
@@ -997,15 +1031,17 @@ loop:
 	return snapshotItem, rs.LoadLatestVersion()
 }
 
-func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
-	var db dbm.DB
-
+// storeDB returns the database of a store.
+func (rs *Store) storeDB(params storeParams) dbm.DB {
 	if params.db != nil {
-		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
-	} else {
-		prefix := "s/k:" + params.key.Name() + "/"
-		db = dbm.NewPrefixDB(rs.db, []byte(prefix))
+		return dbm.NewPrefixDB(params.db, []byte("s/_/"))
 	}
+	prefix := "s/k:" + params.key.Name() + "/"
+	return dbm.NewPrefixDB(rs.db, []byte(prefix))
+}
+
+func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
+	db := rs.storeDB(params)
 
 	switch params.typ {
 	case types.StoreTypeMulti:
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -635,6 +637,155 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 	require.Eventually(t, isPruned, 1*time.Second, 10*time.Millisecond, "expected error when loading pruned heights")
 }
 
+func TestMultiStore_PruningArchive(t *testing.T) {
+	db, archiveDB := dbm.NewMemDB(), dbm.NewMemDB()
+	newStore := func() *Store {
+		ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+		// the versions are archived synchronously with the pruning
+		ms.SetIAVLSyncPruning(true)
+		ms.SetArchiveDB(archiveDB)
+		require.NoError(t, ms.LoadLatestVersion())
+		return ms
+	}
+	ms := newStore()
+
+	// the store1 has a key per version, the store2 only the keys of the 2 last versions
+	key := func(i int64) []byte { return []byte(fmt.Sprintf("key%02d", i)) }
+	commit := func(i int64) {
+		ms.GetKVStore(testStoreKey1).Set(key(i), []byte{byte(i)})
+		ms.GetKVStore(testStoreKey2).Set(key(i), []byte{byte(i)})
+		ms.GetKVStore(testStoreKey2).Delete(key(i - 2))
+		require.Equal(t, i, ms.Commit().Version)
+	}
+	checkVersion := func(v int64) {
+		cms, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err, "expected no error when loading height: %d", v)
+		for i := int64(1); i <= v+1; i++ {
+			var value []byte
+			if i <= v {
+				value = []byte{byte(i)}
+			}
+			require.Equal(t, value, cms.GetKVStore(testStoreKey1).Get(key(i)), "height: %d, key: %d", v, i)
+			if i < v-1 {
+				value = nil
+			}
+			require.Equal(t, value, cms.GetKVStore(testStoreKey2).Get(key(i)), "height: %d, key: %d", v, i)
+		}
+	}
+
+	for i := int64(1); i <= 10; i++ {
+		commit(i)
+	}
+	// the versions are pruned from the store, up to the last archived one
+	isPruned := func() bool {
+		return !ms.GetCommitKVStore(testStoreKey1).(*iavl.Store).VersionExists(6)
+	}
+	require.Eventually(t, isPruned, 1*time.Second, 10*time.Millisecond, "expected the height to be pruned")
+	require.True(t, ms.GetCommitKVStore(testStoreKey1).(*iavl.Store).VersionExists(7))
+	for v := int64(1); v <= 10; v++ {
+		checkVersion(v)
+	}
+
+	// the archive is continued after a restart
+	ms = newStore()
+	for i := int64(11); i <= 15; i++ {
+		commit(i)
+	}
+	for v := int64(1); v <= 15; v++ {
+		checkVersion(v)
+	}
+
+	// the pruned versions aren't available without the archive
+	ms.archiveDB, ms.archiveStores = nil, nil
+	checkErr := func() bool {
+		_, err := ms.CacheMultiStoreWithVersion(1)
+		return err != nil
+	}
+	require.Eventually(t, checkErr, 1*time.Second, 10*time.Millisecond, "expected error when loading height: 1")
+}
+
+func TestMultiStore_PruningArchiveAsync(t *testing.T) {
+	db, archiveDB := dbm.NewMemDB(), dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+	ms.SetArchiveDB(archiveDB)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	// the versions which aren't pruned are read from the IAVL stores, which
+	// can't be read while they're committed
+	var commitMtx sync.RWMutex
+	var latest atomic.Int64
+	key := func(i int64) []byte { return []byte(fmt.Sprintf("key%02d", i)) }
+	commit := func(i int64) {
+		commitMtx.Lock()
+		defer commitMtx.Unlock()
+		ms.GetKVStore(testStoreKey1).Set(key(i), []byte{byte(i)})
+		require.Equal(t, i, ms.Commit().Version)
+		latest.Store(i)
+	}
+	checkValues := func(cms types.CacheMultiStore, v int64) error {
+		for i := int64(1); i <= v; i++ {
+			if value := cms.GetKVStore(testStoreKey1).Get(key(i)); !bytes.Equal([]byte{byte(i)}, value) {
+				return fmt.Errorf("height %d, key %d: unexpected value %v", v, i, value)
+			}
+		}
+		return nil
+	}
+	checkVersion := func(v int64) error {
+		cms, err := ms.CacheMultiStoreWithVersion(v)
+		if err != nil {
+			return err
+		}
+		return checkValues(cms, v)
+	}
+
+	// the archive is queried while it's written in the background, a version
+	// may be pruned before it's archived in the meantime
+	done := make(chan struct{})
+	queryErr := make(chan error, 1)
+	go func() {
+		defer close(queryErr)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			for v := latest.Load(); v > 0; v-- {
+				var err error
+				commitMtx.RLock()
+				if cms, loadErr := ms.CacheMultiStoreWithVersion(v); loadErr == nil {
+					err = checkValues(cms, v)
+				}
+				commitMtx.RUnlock()
+				if err != nil {
+					queryErr <- err
+					return
+				}
+			}
+		}
+	}()
+
+	for i := int64(1); i <= 20; i++ {
+		commit(i)
+	}
+	close(done)
+	require.NoError(t, <-queryErr)
+
+	// the versions are only pruned once they are archived
+	ms.archiveWg.Wait()
+	archived := ms.archivedVersion(testStoreKey1)
+	require.Equal(t, int64(17), archived)
+	for v := int64(1); v <= 20; v++ {
+		require.NoError(t, checkVersion(v))
+	}
+	require.True(t, ms.GetCommitKVStore(testStoreKey1).(*iavl.Store).VersionExists(archived))
+
+	// the archiving is stopped by CloseArchive
+	ms.CloseArchive()
+	commit(21)
+	require.Equal(t, archived, ms.archivedVersion(testStoreKey1))
+}
+
 // TestUnevenStoresHeightCheck tests if loading root store correctly errors when
 // there's any module store with the wrong height
 func TestUnevenStoresHeightCheck(t *testing.T) {
@@ -64,6 +64,46 @@ func readChunks(chunks <-chan io.ReadCloser) [][]byte {
 
 // snapshotItems serialize a array of bytes as SnapshotItem_ExtensionPayload, and return the chunks.
 func snapshotItems(items [][]byte, ext snapshottypes.ExtensionSnapshotter) [][]byte {
+	return writeChunks(func(protoWriter protoio.Writer) {
+		writeItems(protoWriter, items)
+		writeExtension(protoWriter, ext)
+	})
+}
+
+// snapshotGroups returns the chunks of the chunk groups of a snapshot, the items of the
+// multistore being in the first group and the extension in the second one.
+func snapshotGroups(items [][]byte, ext snapshottypes.ExtensionSnapshotter) ([][]byte, []uint32) {
+	storeChunks := writeChunks(func(protoWriter protoio.Writer) {
+		writeItems(protoWriter, items)
+	})
+	extChunks := writeChunks(func(protoWriter protoio.Writer) {
+		writeExtension(protoWriter, ext)
+	})
+	return append(storeChunks, extChunks...), []uint32{uint32(len(storeChunks)), uint32(len(extChunks))}
+}
+
+func writeItems(protoWriter protoio.Writer, items [][]byte) {
+	for _, item := range items {
+		_ = snapshottypes.WriteExtensionPayload(protoWriter, item)
+	}
+}
+
+func writeExtension(protoWriter protoio.Writer, ext snapshottypes.ExtensionSnapshotter) {
+	// write extension metadata
+	_ = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Extension{
+			Extension: &snapshottypes.SnapshotExtensionMeta{
+				Name:   ext.SnapshotName(),
+				Format: ext.SnapshotFormat(),
+			},
+		},
+	})
+	_ = ext.SnapshotExtension(0, func(payload []byte) error {
+		return snapshottypes.WriteExtensionPayload(protoWriter, payload)
+	})
+}
+
+func writeChunks(write func(protoWriter protoio.Writer)) [][]byte {
 	// copy the same parameters from the code
 	snapshotChunkSize := uint64(10e6)
 	snapshotBufferSize := int(snapshotChunkSize)
@@ -74,21 +114,7 @@ func snapshotItems(items [][]byte, ext snapshottypes.ExtensionSnapshotter) [][]b
 		bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
 		zWriter, _ := zlib.NewWriterLevel(bufWriter, 7)
 		protoWriter := protoio.NewDelimitedWriter(zWriter)
-		for _, item := range items {
-			_ = snapshottypes.WriteExtensionPayload(protoWriter, item)
-		}
-		// write extension metadata
-		_ = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-			Item: &snapshottypes.SnapshotItem_Extension{
-				Extension: &snapshottypes.SnapshotExtensionMeta{
-					Name:   ext.SnapshotName(),
-					Format: ext.SnapshotFormat(),
-				},
-			},
-		})
-		_ = ext.SnapshotExtension(0, func(payload []byte) error {
-			return snapshottypes.WriteExtensionPayload(protoWriter, payload)
-		})
+		write(protoWriter)
 		_ = protoWriter.Close()
 		_ = bufWriter.Flush()
 		_ = chunkWriter.Close()
@@ -8,10 +8,13 @@ import (
 	"io"
 	"math"
 	"os"
+	"runtime"
 	"slices"
 	"sort"
 	"sync"
 
+	protoio "github.com/cosmos/gogoproto/io"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/snapshots/types"
@@ -182,16 +185,103 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
-	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
-	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
+	// Spawn goroutines to generate the snapshot chunk groups and pass their io.ReadClosers through channels
+	groups, metadata, err := m.createGroups(height, 0)
+	if err != nil {
+		return nil, err
+	}
 
-	return m.store.Save(height, types.CurrentFormat, ch)
+	return m.store.SaveGroups(height, types.CurrentFormat, metadata, groups)
 }
 
-// createSnapshot do the heavy work of snapshotting after the validations of request are done
-// the produced chunks are written to the channel.
-func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
+// CreateDelta creates a delta snapshot on top of the latest snapshot and returns its metadata.
+// The multistore must be a types.DeltaSnapshotter.
+func (m *Manager) CreateDelta(height uint64) (*types.Snapshot, error) {
+	if m == nil {
+		return nil, errorsmod.Wrap(storetypes.ErrLogic, "no snapshot store configured")
+	}
+	if _, ok := m.multistore.(types.DeltaSnapshotter); !ok {
+		return nil, errorsmod.Wrapf(storetypes.ErrLogic, "multistore %T doesn't support delta snapshots", m.multistore)
+	}
+
+	defer m.multistore.PruneSnapshotHeight(int64(height))
+
+	err := m.begin(opSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	defer m.end()
+
+	latest, err := m.store.GetLatest()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to examine latest snapshot")
+	}
+	if latest == nil {
+		return nil, errorsmod.Wrap(storetypes.ErrLogic, "no base snapshot for a delta snapshot")
+	}
+	if latest.Height >= height {
+		return nil, errorsmod.Wrapf(storetypes.ErrConflict,
+			"a more recent snapshot already exists at height %v", latest.Height)
+	}
+	if latest.Format != types.CurrentFormat {
+		return nil, errorsmod.Wrapf(types.ErrUnknownFormat, "base snapshot format %v", latest.Format)
+	}
+
+	groups, metadata, err := m.createGroups(height, latest.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.store.SaveGroups(height, types.CurrentFormat, metadata, groups)
+}
+
+// createGroups starts the heavy work of snapshotting after the validations of request are done,
+// returning the channels of the chunks of the snapshot chunk groups and the snapshot metadata.
+// A delta snapshot is created if baseHeight isn't 0. The stores of a full snapshot of a
+// types.StoreSnapshotter are written concurrently in a group each, along with their app hash in the
+// metadata, and the extensions are written in the last group.
+func (m *Manager) createGroups(height, baseHeight uint64) ([]<-chan io.ReadCloser, types.Metadata, error) {
+	metadata := types.Metadata{BaseHeight: baseHeight}
+	var writers []func(protoWriter protoio.Writer) error
+	if multistore, ok := m.multistore.(types.StoreSnapshotter); ok && baseHeight == 0 {
+		names, appHash, err := multistore.SnapshotStores(height)
+		if err != nil {
+			return nil, metadata, err
+		}
+		metadata.AppHash = appHash
+		for _, name := range names {
+			writers = append(writers, func(protoWriter protoio.Writer) error {
+				return multistore.SnapshotStore(height, name, protoWriter)
+			})
+		}
+	} else {
+		writers = append(writers, func(protoWriter protoio.Writer) error {
+			if baseHeight > 0 {
+				return m.multistore.(types.DeltaSnapshotter).SnapshotDelta(height, baseHeight, protoWriter)
+			}
+			return m.multistore.Snapshot(height, protoWriter)
+		})
+	}
+	writers = append(writers, func(protoWriter protoio.Writer) error {
+		return m.snapshotExtensions(height, protoWriter)
+	})
+
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	groups := make([]<-chan io.ReadCloser, len(writers))
+	for i, write := range writers {
+		ch := make(chan io.ReadCloser)
+		groups[i] = ch
+		go func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			writeStream(ch, write)
+		}()
+	}
+	return groups, metadata, nil
+}
+
+// writeStream writes snapshot items into a stream, whose chunks are written to the channel.
+func writeStream(ch chan<- io.ReadCloser, write func(protoWriter protoio.Writer) error) {
 	streamWriter := NewStreamWriter(ch)
 	if streamWriter == nil {
 		return
@@ -202,14 +292,17 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		}
 	}()
 
-	if err := m.multistore.Snapshot(height, streamWriter); err != nil {
+	if err := write(streamWriter); err != nil {
 		streamWriter.CloseWithError(err)
-		return
 	}
+}
+
+// snapshotExtensions writes the snapshot items of the extensions.
+func (m *Manager) snapshotExtensions(height uint64, protoWriter protoio.Writer) error {
 	for _, name := range m.sortedExtensionNames() {
 		extension := m.extensions[name]
 		// write extension metadata
-		err := streamWriter.WriteMsg(&types.SnapshotItem{
+		err := protoWriter.WriteMsg(&types.SnapshotItem{
 			Item: &types.SnapshotItem_Extension{
 				Extension: &types.SnapshotExtensionMeta{
 					Name:   name,
@@ -218,22 +311,28 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 			},
 		})
 		if err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 		payloadWriter := func(payload []byte) error {
-			return types.WriteExtensionPayload(streamWriter, payload)
+			return types.WriteExtensionPayload(protoWriter, payload)
 		}
 		if err := extension.SnapshotExtension(height, payloadWriter); err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 	}
+	return nil
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
+// The delta snapshots aren't listed, as they can't be restored by state sync.
 func (m *Manager) List() ([]*types.Snapshot, error) {
-	return m.store.List()
+	snapshots, err := m.store.List()
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(snapshots, func(snapshot *types.Snapshot) bool {
+		return snapshot.IsDelta()
+	}), nil
 }
 
 // LoadChunk loads a chunk into a byte slice, mirroring ABCI LoadChunk. It can be called
@@ -272,16 +371,30 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 			uint32(len(snapshot.Metadata.ChunkHashes)),
 			snapshot.Chunks)
 	}
+	groupChunks := uint32(0)
+	for _, chunks := range snapshot.ChunkGroups() {
+		if chunks == 0 {
+			return errorsmod.Wrap(types.ErrInvalidMetadata, "empty chunk group")
+		}
+		groupChunks += chunks
+	}
+	if groupChunks != snapshot.Chunks {
+		return errorsmod.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunks in its chunk groups, but %v chunks",
+			groupChunks, snapshot.Chunks)
+	}
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	if !types.IsSupportedFormat(snapshot.Format) {
 		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
 		return errorsmod.Wrap(storetypes.ErrLogic, "cannot restore snapshot at height 0")
 	}
+	if snapshot.IsDelta() {
+		return errorsmod.Wrap(types.ErrInvalidMetadata, "cannot restore a delta snapshot")
+	}
 	if snapshot.Height > uint64(math.MaxInt64) {
 		return errorsmod.Wrapf(types.ErrInvalidMetadata,
 			"snapshot height %v cannot exceed %v", snapshot.Height, int64(math.MaxInt64))
@@ -301,10 +414,18 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 		return errorsmod.Wrapf(err, "failed to create snapshot directory %q", dir)
 	}
 
-	chChunks := m.loadChunkStream(snapshot.Height, snapshot.Format, chChunkIDs)
+	restore := func() error {
+		return m.doRestoreSnapshot(snapshot, m.loadChunkStream(snapshot.Height, snapshot.Format, chChunkIDs))
+	}
+	if snapshot.Format >= types.FormatChunkGroups {
+		groups := m.loadGroupStreams(snapshot, dispatchChunkIDs(snapshot.ChunkGroups(), chChunkIDs))
+		restore = func() error {
+			return m.doRestoreGroups(snapshot, groups)
+		}
+	}
 
 	go func() {
-		err := m.doRestoreSnapshot(snapshot, chChunks)
+		err := restore()
 		chDone <- restoreDone{
 			complete: err == nil,
 			err:      err,
@@ -337,6 +458,60 @@ func (m *Manager) loadChunkStream(height uint64, format uint32, chunkIDs <-chan
 	return chunks
 }
 
+// dispatchChunkIDs dispatches the IDs of the chunks of a snapshot, in order, to the channels of
+// its chunk groups, whose numbers of chunks are given. The channels buffer all the IDs of their
+// group, so the groups can be consumed in any order.
+func dispatchChunkIDs(groups []uint32, chunkIDs <-chan uint32) []<-chan uint32 {
+	chs := make([]chan uint32, len(groups))
+	groupIDs := make([]<-chan uint32, len(groups))
+	for i, chunks := range groups {
+		chs[i] = make(chan uint32, chunks)
+		groupIDs[i] = chs[i]
+	}
+
+	go func() {
+		next := 0 // the first group which isn't complete
+		defer func() {
+			for _, ch := range chs[next:] {
+				close(ch)
+			}
+		}()
+
+		for i, chunks := range groups {
+			for j := uint32(0); j < chunks; j++ {
+				chunkID, ok := <-chunkIDs
+				if !ok {
+					return
+				}
+				chs[i] <- chunkID
+			}
+			close(chs[i])
+			next = i + 1
+		}
+	}()
+
+	return groupIDs
+}
+
+// loadGroupStreams loads the chunks of the chunk groups of a snapshot, given the IDs of their chunks.
+func (m *Manager) loadGroupStreams(snapshot types.Snapshot, groupIDs []<-chan uint32) []<-chan io.ReadCloser {
+	groups := make([]<-chan io.ReadCloser, len(groupIDs))
+	for i, chunkIDs := range groupIDs {
+		groups[i] = m.loadChunkStream(snapshot.Height, snapshot.Format, chunkIDs)
+	}
+	return groups
+}
+
+// loadGroups loads the chunks of the chunk groups of a local snapshot.
+func (m *Manager) loadGroups(snapshot types.Snapshot) []<-chan io.ReadCloser {
+	chunkIDs := make(chan uint32, snapshot.Chunks)
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunkIDs <- i
+	}
+	close(chunkIDs)
+	return m.loadGroupStreams(snapshot, dispatchChunkIDs(snapshot.ChunkGroups(), chunkIDs))
+}
+
 // doRestoreSnapshot do the heavy work of snapshot restoration after preliminary checks on request have passed.
 func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
 	dir := m.store.pathSnapshot(snapshot.Height, snapshot.Format)
@@ -344,17 +519,110 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return errorsmod.Wrapf(err, "failed to create snapshot directory %q", dir)
 	}
 
-	var nextItem types.SnapshotItem
 	streamReader, err := NewStreamReader(chChunks)
 	if err != nil {
 		return err
 	}
 	defer streamReader.Close()
 
+	nextItem, err := m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	if err != nil {
+		return errorsmod.Wrap(err, "multistore restore")
+	}
+
+	return m.restoreExtensions(snapshot.Height, nextItem, streamReader)
+}
+
+// doRestoreGroups do the heavy work of the restoration of a snapshot with chunk groups. The store
+// groups of a full snapshot, which has the app hash of its stores, are restored concurrently by a
+// types.StoreSnapshotter, and then the extensions from the last group, otherwise the groups are
+// restored in sequence.
+func (m *Manager) doRestoreGroups(snapshot types.Snapshot, groups []<-chan io.ReadCloser) error {
+	dir := m.store.pathSnapshot(snapshot.Height, snapshot.Format)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		for _, chunks := range groups {
+			DrainChunks(chunks)
+		}
+		return errorsmod.Wrapf(err, "failed to create snapshot directory %q", dir)
+	}
+
+	multistore, ok := m.multistore.(types.StoreSnapshotter)
+	if !ok || snapshot.IsDelta() || len(snapshot.Metadata.AppHash) == 0 {
+		reader := newGroupsReader(groups)
+		defer reader.Close()
+
+		nextItem, err := m.multistore.Restore(snapshot.Height, snapshot.Format, reader)
+		if err != nil {
+			return errorsmod.Wrap(err, "multistore restore")
+		}
+		return m.restoreExtensions(snapshot.Height, nextItem, reader)
+	}
+
+	storeGroups, extensionGroup := groups[:len(groups)-1], groups[len(groups)-1]
+	defer DrainChunks(extensionGroup)
+
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	errs := make([]error, len(storeGroups))
+	var wg sync.WaitGroup
+	for i, chunks := range storeGroups {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			errs[i] = restoreStoreGroup(multistore, snapshot.Height, chunks)
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return errorsmod.Wrap(err, "multistore restore")
+		}
+	}
+	if err := multistore.CompleteRestore(snapshot.Height, snapshot.Metadata.AppHash); err != nil {
+		return errorsmod.Wrap(err, "multistore restore")
+	}
+
+	streamReader, err := NewStreamReader(extensionGroup)
+	if err != nil {
+		return err
+	}
+	defer streamReader.Close()
+
+	var nextItem types.SnapshotItem
+	if err := streamReader.ReadMsg(&nextItem); err != nil && !errors.Is(err, io.EOF) {
+		return errorsmod.Wrap(err, "invalid protobuf message")
+	}
+	return m.restoreExtensions(snapshot.Height, nextItem, streamReader)
+}
+
+// restoreStoreGroup restores the store of a chunk group, starting with its store item.
+func restoreStoreGroup(multistore types.StoreSnapshotter, height uint64, chunks <-chan io.ReadCloser) error {
+	defer DrainChunks(chunks)
+	streamReader, err := NewStreamReader(chunks)
+	if err != nil {
+		return err
+	}
+	defer streamReader.Close()
+
+	var item types.SnapshotItem
+	if err := streamReader.ReadMsg(&item); err != nil {
+		return errorsmod.Wrap(err, "invalid protobuf message")
+	}
+	storeItem := item.GetStore()
+	if storeItem == nil {
+		return errorsmod.Wrapf(storetypes.ErrLogic, "chunk group starting with snapshot item %T", item.Item)
+	}
+	return multistore.RestoreStore(height, storeItem.Name, streamReader)
+}
+
+// restoreExtensions restores the extension snapshots following the multistore snapshot items,
+// nextItem being the first item after them.
+func (m *Manager) restoreExtensions(height uint64, nextItem types.SnapshotItem, protoReader protoio.Reader) error {
 	// payloadReader reads an extension payload for extension snapshotter, it returns `io.EOF` at extension boundaries.
 	payloadReader := func() ([]byte, error) {
 		nextItem.Reset()
-		if err := streamReader.ReadMsg(&nextItem); err != nil {
+		if err := protoReader.ReadMsg(&nextItem); err != nil {
 			return nil, err
 		}
 		payload := nextItem.GetExtensionPayload()
@@ -364,13 +632,7 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return payload.Payload, nil
 	}
 
-	nextItem, err = m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
-	if err != nil {
-		return errorsmod.Wrap(err, "multistore restore")
-	}
-
 	for nextItem.Item != nil {
-
 		metadata := nextItem.GetExtension()
 		if metadata == nil {
 			return errorsmod.Wrapf(storetypes.ErrLogic, "unknown snapshot item %T", nextItem.Item)
@@ -383,12 +645,12 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 			return errorsmod.Wrapf(types.ErrUnknownFormat, "format %v for extension %s", metadata.Format, metadata.Name)
 		}
 
-		if err := extension.RestoreExtension(snapshot.Height, metadata.Format, payloadReader); err != nil {
+		if err := extension.RestoreExtension(height, metadata.Format, payloadReader); err != nil {
 			return errorsmod.Wrapf(err, "extension %s restore", metadata.Name)
 		}
 
 		if nextItem.GetExtensionPayload() != nil {
-			return errorsmod.Wrapf(err, "extension %s don't exhausted payload stream", metadata.Name)
+			return errorsmod.Wrapf(storetypes.ErrLogic, "extension %s don't exhausted payload stream", metadata.Name)
 		}
 	}
 	return nil
@@ -460,7 +722,7 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 
 // RestoreLocalSnapshot restores app state from a local snapshot.
 func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
-	snapshot, ch, err := m.store.Load(height, format)
+	snapshot, err := m.store.Get(height, format)
 	if err != nil {
 		return err
 	}
@@ -468,6 +730,9 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	if snapshot == nil {
 		return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, format)
 	}
+	if snapshot.IsDelta() {
+		return m.restoreLocalDelta(snapshot)
+	}
 
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
@@ -478,9 +743,76 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	}
 	defer m.endLocked()
 
+	if snapshot.Format >= types.FormatChunkGroups {
+		return m.doRestoreGroups(*snapshot, m.loadGroups(*snapshot))
+	}
+
+	_, ch, err := m.store.Load(height, format)
+	if err != nil {
+		return err
+	}
 	return m.doRestoreSnapshot(*snapshot, ch)
 }
 
+// restoreLocalDelta restores app state from a local delta snapshot and its chain of base snapshots.
+func (m *Manager) restoreLocalDelta(snapshot *types.Snapshot) error {
+	multistore, ok := m.multistore.(types.DeltaSnapshotter)
+	if !ok {
+		return errorsmod.Wrapf(storetypes.ErrLogic, "multistore %T doesn't support delta snapshots", m.multistore)
+	}
+	if !types.IsSupportedFormat(snapshot.Format) {
+		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+	chain, err := m.store.Chain(snapshot)
+	if err != nil {
+		return err
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	err = m.beginLocked(opRestore)
+	if err != nil {
+		return err
+	}
+	defer m.endLocked()
+
+	readers := make([]protoio.Reader, 0, len(chain))
+	for _, s := range chain {
+		reader, err := m.openSnapshotReader(s)
+		if err != nil {
+			return err
+		}
+		defer reader.Close()
+		readers = append(readers, reader)
+	}
+
+	nextItem, err := multistore.RestoreDelta(snapshot.Height, snapshot.Format, readers[0], readers[1:])
+	if err != nil {
+		return errorsmod.Wrap(err, "multistore restore")
+	}
+
+	return m.restoreExtensions(snapshot.Height, nextItem, readers[len(readers)-1])
+}
+
+// openSnapshotReader opens the reader of the snapshot items of a local snapshot.
+func (m *Manager) openSnapshotReader(snapshot *types.Snapshot) (protoio.ReadCloser, error) {
+	if snapshot.Format >= types.FormatChunkGroups {
+		return newGroupsReader(m.loadGroups(*snapshot)), nil
+	}
+
+	_, ch, err := m.store.Load(snapshot.Height, snapshot.Format)
+	if err != nil {
+		return nil, err
+	}
+	streamReader, err := NewStreamReader(ch)
+	if err != nil {
+		DrainChunks(ch)
+		return nil, err
+	}
+	return streamReader, nil
+}
+
 // sortedExtensionNames sort extension names for deterministic iteration.
 func (m *Manager) sortedExtensionNames() []string {
 	names := make([]string, 0, len(m.extensions))
@@ -516,6 +848,27 @@ func (m *Manager) shouldTakeSnapshot(height int64) bool {
 	return m.opts.Interval > 0 && uint64(height)%m.opts.Interval == 0
 }
 
+// shouldTakeDelta returns true if a delta snapshot should be taken on top of the latest snapshot,
+// as its chain of delta snapshots is shorter than the configured length.
+func (m *Manager) shouldTakeDelta() bool {
+	if m.opts.DeltaChainLength == 0 {
+		return false
+	}
+	if _, ok := m.multistore.(types.DeltaSnapshotter); !ok {
+		return false
+	}
+	latest, err := m.store.GetLatest()
+	if err != nil || latest == nil || latest.Format != types.CurrentFormat {
+		return false
+	}
+	chain, err := m.store.Chain(latest)
+	if err != nil {
+		return false
+	}
+	// the chain holds the full base snapshot and its delta snapshots
+	return uint32(len(chain)-1) < m.opts.DeltaChainLength
+}
+
 func (m *Manager) snapshot(height int64) {
 	m.logger.Info("creating state snapshot", "height", height)
 
@@ -524,13 +877,21 @@ func (m *Manager) snapshot(height int64) {
 		return
 	}
 
-	snapshot, err := m.Create(uint64(height))
+	var (
+		snapshot *types.Snapshot
+		err      error
+	)
+	if m.shouldTakeDelta() {
+		snapshot, err = m.CreateDelta(uint64(height))
+	} else {
+		snapshot, err = m.Create(uint64(height))
+	}
 	if err != nil {
 		m.logger.Error("failed to create state snapshot", "height", height, "err", err)
 		return
 	}
 
-	m.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format)
+	m.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format, "base", snapshot.Metadata.BaseHeight)
 
 	if m.opts.KeepRecent > 0 {
 		m.logger.Debug("pruning state snapshots")
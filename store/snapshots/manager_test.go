@@ -2,6 +2,7 @@ package snapshots_test
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	db "github.com/cosmos/cosmos-db"
@@ -9,8 +10,11 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
 	"cosmossdk.io/store/snapshots"
 	"cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
 )
 
 var opts = types.NewSnapshotOptions(1500, 2)
@@ -73,7 +77,7 @@ func TestManager_Take(t *testing.T) {
 	}
 	extSnapshotter := newExtSnapshotter(10)
 
-	expectChunks := snapshotItems(items, extSnapshotter)
+	expectChunks, expectGroups := snapshotGroups(items, extSnapshotter)
 	manager := snapshots.NewManager(store, opts, snapshotter, nil, log.NewNopLogger())
 	err := manager.RegisterExtensions(extSnapshotter)
 	require.NoError(t, err)
@@ -97,10 +101,11 @@ func TestManager_Take(t *testing.T) {
 	assert.Equal(t, &types.Snapshot{
 		Height: 5,
 		Format: snapshotter.SnapshotFormat(),
-		Chunks: 1,
-		Hash:   []uint8{0xc5, 0xf7, 0xfe, 0xea, 0xd3, 0x4d, 0x3e, 0x87, 0xff, 0x41, 0xa2, 0x27, 0xfa, 0xcb, 0x38, 0x17, 0xa, 0x5, 0xeb, 0x27, 0x4e, 0x16, 0x5e, 0xf3, 0xb2, 0x8b, 0x47, 0xd1, 0xe6, 0x94, 0x7e, 0x8b},
+		Chunks: 2,
+		Hash:   hash(checksums(expectChunks)),
 		Metadata: types.Metadata{
 			ChunkHashes: checksums(expectChunks),
+			ChunkGroups: expectGroups,
 		},
 	}, snapshot)
 
@@ -283,3 +288,150 @@ func TestIsFormatSupported(t *testing.T) {
 		require.False(t, snapshots.IsFormatSupported(emptyExtension, 1))
 	})
 }
+
+func newDeltaMultiStore(t *testing.T) (*rootmulti.Store, storetypes.CommitKVStore) {
+	t.Helper()
+	multistore := rootmulti.NewStore(db.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	multistore.MountStoreWithDB(storetypes.NewKVStoreKey("store"), storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, multistore.LoadLatestVersion())
+	return multistore, multistore.GetStoreByName("store").(storetypes.CommitKVStore)
+}
+
+func TestManager_Delta(t *testing.T) {
+	store, err := snapshots.NewStore(db.NewMemDB(), GetTempDir(t))
+	require.NoError(t, err)
+	source, kvStore := newDeltaMultiStore(t)
+	deltaOpts := opts
+	deltaOpts.DeltaChainLength = 2
+	manager := snapshots.NewManager(store, deltaOpts, source, nil, log.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(newExtSnapshotter(10)))
+
+	// a delta snapshot needs a base snapshot
+	_, err = manager.CreateDelta(1)
+	require.Error(t, err)
+
+	for i := 0; i < 100; i++ {
+		kvStore.Set([]byte(fmt.Sprintf("key%03d", i)), []byte{byte(i)})
+	}
+	source.Commit()
+	_, err = manager.Create(1)
+	require.NoError(t, err)
+
+	kvStore.Set([]byte("key050"), []byte("updated"))
+	source.Commit()
+	delta, err := manager.CreateDelta(2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, delta.Metadata.BaseHeight)
+
+	kvStore.Delete([]byte("key000"))
+	source.Commit()
+	_, err = manager.CreateDelta(3)
+	require.NoError(t, err)
+
+	// the delta snapshots aren't offered to state sync
+	list, err := manager.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.EqualValues(t, 1, list[0].Height)
+	abciSnapshot, err := store.Get(3, types.CurrentFormat)
+	require.NoError(t, err)
+	err = manager.Restore(*abciSnapshot)
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+
+	target, _ := newDeltaMultiStore(t)
+	extSnapshotter := newExtSnapshotter(0)
+	manager = snapshots.NewManager(store, deltaOpts, target, nil, log.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(extSnapshotter))
+	require.NoError(t, manager.RestoreLocalSnapshot(3, types.CurrentFormat))
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	assert.Equal(t, newExtSnapshotter(10).state, extSnapshotter.state)
+
+	// a multistore without delta snapshots can't create nor restore them
+	manager = snapshots.NewManager(store, deltaOpts, &mockSnapshotter{prunedHeights: make(map[int64]struct{})}, nil, log.NewNopLogger())
+	_, err = manager.CreateDelta(4)
+	require.Error(t, err)
+	require.Error(t, manager.RestoreLocalSnapshot(3, types.CurrentFormat))
+}
+
+func TestManager_ChunkGroups(t *testing.T) {
+	store, err := snapshots.NewStore(db.NewMemDB(), GetTempDir(t))
+	require.NoError(t, err)
+	newMultiStore := func() *rootmulti.Store {
+		multistore := rootmulti.NewStore(db.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+		for _, name := range []string{"store1", "store2", "store3"} {
+			multistore.MountStoreWithDB(storetypes.NewKVStoreKey(name), storetypes.StoreTypeIAVL, nil)
+		}
+		require.NoError(t, multistore.LoadLatestVersion())
+		return multistore
+	}
+	source := newMultiStore()
+	for i, name := range []string{"store1", "store2", "store3"} {
+		kvStore := source.GetStoreByName(name).(storetypes.CommitKVStore)
+		for j := 0; j < 100*(i+1); j++ {
+			kvStore.Set([]byte(fmt.Sprintf("key%03d", j)), []byte(name))
+		}
+	}
+	source.Commit()
+	manager := snapshots.NewManager(store, opts, source, nil, log.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(newExtSnapshotter(10)))
+	snapshot, err := manager.Create(1)
+	require.NoError(t, err)
+
+	// a chunk group per store and one for the extensions
+	assert.Equal(t, types.CurrentFormat, snapshot.Format)
+	assert.Len(t, snapshot.Metadata.ChunkGroups, 4)
+	assert.Equal(t, source.LastCommitID().Hash, snapshot.Metadata.AppHash)
+	assert.Equal(t, hash(snapshot.Metadata.ChunkHashes), snapshot.Hash)
+
+	_, chunks, err := store.Load(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	allChunks := readChunks(chunks)
+	restoreABCI := func(snapshot types.Snapshot) (*rootmulti.Store, *extSnapshotter, error) {
+		target := newMultiStore()
+		extSnapshotter := newExtSnapshotter(0)
+		targetStore, err := snapshots.NewStore(db.NewMemDB(), GetTempDir(t))
+		require.NoError(t, err)
+		manager := snapshots.NewManager(targetStore, opts, target, nil, log.NewNopLogger())
+		require.NoError(t, manager.RegisterExtensions(extSnapshotter))
+		require.NoError(t, manager.Restore(snapshot))
+		for i, chunk := range allChunks {
+			done, err := manager.RestoreChunk(chunk)
+			if err != nil {
+				return nil, nil, err
+			}
+			assert.Equal(t, uint32(i) == snapshot.Chunks-1, done)
+		}
+		return target, extSnapshotter, nil
+	}
+	target, extSnapshotter, err := restoreABCI(*snapshot)
+	require.NoError(t, err)
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	assert.Equal(t, newExtSnapshotter(10).state, extSnapshotter.state)
+
+	// the restored app hash is verified
+	invalid := *snapshot
+	invalid.Metadata.AppHash = []byte{1, 2, 3}
+	_, _, err = restoreABCI(invalid)
+	require.ErrorIs(t, err, types.ErrAppHashMismatch)
+
+	// without an app hash, the groups are restored in sequence
+	sequential := *snapshot
+	sequential.Metadata.AppHash = nil
+	target, extSnapshotter, err = restoreABCI(sequential)
+	require.NoError(t, err)
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	assert.Equal(t, newExtSnapshotter(10).state, extSnapshotter.state)
+
+	// the chunk groups must add up to the chunks
+	invalid = *snapshot
+	invalid.Metadata.ChunkGroups = []uint32{1}
+	require.ErrorIs(t, manager.Restore(invalid), types.ErrInvalidMetadata)
+
+	target = newMultiStore()
+	extSnapshotter = newExtSnapshotter(0)
+	manager = snapshots.NewManager(store, opts, target, nil, log.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(extSnapshotter))
+	require.NoError(t, manager.RestoreLocalSnapshot(1, types.CurrentFormat))
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	assert.Equal(t, newExtSnapshotter(10).state, extSnapshotter.state)
+}
@@ -131,6 +131,29 @@ func (s *Store) List() ([]*types.Snapshot, error) {
 	return snapshots, iter.Error()
 }
 
+// Chain returns the chain of snapshots needed to restore the given snapshot, from its full
+// base snapshot to the snapshot itself. It errors if a base snapshot doesn't exist.
+func (s *Store) Chain(snapshot *types.Snapshot) ([]*types.Snapshot, error) {
+	chain := []*types.Snapshot{snapshot}
+	for snapshot.IsDelta() {
+		if snapshot.Metadata.BaseHeight >= snapshot.Height {
+			return nil, errors.Wrapf(types.ErrInvalidMetadata,
+				"invalid base height %v of a delta snapshot at height %v", snapshot.Metadata.BaseHeight, snapshot.Height)
+		}
+		base, err := s.Get(snapshot.Metadata.BaseHeight, snapshot.Format)
+		if err != nil {
+			return nil, err
+		}
+		if base == nil {
+			return nil, errors.Wrapf(storetypes.ErrLogic,
+				"base snapshot for height %v format %v doesn't exist", snapshot.Metadata.BaseHeight, snapshot.Format)
+		}
+		chain = append([]*types.Snapshot{base}, chain...)
+		snapshot = base
+	}
+	return chain, nil
+}
+
 // Load loads a snapshot (both metadata and binary chunks). The chunks must be consumed and closed.
 // Returns nil if the snapshot does not exist.
 func (s *Store) Load(height uint64, format uint32) (*types.Snapshot, <-chan io.ReadCloser, error) {
@@ -181,7 +204,8 @@ func (s *Store) loadChunkFile(height uint64, format, chunk uint32) (io.ReadClose
 	return os.Open(path)
 }
 
-// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained.
+// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained,
+// along with the base snapshots of the retained delta snapshots so that they remain restorable.
 func (s *Store) Prune(retain uint32) (uint64, error) {
 	iter, err := s.db.ReverseIterator(encodeKey(0, 0), encodeKey(uint64(math.MaxUint64), math.MaxUint32))
 	if err != nil {
@@ -192,13 +216,22 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 	pruned := uint64(0)
 	prunedHeights := make(map[uint64]bool)
 	skip := make(map[uint64]bool)
+	bases := make(map[uint64]bool) // base heights of the retained delta snapshots
 	for ; iter.Valid(); iter.Next() {
 		height, format, err := decodeKey(iter.Key())
 		if err != nil {
 			return 0, errors.Wrap(err, "failed to prune snapshots")
 		}
-		if skip[height] || uint32(len(skip)) < retain {
+		if skip[height] || bases[height] || uint32(len(skip)) < retain {
 			skip[height] = true
+			// the base snapshots are older, so they're retained further down the iteration
+			snapshot := &types.Snapshot{}
+			if err := proto.Unmarshal(iter.Value(), snapshot); err != nil {
+				return 0, errors.Wrap(err, "failed to decode snapshot info")
+			}
+			if snapshot.IsDelta() {
+				bases[snapshot.Metadata.BaseHeight] = true
+			}
 			continue
 		}
 		err = s.Delete(height, format)
@@ -225,10 +258,54 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
 ) (*types.Snapshot, error) {
-	defer DrainChunks(chunks)
+	return s.SaveGroups(height, format, types.Metadata{}, []<-chan io.ReadCloser{chunks})
+}
+
+// SaveDelta saves a delta snapshot to disk, returning it. Its base snapshot, at baseHeight
+// with the same format, must exist.
+func (s *Store) SaveDelta(
+	height, baseHeight uint64, format uint32, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	if baseHeight == 0 {
+		DrainChunks(chunks)
+		return nil, errors.Wrapf(storetypes.ErrLogic,
+			"invalid base height %v of a delta snapshot at height %v", baseHeight, height)
+	}
+	return s.SaveGroups(height, format, types.Metadata{BaseHeight: baseHeight}, []<-chan io.ReadCloser{chunks})
+}
+
+// SaveGroups saves a snapshot whose chunks are split in groups to disk, returning it. The groups
+// are saved and hashed concurrently, a snapshot of a format without chunk groups having a single
+// group. The base height and the app hash of the snapshot are the ones of the given metadata, its
+// chunk hashes and groups being computed.
+func (s *Store) SaveGroups(
+	height uint64, format uint32, metadata types.Metadata, groups []<-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	defer func() {
+		for _, chunks := range groups {
+			DrainChunks(chunks)
+		}
+	}()
 	if height == 0 {
 		return nil, errors.Wrap(storetypes.ErrLogic, "snapshot height cannot be 0")
 	}
+	if format < types.FormatChunkGroups && len(groups) != 1 {
+		return nil, errors.Wrapf(storetypes.ErrLogic, "snapshot format %v has no chunk groups", format)
+	}
+	if metadata.BaseHeight > 0 {
+		if metadata.BaseHeight >= height {
+			return nil, errors.Wrapf(storetypes.ErrLogic,
+				"invalid base height %v of a delta snapshot at height %v", metadata.BaseHeight, height)
+		}
+		base, err := s.Get(metadata.BaseHeight, format)
+		if err != nil {
+			return nil, err
+		}
+		if base == nil {
+			return nil, errors.Wrapf(storetypes.ErrLogic,
+				"base snapshot for height %v format %v doesn't exist", metadata.BaseHeight, format)
+		}
+	}
 
 	s.mtx.Lock()
 	saving := s.saving[height]
@@ -256,8 +333,25 @@ func (s *Store) Save(
 	snapshot := &types.Snapshot{
 		Height: height,
 		Format: format,
+		Metadata: types.Metadata{
+			BaseHeight: metadata.BaseHeight,
+			AppHash:    metadata.AppHash,
+		},
+	}
+	if format < types.FormatChunkGroups {
+		err = s.saveStream(snapshot, groups[0])
+	} else {
+		err = s.saveGroups(snapshot, groups)
+	}
+	if err != nil {
+		return nil, err
 	}
+	return snapshot, s.saveSnapshot(snapshot)
+}
 
+// saveStream saves the chunks of a snapshot without chunk groups, whose hash is the hash of
+// the content of its chunks.
+func (s *Store) saveStream(snapshot *types.Snapshot, chunks <-chan io.ReadCloser) error {
 	dirCreated := false
 	index := uint32(0)
 	snapshotHasher := sha256.New()
@@ -267,52 +361,118 @@ func (s *Store) Save(
 		// If the directory disappears during chunk saving,
 		// the whole operation will fail anyway.
 		if !dirCreated {
-			dir := s.pathSnapshot(height, format)
+			dir := s.pathSnapshot(snapshot.Height, snapshot.Format)
 			if err := os.MkdirAll(dir, 0o755); err != nil {
-				return nil, errors.Wrapf(err, "failed to create snapshot directory %q", dir)
+				return errors.Wrapf(err, "failed to create snapshot directory %q", dir)
 			}
 
 			dirCreated = true
 		}
 
-		if err := s.saveChunk(chunkBody, index, snapshot, chunkHasher, snapshotHasher); err != nil {
-			return nil, err
+		path := s.PathChunk(snapshot.Height, snapshot.Format, index)
+		chunkHash, err := saveChunk(chunkBody, path, index, chunkHasher, snapshotHasher)
+		if err != nil {
+			return err
 		}
+		snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, chunkHash)
 		index++
 	}
 	snapshot.Chunks = index
 	snapshot.Hash = snapshotHasher.Sum(nil)
-	return snapshot, s.saveSnapshot(snapshot)
+	return nil
 }
 
-// saveChunk saves the given chunkBody with the given index to its appropriate path on disk.
-// The hash of the chunk is appended to the snapshot's metadata,
-// and the overall snapshot hash is updated with the chunk content too.
-func (s *Store) saveChunk(chunkBody io.ReadCloser, index uint32, snapshot *types.Snapshot, chunkHasher, snapshotHasher hash.Hash) error {
+// saveGroups concurrently saves the chunk groups of a snapshot, whose hash is the hash of its
+// chunk hashes. The chunks of each group are saved in a directory of their own, and then moved
+// to their index.
+func (s *Store) saveGroups(snapshot *types.Snapshot, groups []<-chan io.ReadCloser) error {
+	groupHashes := make([][][]byte, len(groups))
+	groupErrs := make([]error, len(groups))
+	var wg sync.WaitGroup
+	for i, chunks := range groups {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			groupHashes[i], groupErrs[i] = s.saveGroup(snapshot, uint32(i), chunks)
+		}()
+	}
+	wg.Wait()
+	defer func() {
+		for i := range groups {
+			_ = os.RemoveAll(s.pathGroup(snapshot, uint32(i)))
+		}
+	}()
+	for _, err := range groupErrs {
+		if err != nil {
+			return err
+		}
+	}
+
+	index := uint32(0)
+	snapshotHasher := sha256.New()
+	for i, hashes := range groupHashes {
+		for j, chunkHash := range hashes {
+			path := s.PathChunk(snapshot.Height, snapshot.Format, index)
+			if err := os.Rename(filepath.Join(s.pathGroup(snapshot, uint32(i)), strconv.Itoa(j)), path); err != nil {
+				return errors.Wrapf(err, "failed to move snapshot chunk file %q", path)
+			}
+			snapshotHasher.Write(chunkHash)
+			index++
+		}
+		snapshot.Metadata.ChunkGroups = append(snapshot.Metadata.ChunkGroups, uint32(len(hashes)))
+		snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, hashes...)
+	}
+	snapshot.Chunks = index
+	snapshot.Hash = snapshotHasher.Sum(nil)
+	return nil
+}
+
+// saveGroup saves the chunks of a chunk group in its directory, returning their hashes.
+func (s *Store) saveGroup(snapshot *types.Snapshot, group uint32, chunks <-chan io.ReadCloser) ([][]byte, error) {
+	defer DrainChunks(chunks)
+	dir := s.pathGroup(snapshot, group)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errors.Wrapf(err, "failed to create snapshot chunk group directory %q", dir)
+	}
+
+	hashes := [][]byte{}
+	chunkHasher := sha256.New()
+	for chunkBody := range chunks {
+		index := uint32(len(hashes))
+		chunkHash, err := saveChunk(chunkBody, filepath.Join(dir, strconv.FormatUint(uint64(index), 10)), index, chunkHasher, io.Discard)
+		if err != nil {
+			return nil, errors.Wrapf(err, "chunk group %d", group)
+		}
+		hashes = append(hashes, chunkHash)
+	}
+	return hashes, nil
+}
+
+// saveChunk saves the given chunkBody with the given index to the given path on disk, returning
+// its hash. The snapshot hasher is updated with the chunk content too.
+func saveChunk(chunkBody io.ReadCloser, path string, index uint32, chunkHasher hash.Hash, snapshotHasher io.Writer) ([]byte, error) {
 	defer chunkBody.Close()
 
-	path := s.PathChunk(snapshot.Height, snapshot.Format, index)
 	chunkFile, err := os.Create(path)
 	if err != nil {
-		return errors.Wrapf(err, "failed to create snapshot chunk file %q", path)
+		return nil, errors.Wrapf(err, "failed to create snapshot chunk file %q", path)
 	}
 	defer chunkFile.Close()
 
 	chunkHasher.Reset()
 	if _, err := io.Copy(io.MultiWriter(chunkFile, chunkHasher, snapshotHasher), chunkBody); err != nil {
-		return errors.Wrapf(err, "failed to generate snapshot chunk %d", index)
+		return nil, errors.Wrapf(err, "failed to generate snapshot chunk %d", index)
 	}
 
 	if err := chunkFile.Close(); err != nil {
-		return errors.Wrapf(err, "failed to close snapshot chunk file %d", index)
+		return nil, errors.Wrapf(err, "failed to close snapshot chunk file %d", index)
 	}
 
 	if err := chunkBody.Close(); err != nil {
-		return errors.Wrapf(err, "failed to close snapshot chunk body %d", index)
+		return nil, errors.Wrapf(err, "failed to close snapshot chunk body %d", index)
 	}
 
-	snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, chunkHasher.Sum(nil))
-	return nil
+	return chunkHasher.Sum(nil), nil
 }
 
 // saveChunkContent save the chunk to disk
@@ -341,6 +501,11 @@ func (s *Store) pathSnapshot(height uint64, format uint32) string {
 	return filepath.Join(s.pathHeight(height), strconv.FormatUint(uint64(format), 10))
 }
 
+// pathGroup generates the path of the directory where the chunks of a chunk group are saved.
+func (s *Store) pathGroup(snapshot *types.Snapshot, group uint32) string {
+	return filepath.Join(s.pathSnapshot(snapshot.Height, snapshot.Format), "group-"+strconv.FormatUint(uint64(group), 10))
+}
+
 // PathChunk generates a snapshot chunk path.
 func (s *Store) PathChunk(height uint64, format, chunk uint32) string {
 	return filepath.Join(s.pathSnapshot(height, format), strconv.FormatUint(uint64(chunk), 10))
@@ -331,3 +331,107 @@ func TestStore_Save(t *testing.T) {
 	require.NoError(t, err)
 	close(ch)
 }
+
+func TestStore_SaveGroups(t *testing.T) {
+	store := setupStore(t)
+	groups := [][][]byte{{{1}, {2}}, {{3}}, {{4}, {5}, {6}}}
+	chunks := [][]byte{{1}, {2}, {3}, {4}, {5}, {6}}
+	snapshot, err := store.SaveGroups(4, types.FormatChunkGroups, types.Metadata{AppHash: []byte{7}},
+		[]<-chan io.ReadCloser{makeChunks(groups[0]), makeChunks(groups[1]), makeChunks(groups[2])})
+	require.NoError(t, err)
+	assert.Equal(t, &types.Snapshot{
+		Height: 4,
+		Format: types.FormatChunkGroups,
+		Chunks: 6,
+		Hash:   hash(checksums(chunks)),
+		Metadata: types.Metadata{
+			ChunkHashes: checksums(chunks),
+			ChunkGroups: []uint32{2, 1, 3},
+			AppHash:     []byte{7},
+		},
+	}, snapshot)
+	loaded, chunkBodies, err := store.Load(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, loaded)
+	assert.Equal(t, chunks, readChunks(chunkBodies))
+
+	// the stream format has a single chunk group
+	_, err = store.SaveGroups(5, types.FormatStream, types.Metadata{},
+		[]<-chan io.ReadCloser{makeChunks(groups[0]), makeChunks(groups[1])})
+	require.Error(t, err)
+
+	// a group error fails the snapshot, emptying out all the groups
+	someErr := errors.New("boom")
+	pr, pw := io.Pipe()
+	require.NoError(t, pw.CloseWithError(someErr))
+	ch := make(chan io.ReadCloser, 2)
+	ch <- pr
+	ch <- io.NopCloser(bytes.NewBuffer([]byte{0xff}))
+	close(ch)
+	other := makeChunks(groups[2])
+	_, err = store.SaveGroups(6, types.FormatChunkGroups, types.Metadata{}, []<-chan io.ReadCloser{other, ch})
+	require.ErrorIs(t, err, someErr)
+	assert.Empty(t, ch)
+	assert.Empty(t, other)
+	snapshot, err = store.Get(6, types.FormatChunkGroups)
+	require.NoError(t, err)
+	assert.Nil(t, snapshot)
+}
+
+func TestStore_SaveDelta(t *testing.T) {
+	store := setupStore(t)
+
+	// the base snapshot must exist, at a lower height
+	_, err := store.SaveDelta(5, 4, 2, makeChunks([][]byte{{5, 2, 0}}))
+	require.Error(t, err)
+	_, err = store.SaveDelta(3, 3, 2, makeChunks([][]byte{{3, 2, 0}}))
+	require.Error(t, err)
+
+	delta, err := store.SaveDelta(5, 3, 2, makeChunks([][]byte{{5, 2, 0}}))
+	require.NoError(t, err)
+	assert.True(t, delta.IsDelta())
+	assert.EqualValues(t, 3, delta.Metadata.BaseHeight)
+	_, err = store.SaveDelta(7, 5, 2, makeChunks([][]byte{{7, 2, 0}}))
+	require.NoError(t, err)
+
+	snapshot, err := store.Get(7, 2)
+	require.NoError(t, err)
+	chain, err := store.Chain(snapshot)
+	require.NoError(t, err)
+	heights := []uint64{}
+	for _, s := range chain {
+		heights = append(heights, s.Height)
+	}
+	assert.Equal(t, []uint64{3, 5, 7}, heights)
+
+	// a chain with a deleted base snapshot is broken
+	require.NoError(t, store.Delete(3, 2))
+	_, err = store.Chain(snapshot)
+	require.Error(t, err)
+}
+
+func TestStore_PruneDelta(t *testing.T) {
+	store := setupStore(t)
+	_, err := store.SaveDelta(4, 2, 2, makeChunks([][]byte{{4, 2, 0}}))
+	require.NoError(t, err)
+	_, err = store.SaveDelta(5, 4, 2, makeChunks([][]byte{{5, 2, 0}}))
+	require.NoError(t, err)
+
+	// the bases of the retained delta snapshots are retained, with all the formats of their height
+	pruned, err := store.Prune(1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, pruned)
+
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	heights := []uint64{}
+	for _, s := range snapshots {
+		heights = append(heights, s.Height)
+	}
+	assert.Equal(t, []uint64{5, 4, 2, 2}, heights)
+
+	snapshot, err := store.Get(5, 2)
+	require.NoError(t, err)
+	_, err = store.Chain(snapshot)
+	require.NoError(t, err)
+}
@@ -3,12 +3,13 @@ package snapshots
 import (
 	"bufio"
 	"compress/zlib"
+	"errors"
 	"io"
 
 	protoio "github.com/cosmos/gogoproto/io"
 	"github.com/cosmos/gogoproto/proto"
 
-	"cosmossdk.io/errors"
+	errorsmod "cosmossdk.io/errors"
 )
 
 const (
@@ -34,7 +35,7 @@ func NewStreamWriter(ch chan<- io.ReadCloser) *StreamWriter {
 	bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
 	zWriter, err := zlib.NewWriterLevel(bufWriter, snapshotCompressionLevel)
 	if err != nil {
-		chunkWriter.CloseWithError(errors.Wrap(err, "zlib failure"))
+		chunkWriter.CloseWithError(errorsmod.Wrap(err, "zlib failure"))
 		return nil
 	}
 	protoWriter := protoio.NewDelimitedWriter(zWriter)
@@ -82,7 +83,7 @@ func NewStreamReader(chunks <-chan io.ReadCloser) (*StreamReader, error) {
 	chunkReader := NewChunkReader(chunks)
 	zReader, err := zlib.NewReader(chunkReader)
 	if err != nil {
-		return nil, errors.Wrap(err, "zlib failure")
+		return nil, errorsmod.Wrap(err, "zlib failure")
 	}
 	protoReader := protoio.NewDelimitedReader(zReader, snapshotMaxItemSize)
 	return &StreamReader{
@@ -111,3 +112,55 @@ func (sr *StreamReader) Close() error {
 	}
 	return err
 }
+
+// groupsReader reads the snapshot items of chunk groups in sequence, each group being a stream
+// of its own.
+type groupsReader struct {
+	groups []<-chan io.ReadCloser
+	// current is the reader of the group being read, nil before its first item
+	current *StreamReader
+}
+
+func newGroupsReader(groups []<-chan io.ReadCloser) *groupsReader {
+	return &groupsReader{groups: groups}
+}
+
+// ReadMsg implements protoio.Reader interface
+func (r *groupsReader) ReadMsg(msg proto.Message) error {
+	for {
+		if r.current == nil {
+			if len(r.groups) == 0 {
+				return io.EOF
+			}
+			streamReader, err := NewStreamReader(r.groups[0])
+			if err != nil {
+				return err
+			}
+			r.current = streamReader
+			r.groups = r.groups[1:]
+		}
+
+		err := r.current.ReadMsg(msg)
+		if !errors.Is(err, io.EOF) {
+			return err
+		}
+		if err := r.current.Close(); err != nil {
+			return err
+		}
+		r.current = nil
+	}
+}
+
+// Close implements io.Closer interface
+func (r *groupsReader) Close() error {
+	var err error
+	if r.current != nil {
+		err = r.current.Close()
+		r.current = nil
+	}
+	for _, chunks := range r.groups {
+		DrainChunks(chunks)
+	}
+	r.groups = nil
+	return err
+}
@@ -37,3 +37,18 @@ func (s Snapshot) ToABCI() (abci.Snapshot, error) {
 	}
 	return out, nil
 }
+
+// IsDelta returns whether the snapshot is a delta snapshot, which is restored
+// on top of the snapshot at its base height.
+func (s Snapshot) IsDelta() bool {
+	return s.Metadata.BaseHeight > 0
+}
+
+// ChunkGroups returns the number of chunks of each chunk group of the snapshot. A snapshot without
+// chunk groups, like the ones of the FormatStream format, has a single group.
+func (s Snapshot) ChunkGroups() []uint32 {
+	if len(s.Metadata.ChunkGroups) == 0 {
+		return []uint32{s.Chunks}
+	}
+	return s.Metadata.ChunkGroups
+}
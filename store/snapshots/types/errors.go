@@ -16,4 +16,7 @@ var (
 
 	// ErrInvalidSnapshotVersion is returned when the snapshot version is invalid
 	ErrInvalidSnapshotVersion = errors.New("invalid snapshot version")
+
+	// ErrAppHashMismatch is returned when the restored app hash isn't the one of the snapshot.
+	ErrAppHashMismatch = errors.New("restored app hash mismatch")
 )
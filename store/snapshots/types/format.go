@@ -1,6 +1,28 @@
 package types
 
+import "slices"
+
+const (
+	// FormatStream is the snapshot format whose chunks are a single zlib-compressed stream of
+	// snapshot items.
+	FormatStream uint32 = 3
+
+	// FormatChunkGroups is the snapshot format whose chunks are split in groups, each group being
+	// an independently zlib-compressed stream of snapshot items. A full snapshot has a group for each
+	// store followed by a group for the extensions, so that the stores can be restored concurrently.
+	// The snapshot items of the groups, in sequence, are the ones of a FormatStream snapshot.
+	FormatChunkGroups uint32 = 4
+)
+
 // CurrentFormat is the currently used format for snapshots. Snapshots using the same format
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
-const CurrentFormat uint32 = 3
+const CurrentFormat = FormatChunkGroups
+
+// SupportedFormats are the snapshot formats which can be restored.
+var SupportedFormats = []uint32{FormatStream, FormatChunkGroups}
+
+// IsSupportedFormat returns whether snapshots of the format can be restored.
+func IsSupportedFormat(format uint32) bool {
+	return slices.Contains(SupportedFormats, format)
+}
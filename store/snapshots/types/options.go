@@ -8,6 +8,10 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// DeltaChainLength defines how many delta snapshots are taken on top of a
+	// full snapshot before the next full snapshot, 0 disabling delta snapshots.
+	DeltaChainLength uint32
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
@@ -104,6 +104,15 @@ func (m *Snapshot) GetMetadata() Metadata {
 // Metadata contains SDK-specific snapshot metadata.
 type Metadata struct {
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// base_height is the height of the snapshot a delta snapshot is applied on,
+	// it's 0 for a full snapshot.
+	BaseHeight uint64 `protobuf:"varint,2,opt,name=base_height,json=baseHeight,proto3" json:"base_height,omitempty"`
+	// chunk_groups is the number of chunks of each chunk group, an independently
+	// compressed stream of snapshot items, in the snapshot formats using them.
+	ChunkGroups []uint32 `protobuf:"varint,3,rep,packed,name=chunk_groups,json=chunkGroups,proto3" json:"chunk_groups,omitempty"`
+	// app_hash is the app hash at the snapshot height, which is verified once the
+	// snapshot is restored.
+	AppHash []byte `protobuf:"bytes,4,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -146,6 +155,27 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (m *Metadata) GetBaseHeight() uint64 {
+	if m != nil {
+		return m.BaseHeight
+	}
+	return 0
+}
+
+func (m *Metadata) GetChunkGroups() []uint32 {
+	if m != nil {
+		return m.ChunkGroups
+	}
+	return nil
+}
+
+func (m *Metadata) GetAppHash() []byte {
+	if m != nil {
+		return m.AppHash
+	}
+	return nil
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 type SnapshotItem struct {
 	// item is the specific type of snapshot item.
@@ -156,6 +186,7 @@ type SnapshotItem struct {
 	//	*SnapshotItem_IAVL
 	//	*SnapshotItem_Extension
 	//	*SnapshotItem_ExtensionPayload
+	//	*SnapshotItem_IAVLSubtree
 	Item isSnapshotItem_Item `protobuf_oneof:"item"`
 }
 
@@ -210,11 +241,15 @@ type SnapshotItem_Extension struct {
 type SnapshotItem_ExtensionPayload struct {
 	ExtensionPayload *SnapshotExtensionPayload `protobuf:"bytes,4,opt,name=extension_payload,json=extensionPayload,proto3,oneof" json:"extension_payload,omitempty"`
 }
+type SnapshotItem_IAVLSubtree struct {
+	IAVLSubtree *SnapshotIAVLSubtreeItem `protobuf:"bytes,5,opt,name=iavl_subtree,json=iavlSubtree,proto3,oneof" json:"iavl_subtree,omitempty"`
+}
 
 func (*SnapshotItem_Store) isSnapshotItem_Item()            {}
 func (*SnapshotItem_IAVL) isSnapshotItem_Item()             {}
 func (*SnapshotItem_Extension) isSnapshotItem_Item()        {}
 func (*SnapshotItem_ExtensionPayload) isSnapshotItem_Item() {}
+func (*SnapshotItem_IAVLSubtree) isSnapshotItem_Item()      {}
 
 func (m *SnapshotItem) GetItem() isSnapshotItem_Item {
 	if m != nil {
@@ -251,6 +286,13 @@ func (m *SnapshotItem) GetExtensionPayload() *SnapshotExtensionPayload {
 	return nil
 }
 
+func (m *SnapshotItem) GetIAVLSubtree() *SnapshotIAVLSubtreeItem {
+	if x, ok := m.GetItem().(*SnapshotItem_IAVLSubtree); ok {
+		return x.IAVLSubtree
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -258,6 +300,7 @@ func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 		(*SnapshotItem_IAVL)(nil),
 		(*SnapshotItem_Extension)(nil),
 		(*SnapshotItem_ExtensionPayload)(nil),
+		(*SnapshotItem_IAVLSubtree)(nil),
 	}
 }
 
@@ -377,6 +420,78 @@ func (m *SnapshotIAVLItem) GetHeight() int32 {
 	return 0
 }
 
+// SnapshotIAVLSubtreeItem references a subtree of the IAVL tree of the base
+// snapshot of a delta snapshot, which is unchanged in the exported tree.
+type SnapshotIAVLSubtreeItem struct {
+	// first_key is the key of the leftmost leaf of the subtree.
+	FirstKey []byte `protobuf:"bytes,1,opt,name=first_key,json=firstKey,proto3" json:"first_key,omitempty"`
+	// key, version and height are the ones of the root node of the subtree.
+	Key     []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Version int64  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Height  int32  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SnapshotIAVLSubtreeItem) Reset()         { *m = SnapshotIAVLSubtreeItem{} }
+func (m *SnapshotIAVLSubtreeItem) String() string { return proto.CompactTextString(m) }
+func (*SnapshotIAVLSubtreeItem) ProtoMessage()    {}
+func (*SnapshotIAVLSubtreeItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d5cca1aa5b69183, []int{5}
+}
+func (m *SnapshotIAVLSubtreeItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotIAVLSubtreeItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotIAVLSubtreeItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotIAVLSubtreeItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotIAVLSubtreeItem.Merge(m, src)
+}
+func (m *SnapshotIAVLSubtreeItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotIAVLSubtreeItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotIAVLSubtreeItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotIAVLSubtreeItem proto.InternalMessageInfo
+
+func (m *SnapshotIAVLSubtreeItem) GetFirstKey() []byte {
+	if m != nil {
+		return m.FirstKey
+	}
+	return nil
+}
+
+func (m *SnapshotIAVLSubtreeItem) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *SnapshotIAVLSubtreeItem) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SnapshotIAVLSubtreeItem) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // SnapshotExtensionMeta contains metadata about an external snapshotter.
 type SnapshotExtensionMeta struct {
 	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -387,7 +502,7 @@ func (m *SnapshotExtensionMeta) Reset()         { *m = SnapshotExtensionMeta{} }
 func (m *SnapshotExtensionMeta) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionMeta) ProtoMessage()    {}
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{5}
+	return fileDescriptor_3d5cca1aa5b69183, []int{6}
 }
 func (m *SnapshotExtensionMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,7 +554,7 @@ func (m *SnapshotExtensionPayload) Reset()         { *m = SnapshotExtensionPaylo
 func (m *SnapshotExtensionPayload) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionPayload) ProtoMessage()    {}
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{6}
+	return fileDescriptor_3d5cca1aa5b69183, []int{7}
 }
 func (m *SnapshotExtensionPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,6 +596,7 @@ func init() {
 	proto.RegisterType((*SnapshotItem)(nil), "cosmos.store.snapshots.v1.SnapshotItem")
 	proto.RegisterType((*SnapshotStoreItem)(nil), "cosmos.store.snapshots.v1.SnapshotStoreItem")
 	proto.RegisterType((*SnapshotIAVLItem)(nil), "cosmos.store.snapshots.v1.SnapshotIAVLItem")
+	proto.RegisterType((*SnapshotIAVLSubtreeItem)(nil), "cosmos.store.snapshots.v1.SnapshotIAVLSubtreeItem")
 	proto.RegisterType((*SnapshotExtensionMeta)(nil), "cosmos.store.snapshots.v1.SnapshotExtensionMeta")
 	proto.RegisterType((*SnapshotExtensionPayload)(nil), "cosmos.store.snapshots.v1.SnapshotExtensionPayload")
 }
@@ -490,41 +606,48 @@ func init() {
 }
 
 var fileDescriptor_3d5cca1aa5b69183 = []byte{
-	// 538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x6f, 0xd3, 0x3c,
-	0x18, 0x8e, 0xd7, 0xb4, 0x5f, 0xf7, 0x26, 0x9f, 0xe8, 0xcc, 0x40, 0x61, 0x87, 0x2c, 0x84, 0x4b,
-	0x24, 0x68, 0xba, 0x75, 0x88, 0x03, 0xda, 0x85, 0x8a, 0x49, 0xad, 0x00, 0x69, 0xf2, 0x24, 0x84,
-	0xb8, 0x54, 0xde, 0x6a, 0x9a, 0xaa, 0x4d, 0x5d, 0xd5, 0x5e, 0x45, 0x8f, 0xfc, 0x03, 0xfe, 0x08,
-	0x37, 0x7e, 0xc4, 0x8e, 0x13, 0x27, 0x4e, 0x13, 0x6a, 0xff, 0x02, 0x3f, 0x00, 0xd9, 0x4e, 0x0a,
-	0xda, 0x52, 0x34, 0x6e, 0xef, 0xf3, 0xfa, 0x79, 0x1e, 0xfb, 0x7d, 0xec, 0x04, 0xa2, 0x33, 0x2e,
-	0x52, 0x2e, 0x1a, 0x42, 0xf2, 0x29, 0x6b, 0x88, 0x31, 0x9d, 0x88, 0x84, 0x4b, 0xd1, 0x98, 0xed,
-	0xaf, 0x40, 0x3c, 0x99, 0x72, 0xc9, 0xf1, 0x03, 0xc3, 0x8c, 0x35, 0x33, 0x5e, 0x31, 0xe3, 0xd9,
-	0xfe, 0xce, 0x76, 0x9f, 0xf7, 0xb9, 0x66, 0x35, 0x54, 0x65, 0x04, 0x3b, 0x99, 0xa0, 0x6b, 0x16,
-	0x32, 0xb5, 0x06, 0xe1, 0x17, 0x04, 0xd5, 0x93, 0xcc, 0x01, 0xdf, 0x87, 0x4a, 0xc2, 0x06, 0xfd,
-	0x44, 0x7a, 0x28, 0x40, 0x91, 0x4d, 0x32, 0xa4, 0xfa, 0x1f, 0xf8, 0x34, 0xa5, 0xd2, 0xdb, 0x08,
-	0x50, 0xf4, 0x3f, 0xc9, 0x90, 0xea, 0x9f, 0x25, 0xe7, 0xe3, 0xa1, 0xf0, 0x4a, 0xa6, 0x6f, 0x10,
-	0xc6, 0x60, 0x27, 0x54, 0x24, 0x9e, 0x1d, 0xa0, 0xc8, 0x25, 0xba, 0xc6, 0x47, 0x50, 0x4d, 0x99,
-	0xa4, 0x3d, 0x2a, 0xa9, 0x57, 0x0e, 0x50, 0xe4, 0x34, 0x1f, 0xc5, 0x6b, 0xe7, 0x88, 0xdf, 0x64,
-	0xd4, 0x96, 0x7d, 0x71, 0xb5, 0x6b, 0x91, 0x95, 0x34, 0xac, 0x43, 0x35, 0x5f, 0xc3, 0x0f, 0xc1,
-	0xd5, 0x1b, 0x76, 0xd5, 0x06, 0x4c, 0x78, 0x28, 0x28, 0x45, 0x2e, 0x71, 0x74, 0xaf, 0xad, 0x5b,
-	0xe1, 0xcf, 0x0d, 0x70, 0xf3, 0xf1, 0x3a, 0x92, 0xa5, 0xf8, 0x25, 0x94, 0xf5, 0x76, 0x7a, 0x42,
-	0xa7, 0xf9, 0xe4, 0x2f, 0x67, 0xc8, 0x75, 0x27, 0x6a, 0x49, 0x89, 0xdb, 0x16, 0x31, 0x62, 0xfc,
-	0x0a, 0xec, 0x01, 0x9d, 0x8d, 0x74, 0x1c, 0x4e, 0xf3, 0xf1, 0x2d, 0x4c, 0x3a, 0x2f, 0xde, 0xbe,
-	0x56, 0x1e, 0xad, 0xea, 0xe2, 0x6a, 0xd7, 0x56, 0xa8, 0x6d, 0x11, 0x6d, 0x82, 0x8f, 0x61, 0x93,
-	0x7d, 0x94, 0x6c, 0x2c, 0x06, 0x7c, 0xac, 0x83, 0x74, 0x9a, 0x7b, 0xb7, 0x70, 0x3c, 0xca, 0x35,
-	0x2a, 0x8f, 0xb6, 0x45, 0x7e, 0x9b, 0xe0, 0x53, 0xd8, 0x5a, 0x81, 0xee, 0x84, 0xce, 0x47, 0x9c,
-	0xf6, 0xf4, 0x65, 0x38, 0xcd, 0x83, 0x7f, 0x71, 0x3e, 0x36, 0xd2, 0xb6, 0x45, 0x6a, 0xec, 0x5a,
-	0xef, 0xf9, 0xdd, 0x6f, 0x5f, 0xeb, 0x77, 0x8c, 0x57, 0x5d, 0xf4, 0x86, 0xc1, 0x5e, 0xfc, 0xf4,
-	0x59, 0xab, 0x02, 0xf6, 0x40, 0xb2, 0x34, 0x3c, 0x84, 0xad, 0x1b, 0xe9, 0xa9, 0x57, 0x31, 0xa6,
-	0xa9, 0x49, 0x7e, 0x93, 0xe8, 0xba, 0xd0, 0x25, 0xfc, 0x84, 0xa0, 0x76, 0x3d, 0x37, 0x5c, 0x83,
-	0xd2, 0x90, 0xcd, 0xb5, 0xd8, 0x25, 0xaa, 0xc4, 0xdb, 0x50, 0x9e, 0xd1, 0xd1, 0x39, 0xd3, 0xb7,
-	0xe0, 0x12, 0x03, 0xb0, 0x07, 0xff, 0xcd, 0xd8, 0x74, 0x95, 0x65, 0x89, 0xe4, 0xf0, 0x8f, 0xd7,
-	0xad, 0xa2, 0x28, 0xe7, 0xaf, 0xbb, 0xf8, 0x0c, 0xef, 0xe0, 0x5e, 0x61, 0xd0, 0x45, 0x53, 0xac,
-	0xfb, 0x3e, 0x8a, 0x9d, 0x3b, 0xe0, 0xad, 0x0b, 0x5a, 0x1d, 0x3e, 0xbf, 0x2e, 0x33, 0x68, 0x0e,
-	0x8b, 0xe3, 0x3e, 0xbc, 0x58, 0xf8, 0xe8, 0x72, 0xe1, 0xa3, 0x1f, 0x0b, 0x1f, 0x7d, 0x5e, 0xfa,
-	0xd6, 0xe5, 0xd2, 0xb7, 0xbe, 0x2f, 0x7d, 0xeb, 0x7d, 0x68, 0xa8, 0xa2, 0x37, 0x8c, 0x07, 0xfc,
-	0xc6, 0x2f, 0x45, 0xce, 0x27, 0x4c, 0x9c, 0x56, 0xf4, 0x1f, 0xe0, 0xe0, 0x57, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x57, 0xe2, 0xd5, 0x36, 0x79, 0x04, 0x00, 0x00,
+	// 646 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x4f, 0x13, 0x41,
+	0x14, 0xde, 0xa5, 0x5b, 0x28, 0x6f, 0x97, 0x00, 0x23, 0xea, 0x82, 0x49, 0x5b, 0xd7, 0xcb, 0x26,
+	0xca, 0x16, 0x8a, 0xf1, 0x60, 0xb8, 0x48, 0x24, 0x2e, 0x41, 0x13, 0x32, 0x24, 0xc6, 0x78, 0xd9,
+	0x4c, 0xe9, 0xd0, 0x6d, 0x4a, 0x3b, 0x9b, 0x9d, 0x69, 0x63, 0xbd, 0x79, 0xf7, 0xe0, 0x1f, 0xf1,
+	0xe6, 0x8f, 0xe0, 0x48, 0x3c, 0x79, 0x6a, 0x4c, 0xf9, 0x23, 0x66, 0x66, 0x76, 0x0b, 0x81, 0xd6,
+	0xc0, 0x6d, 0xbe, 0xb7, 0xef, 0xfb, 0xe6, 0x7d, 0xef, 0xbd, 0x1d, 0xf0, 0x4f, 0x18, 0xef, 0x32,
+	0x5e, 0xe3, 0x82, 0xa5, 0xb4, 0xc6, 0x7b, 0x24, 0xe1, 0x31, 0x13, 0xbc, 0x36, 0xd8, 0x9e, 0x80,
+	0x20, 0x49, 0x99, 0x60, 0x68, 0x5d, 0x67, 0x06, 0x2a, 0x33, 0x98, 0x64, 0x06, 0x83, 0xed, 0x8d,
+	0xb5, 0x16, 0x6b, 0x31, 0x95, 0x55, 0x93, 0x27, 0x4d, 0xd8, 0xc8, 0x08, 0x91, 0xfe, 0x90, 0xb1,
+	0x15, 0xf0, 0x7e, 0x9a, 0x50, 0x3a, 0xce, 0x14, 0xd0, 0x23, 0x98, 0x8f, 0x69, 0xbb, 0x15, 0x0b,
+	0xd7, 0xac, 0x9a, 0xbe, 0x85, 0x33, 0x24, 0xe3, 0xa7, 0x2c, 0xed, 0x12, 0xe1, 0xce, 0x55, 0x4d,
+	0x7f, 0x09, 0x67, 0x48, 0xc6, 0x4f, 0xe2, 0x7e, 0xaf, 0xc3, 0xdd, 0x82, 0x8e, 0x6b, 0x84, 0x10,
+	0x58, 0x31, 0xe1, 0xb1, 0x6b, 0x55, 0x4d, 0xdf, 0xc1, 0xea, 0x8c, 0xf6, 0xa1, 0xd4, 0xa5, 0x82,
+	0x34, 0x89, 0x20, 0x6e, 0xb1, 0x6a, 0xfa, 0x76, 0xfd, 0x59, 0x30, 0xd3, 0x47, 0xf0, 0x21, 0x4b,
+	0xdd, 0xb3, 0xce, 0x47, 0x15, 0x03, 0x4f, 0xa8, 0xde, 0x77, 0x13, 0x4a, 0xf9, 0x47, 0xf4, 0x14,
+	0x1c, 0x75, 0x63, 0x24, 0x6f, 0xa0, 0xdc, 0x35, 0xab, 0x05, 0xdf, 0xc1, 0xb6, 0x8a, 0x85, 0x2a,
+	0x84, 0x2a, 0x60, 0x37, 0x08, 0xa7, 0x51, 0xe6, 0x6b, 0x4e, 0xf9, 0x02, 0x19, 0x0a, 0xb5, 0xb7,
+	0x89, 0x46, 0x2b, 0x65, 0xfd, 0x44, 0x3a, 0x29, 0xf8, 0x4b, 0x99, 0xc6, 0x3b, 0x15, 0x42, 0xeb,
+	0x50, 0x22, 0x49, 0x12, 0x5d, 0xb3, 0xb4, 0x40, 0x92, 0x44, 0x5e, 0xe0, 0x8d, 0x0a, 0xe0, 0xe4,
+	0xed, 0x3b, 0x10, 0xb4, 0x8b, 0xde, 0x42, 0x51, 0xd9, 0x51, 0x1d, 0xb4, 0xeb, 0x2f, 0xfe, 0xe3,
+	0x31, 0xe7, 0x1d, 0xcb, 0x4f, 0x92, 0x1c, 0x1a, 0x58, 0x93, 0xd1, 0x21, 0x58, 0x6d, 0x32, 0x38,
+	0x53, 0xe5, 0xda, 0xf5, 0xe7, 0x77, 0x10, 0x39, 0x78, 0xf3, 0xf1, 0xbd, 0xd4, 0xd8, 0x2b, 0x8d,
+	0x47, 0x15, 0x4b, 0xa2, 0xd0, 0xc0, 0x4a, 0x04, 0x1d, 0xc1, 0x22, 0xfd, 0x22, 0x68, 0x8f, 0xb7,
+	0x59, 0x4f, 0x0d, 0xca, 0xae, 0x6f, 0xdd, 0x41, 0x71, 0x3f, 0xe7, 0xc8, 0x76, 0x87, 0x06, 0xbe,
+	0x12, 0x41, 0x0d, 0x58, 0x9d, 0x80, 0x28, 0x21, 0xc3, 0x33, 0x46, 0x9a, 0xaa, 0x33, 0x76, 0x7d,
+	0xe7, 0x3e, 0xca, 0x47, 0x9a, 0x1a, 0x1a, 0x78, 0x85, 0xde, 0x88, 0xa1, 0x18, 0x1c, 0x59, 0x7d,
+	0xc4, 0xfb, 0x0d, 0x91, 0x52, 0x9a, 0xed, 0x4c, 0xfd, 0x8e, 0xad, 0x38, 0xd6, 0x2c, 0xd5, 0x91,
+	0xe5, 0xf1, 0xa8, 0x62, 0x5f, 0x0b, 0x86, 0x06, 0xb6, 0xa5, 0x74, 0x06, 0x5f, 0x3f, 0xf8, 0xfd,
+	0x6b, 0x73, 0x59, 0xcb, 0x6e, 0xf2, 0x66, 0xa7, 0xba, 0x15, 0xbc, 0x7c, 0xb5, 0x37, 0x0f, 0x56,
+	0x5b, 0xd0, 0xae, 0xb7, 0x0b, 0xab, 0xb7, 0xe6, 0x24, 0xf7, 0xbb, 0x47, 0xba, 0x7a, 0xc6, 0x8b,
+	0x58, 0x9d, 0xa7, 0xaa, 0x78, 0xdf, 0x4c, 0x58, 0xb9, 0x39, 0x21, 0xb4, 0x02, 0x85, 0x0e, 0x1d,
+	0x2a, 0xb2, 0x83, 0xe5, 0x11, 0xad, 0x41, 0x71, 0x40, 0xce, 0xfa, 0x54, 0xcd, 0xdb, 0xc1, 0x1a,
+	0x20, 0x17, 0x16, 0x06, 0x34, 0x9d, 0x4c, 0xad, 0x80, 0x73, 0x78, 0xed, 0x3f, 0x95, 0x4d, 0x2f,
+	0xe6, 0xff, 0xe9, 0xf4, 0x1a, 0xbe, 0xc2, 0xe3, 0x19, 0x9d, 0x41, 0x4f, 0x60, 0xf1, 0xb4, 0x9d,
+	0x72, 0x11, 0x5d, 0xd5, 0x53, 0x52, 0x81, 0x43, 0x3a, 0xcc, 0xcb, 0x9c, 0xbb, 0x2a, 0xf3, 0xde,
+	0x05, 0x79, 0x9f, 0xe0, 0xe1, 0xd4, 0x75, 0x9a, 0xd6, 0xc1, 0x59, 0xaf, 0xcc, 0x74, 0x57, 0x07,
+	0xe0, 0xce, 0x5a, 0x27, 0x59, 0x67, 0xbe, 0x94, 0xda, 0x54, 0x0e, 0xa7, 0x8f, 0x7a, 0xf7, 0x7c,
+	0x5c, 0x36, 0x2f, 0xc6, 0x65, 0xf3, 0xef, 0xb8, 0x6c, 0xfe, 0xb8, 0x2c, 0x1b, 0x17, 0x97, 0x65,
+	0xe3, 0xcf, 0x65, 0xd9, 0xf8, 0xec, 0xe9, 0x54, 0xde, 0xec, 0x04, 0x6d, 0x76, 0xeb, 0x61, 0x16,
+	0xc3, 0x84, 0xf2, 0xc6, 0xbc, 0x7a, 0x47, 0x77, 0xfe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x31, 0xb5,
+	0xe5, 0x36, 0xbf, 0x05, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -602,6 +725,36 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AppHash) > 0 {
+		i -= len(m.AppHash)
+		copy(dAtA[i:], m.AppHash)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.AppHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChunkGroups) > 0 {
+		dAtA3 := make([]byte, len(m.ChunkGroups)*10)
+		var j2 int
+		for _, num := range m.ChunkGroups {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintSnapshot(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BaseHeight != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.BaseHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.ChunkHashes) > 0 {
 		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ChunkHashes[iNdEx])
@@ -730,6 +883,27 @@ func (m *SnapshotItem_ExtensionPayload) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *SnapshotItem_IAVLSubtree) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotItem_IAVLSubtree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IAVLSubtree != nil {
+		{
+			size, err := m.IAVLSubtree.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SnapshotStoreItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -807,6 +981,53 @@ func (m *SnapshotIAVLItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SnapshotIAVLSubtreeItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotIAVLSubtreeItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotIAVLSubtreeItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Version != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FirstKey) > 0 {
+		i -= len(m.FirstKey)
+		copy(dAtA[i:], m.FirstKey)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.FirstKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SnapshotExtensionMeta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -919,6 +1140,20 @@ func (m *Metadata) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
+	if m.BaseHeight != 0 {
+		n += 1 + sovSnapshot(uint64(m.BaseHeight))
+	}
+	if len(m.ChunkGroups) > 0 {
+		l = 0
+		for _, e := range m.ChunkGroups {
+			l += sovSnapshot(uint64(e))
+		}
+		n += 1 + sovSnapshot(uint64(l)) + l
+	}
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
 	return n
 }
 
@@ -982,6 +1217,18 @@ func (m *SnapshotItem_ExtensionPayload) Size() (n int) {
 	}
 	return n
 }
+func (m *SnapshotItem_IAVLSubtree) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IAVLSubtree != nil {
+		l = m.IAVLSubtree.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
 func (m *SnapshotStoreItem) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1018,6 +1265,29 @@ func (m *SnapshotIAVLItem) Size() (n int) {
 	return n
 }
 
+func (m *SnapshotIAVLSubtreeItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FirstKey)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovSnapshot(uint64(m.Version))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSnapshot(uint64(m.Height))
+	}
+	return n
+}
+
 func (m *SnapshotExtensionMeta) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1288,6 +1558,135 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
 			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseHeight", wireType)
+			}
+			m.BaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSnapshot
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ChunkGroups = append(m.ChunkGroups, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSnapshot
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSnapshot
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSnapshot
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ChunkGroups) == 0 {
+					m.ChunkGroups = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSnapshot
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ChunkGroups = append(m.ChunkGroups, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkGroups", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -1478,6 +1877,41 @@ func (m *SnapshotItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Item = &SnapshotItem_ExtensionPayload{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IAVLSubtree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SnapshotIAVLSubtreeItem{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &SnapshotItem_IAVLSubtree{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -1737,6 +2171,162 @@ func (m *SnapshotIAVLItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SnapshotIAVLSubtreeItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotIAVLSubtreeItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotIAVLSubtreeItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstKey = append(m.FirstKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.FirstKey == nil {
+				m.FirstKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SnapshotExtensionMeta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
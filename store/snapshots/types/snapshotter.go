@@ -26,6 +26,42 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// DeltaSnapshotter is a Snapshotter which can also create and restore delta snapshots, which
+// only contain the state changed since a base snapshot.
+type DeltaSnapshotter interface {
+	Snapshotter
+
+	// SnapshotDelta writes the snapshot items of the state at height which changed since
+	// baseHeight into the protobuf writer.
+	SnapshotDelta(height, baseHeight uint64, protoWriter protoio.Writer) error
+
+	// RestoreDelta restores a state snapshot from the reader of its full base snapshot
+	// and the readers of its chain of delta snapshots, the last one being at height.
+	RestoreDelta(height uint64, format uint32, base protoio.Reader, deltas []protoio.Reader) (SnapshotItem, error)
+}
+
+// StoreSnapshotter is a Snapshotter which can also snapshot and restore its stores independently,
+// each store being restored concurrently from its chunk group in the FormatChunkGroups format.
+type StoreSnapshotter interface {
+	Snapshotter
+
+	// SnapshotStores returns the names of the stores to snapshot at height, in snapshot order,
+	// and the app hash at height.
+	SnapshotStores(height uint64) ([]string, []byte, error)
+
+	// SnapshotStore writes the snapshot items of the store with the given name at height into
+	// the protobuf writer, starting with its store item.
+	SnapshotStore(height uint64, name string, protoWriter protoio.Writer) error
+
+	// RestoreStore restores the store with the given name from the reader of its snapshot items
+	// following its store item. It may be called concurrently for different stores.
+	RestoreStore(height uint64, name string, protoReader protoio.Reader) error
+
+	// CompleteRestore completes the restore once all the stores are restored, returning an error
+	// if the restored app hash isn't the given one, unless it's empty.
+	CompleteRestore(height uint64, appHash []byte) error
+}
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
@@ -0,0 +1,169 @@
+// Package file implements an ABCIListener which streams the FinalizeBlock
+// requests and responses, the Commit responses and the state change sets of
+// the blocks to local files, and the reader to replay them.
+//
+// A file is a sequence of records, each one made of a kind byte, the uvarint
+// length of its payload and the payload. A block is written as the records of
+// its FinalizeBlock request and response, of its StoreKVPair change set and of
+// its Commit response, followed by a sync marker record which holds the height
+// of the block and the CRC32 of its records. A block without a valid sync
+// marker, which is left by a crash, is ignored by the reader.
+package file
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	filePrefix    = "blocks-"
+	fileExtension = ".abci"
+	gzipExtension = ".gz"
+
+	// maxRecordSize bounds the payload size of a record read from a file.
+	maxRecordSize = 1 << 30
+)
+
+const (
+	recordFinalizeBlockRequest byte = iota + 1
+	recordFinalizeBlockResponse
+	recordStoreKVPair
+	recordCommitResponse
+	recordSyncMarker
+)
+
+const syncMarkerSize = 8 + 4
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// fileName returns the name of the file starting at the given height.
+func fileName(height int64, compressed bool) string {
+	name := fmt.Sprintf("%s%020d%s", filePrefix, height, fileExtension)
+	if compressed {
+		name += gzipExtension
+	}
+	return name
+}
+
+// parseFileName returns the start height of a file, and whether it is
+// compressed.
+func parseFileName(name string) (height int64, compressed, ok bool) {
+	if !strings.HasPrefix(name, filePrefix) {
+		return 0, false, false
+	}
+	name = strings.TrimPrefix(name, filePrefix)
+	name, compressed = strings.CutSuffix(name, gzipExtension)
+	name, found := strings.CutSuffix(name, fileExtension)
+	if !found {
+		return 0, false, false
+	}
+
+	height, err := strconv.ParseInt(name, 10, 64)
+	if err != nil {
+		return 0, false, false
+	}
+
+	return height, compressed, true
+}
+
+// Files returns the paths of the files of dir written by the Listener, in
+// height order.
+func Files(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	type heightFile struct {
+		height int64
+		path   string
+	}
+	var files []heightFile
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		height, _, ok := parseFileName(entry.Name())
+		if !ok {
+			continue
+		}
+		files = append(files, heightFile{height: height, path: filepath.Join(dir, entry.Name())})
+	}
+	sort.SliceStable(files, func(i, j int) bool { return files[i].height < files[j].height })
+
+	paths := make([]string, len(files))
+	for i, file := range files {
+		paths[i] = file.path
+	}
+	return paths, nil
+}
+
+// writeRecord writes a record to w and adds it to the CRC of its block.
+func writeRecord(w io.Writer, crc uint32, kind byte, payload []byte) (uint32, error) {
+	header := make([]byte, 1, 1+binary.MaxVarintLen64)
+	header[0] = kind
+	header = binary.AppendUvarint(header, uint64(len(payload)))
+
+	crc = crc32.Update(crc, crcTable, header)
+	crc = crc32.Update(crc, crcTable, payload)
+	if _, err := w.Write(header); err != nil {
+		return crc, err
+	}
+	if _, err := w.Write(payload); err != nil {
+		return crc, err
+	}
+	return crc, nil
+}
+
+// errTornRecord is returned when a record is cut by the end of the file, or
+// its length is corrupted.
+var errTornRecord = errors.New("torn record")
+
+// byteReader is the reader of records.
+type byteReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// readRecord reads a record from r, and returns it with its encoded header.
+func readRecord(r byteReader) (kind byte, header, payload []byte, err error) {
+	kind, err = r.ReadByte()
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	size, err := binary.ReadUvarint(r)
+	if err != nil || size > maxRecordSize {
+		return 0, nil, nil, errTornRecord
+	}
+
+	header = binary.AppendUvarint([]byte{kind}, size)
+	payload = make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, nil, errTornRecord
+	}
+
+	return kind, header, payload, nil
+}
+
+func encodeSyncMarker(height int64, crc uint32) []byte {
+	bz := make([]byte, syncMarkerSize)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	binary.BigEndian.PutUint32(bz[8:], crc)
+	return bz
+}
+
+func decodeSyncMarker(bz []byte) (height int64, crc uint32, err error) {
+	if len(bz) != syncMarkerSize {
+		return 0, 0, fmt.Errorf("invalid sync marker size %d", len(bz))
+	}
+	return int64(binary.BigEndian.Uint64(bz)), binary.BigEndian.Uint32(bz[8:]), nil
+}
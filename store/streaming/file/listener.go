@@ -0,0 +1,236 @@
+package file
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/store/types"
+)
+
+// Config defines the configuration of the file Listener.
+type Config struct {
+	// Dir is the directory of the files.
+	Dir string
+	// MaxBlocks is the number of blocks after which a file is rotated, 0 for no
+	// limit.
+	MaxBlocks int64
+	// MaxBytes is the size in bytes after which a file is rotated, 0 for no
+	// limit.
+	MaxBytes int64
+	// Compress defines whether the files are compressed with gzip.
+	Compress bool
+	// Fsync defines whether a file is synced to the disk after each block.
+	Fsync bool
+}
+
+var (
+	_ types.ABCIListener = (*Listener)(nil)
+	_ io.Closer          = (*Listener)(nil)
+)
+
+// Listener is an ABCIListener which writes the blocks to files of a directory.
+// A block is written when it's committed, and a file is only rotated between
+// two blocks. A file is named after the height of its first block, so the
+// blocks written after a restart go to a new file.
+type Listener struct {
+	cfg Config
+
+	mtx    sync.Mutex
+	file   *os.File
+	size   *countingWriter
+	gzip   *gzip.Writer
+	buf    *bufio.Writer
+	blocks int64
+
+	// height and crc are the height and the CRC of the block being written,
+	// height is 0 when no block is being written
+	height int64
+	crc    uint32
+}
+
+// NewListener creates the directory of the files and returns the Listener.
+func NewListener(cfg Config) (*Listener, error) {
+	if cfg.Dir == "" {
+		return nil, errors.New("empty streaming directory")
+	}
+	if cfg.MaxBlocks < 0 || cfg.MaxBytes < 0 {
+		return nil, fmt.Errorf("invalid rotation limits %d blocks and %d bytes", cfg.MaxBlocks, cfg.MaxBytes)
+	}
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &Listener{cfg: cfg}, nil
+}
+
+// ListenFinalizeBlock implements types.ABCIListener. It starts the block of the
+// request, discarding the block which was started and not committed, if any.
+func (l *Listener) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.file == nil {
+		if err := l.openFile(req.Height); err != nil {
+			return err
+		}
+	}
+
+	reqBz, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+	resBz, err := res.Marshal()
+	if err != nil {
+		return err
+	}
+
+	l.height = req.Height
+	l.crc = 0
+	if err := l.write(recordFinalizeBlockRequest, reqBz); err != nil {
+		return err
+	}
+	return l.write(recordFinalizeBlockResponse, resBz)
+}
+
+// ListenCommit implements types.ABCIListener. It completes the block with its
+// change set and its sync marker, and flushes it to the file.
+func (l *Listener) ListenCommit(_ context.Context, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.height == 0 {
+		return errors.New("commit without a finalized block")
+	}
+
+	for _, pair := range changeSet {
+		bz, err := pair.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := l.write(recordStoreKVPair, bz); err != nil {
+			return err
+		}
+	}
+
+	bz, err := res.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := l.write(recordCommitResponse, bz); err != nil {
+		return err
+	}
+	if err := l.write(recordSyncMarker, encodeSyncMarker(l.height, l.crc)); err != nil {
+		return err
+	}
+	l.height = 0
+	l.blocks++
+
+	if err := l.flush(); err != nil {
+		return err
+	}
+
+	if (l.cfg.MaxBlocks > 0 && l.blocks >= l.cfg.MaxBlocks) || (l.cfg.MaxBytes > 0 && l.size.n >= l.cfg.MaxBytes) {
+		return l.closeFile()
+	}
+
+	return nil
+}
+
+// Close closes the current file. A block which was started and not committed
+// is dropped.
+func (l *Listener) Close() error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.file == nil {
+		return nil
+	}
+	return l.closeFile()
+}
+
+func (l *Listener) write(kind byte, payload []byte) error {
+	crc, err := writeRecord(l.buf, l.crc, kind, payload)
+	if err != nil {
+		return err
+	}
+	if kind != recordSyncMarker {
+		l.crc = crc
+	}
+	return nil
+}
+
+// openFile opens the file of the blocks starting at the given height. A file
+// with the same name can only be left by a crash before its first block was
+// synced, so it's truncated.
+func (l *Listener) openFile(height int64) error {
+	path := filepath.Join(l.cfg.Dir, fileName(height, l.cfg.Compress))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	l.file = f
+	l.size = &countingWriter{w: f}
+	var w io.Writer = l.size
+	if l.cfg.Compress {
+		l.gzip = gzip.NewWriter(w)
+		w = l.gzip
+	}
+	l.buf = bufio.NewWriter(w)
+	l.blocks = 0
+
+	return nil
+}
+
+func (l *Listener) flush() error {
+	if err := l.buf.Flush(); err != nil {
+		return err
+	}
+	if l.gzip != nil {
+		if err := l.gzip.Flush(); err != nil {
+			return err
+		}
+	}
+	if l.cfg.Fsync {
+		return l.file.Sync()
+	}
+	return nil
+}
+
+// closeFile closes the current file, only the complete blocks are flushed.
+func (l *Listener) closeFile() error {
+	var errs []error
+	if l.gzip != nil && l.height == 0 {
+		errs = append(errs, l.gzip.Close())
+	}
+	if l.cfg.Fsync {
+		errs = append(errs, l.file.Sync())
+	}
+	errs = append(errs, l.file.Close())
+
+	l.file, l.size, l.gzip, l.buf = nil, nil, nil, nil
+	l.height = 0
+
+	return errors.Join(errs...)
+}
+
+// countingWriter counts the bytes written to a file.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
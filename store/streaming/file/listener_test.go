@@ -0,0 +1,144 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/types"
+)
+
+func testBlock(height int64) *Block {
+	return &Block{
+		Height:   height,
+		Request:  abci.RequestFinalizeBlock{Height: height, Txs: [][]byte{[]byte(fmt.Sprintf("tx%d", height))}},
+		Response: abci.ResponseFinalizeBlock{AppHash: []byte(fmt.Sprintf("hash%d", height))},
+		ChangeSet: []*types.StoreKVPair{
+			{StoreKey: "acc", Key: []byte("key"), Value: []byte(fmt.Sprintf("value%d", height))},
+			{StoreKey: "bank", Delete: true, Key: []byte(fmt.Sprintf("key%d", height))},
+		},
+		Commit: abci.ResponseCommit{RetainHeight: height - 1},
+	}
+}
+
+func listenBlock(t *testing.T, l *Listener, block *Block) {
+	t.Helper()
+	require.NoError(t, l.ListenFinalizeBlock(context.Background(), block.Request, block.Response))
+	require.NoError(t, l.ListenCommit(context.Background(), block.Commit, block.ChangeSet))
+}
+
+func replayAll(t *testing.T, dir string, from int64) []*Block {
+	t.Helper()
+	var blocks []*Block
+	require.NoError(t, Replay(dir, from, func(block *Block) error {
+		blocks = append(blocks, block)
+		return nil
+	}))
+	return blocks
+}
+
+func TestListener(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		t.Run(fmt.Sprintf("compress=%t", compress), func(t *testing.T) {
+			dir := t.TempDir()
+			l, err := NewListener(Config{Dir: dir, MaxBlocks: 3, Compress: compress, Fsync: true})
+			require.NoError(t, err)
+
+			var expected []*Block
+			for height := int64(1); height <= 7; height++ {
+				block := testBlock(height)
+				listenBlock(t, l, block)
+				expected = append(expected, block)
+			}
+
+			// a block which isn't committed before a crash is ignored
+			require.NoError(t, l.ListenFinalizeBlock(context.Background(), testBlock(8).Request, testBlock(8).Response))
+			require.NoError(t, l.Close())
+
+			files, err := Files(dir)
+			require.NoError(t, err)
+			require.Equal(t, []string{
+				filepath.Join(dir, fileName(1, compress)),
+				filepath.Join(dir, fileName(4, compress)),
+				filepath.Join(dir, fileName(7, compress)),
+			}, files)
+			require.Equal(t, expected, replayAll(t, dir, 0))
+			require.Equal(t, expected[4:], replayAll(t, dir, 5))
+
+			// the blocks after a restart go to a new file
+			l, err = NewListener(Config{Dir: dir, MaxBlocks: 3, Compress: compress})
+			require.NoError(t, err)
+			for height := int64(8); height <= 9; height++ {
+				block := testBlock(height)
+				listenBlock(t, l, block)
+				expected = append(expected, block)
+			}
+			require.NoError(t, l.Close())
+			require.Equal(t, expected, replayAll(t, dir, 0))
+		})
+	}
+}
+
+func TestListener_Rotation(t *testing.T) {
+	dir := t.TempDir()
+	l, err := NewListener(Config{Dir: dir, MaxBytes: 1})
+	require.NoError(t, err)
+	for height := int64(1); height <= 3; height++ {
+		listenBlock(t, l, testBlock(height))
+	}
+	require.NoError(t, l.Close())
+
+	files, err := Files(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+
+	_, err = NewListener(Config{})
+	require.Error(t, err)
+	_, err = NewListener(Config{Dir: dir, MaxBlocks: -1})
+	require.Error(t, err)
+
+	l, err = NewListener(Config{Dir: t.TempDir()})
+	require.NoError(t, err)
+	require.Error(t, l.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+}
+
+func TestReader_Torn(t *testing.T) {
+	writeBlocks := func(dir string, n int64) []byte {
+		l, err := NewListener(Config{Dir: dir})
+		require.NoError(t, err)
+		for height := int64(1); height <= n; height++ {
+			listenBlock(t, l, testBlock(height))
+		}
+		require.NoError(t, l.Close())
+
+		bz, err := os.ReadFile(filepath.Join(dir, fileName(1, false)))
+		require.NoError(t, err)
+		return bz
+	}
+	end := len(writeBlocks(t.TempDir(), 1))
+	dir := t.TempDir()
+	bz := writeBlocks(dir, 2)
+	path := filepath.Join(dir, fileName(1, false))
+
+	// a file cut anywhere in the second block only holds the first one
+	for cut := end; cut < len(bz); cut++ {
+		require.NoError(t, os.WriteFile(path, bz[:cut], 0o600))
+		require.Equal(t, []*Block{testBlock(1)}, replayAll(t, dir, 0), "cut at %d", cut)
+	}
+
+	// a corrupted block is skipped
+	corrupted := append([]byte{}, bz...)
+	corrupted[end+10] ^= 0xff
+	require.NoError(t, os.WriteFile(path, corrupted, 0o600))
+	require.Equal(t, []*Block{testBlock(1)}, replayAll(t, dir, 0))
+
+	corrupted = append([]byte{}, bz...)
+	corrupted[5] ^= 0xff
+	require.NoError(t, os.WriteFile(path, corrupted, 0o600))
+	require.Equal(t, []*Block{testBlock(2)}, replayAll(t, dir, 0))
+}
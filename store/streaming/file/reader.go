@@ -0,0 +1,194 @@
+package file
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/store/types"
+)
+
+// Block is a block written by the Listener.
+type Block struct {
+	Height    int64
+	Request   abci.RequestFinalizeBlock
+	Response  abci.ResponseFinalizeBlock
+	ChangeSet []*types.StoreKVPair
+	Commit    abci.ResponseCommit
+}
+
+// Reader reads the blocks of a file written by the Listener.
+type Reader struct {
+	r      *bufio.Reader
+	closer io.Closer
+}
+
+// NewReader returns a Reader of the uncompressed blocks of r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// OpenReader opens a file written by the Listener, which is decompressed if
+// its name has the gzip extension.
+func OpenReader(path string) (*Reader, error) {
+	_, compressed, ok := parseFileName(filepath.Base(path))
+	if !ok {
+		return nil, fmt.Errorf("%s isn't a streaming file", path)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	if !compressed {
+		return &Reader{r: bufio.NewReader(f), closer: f}, nil
+	}
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		// the file is empty or its gzip header is torn
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return NewReader(bytes.NewReader(nil)), nil
+		}
+		return nil, err
+	}
+	return &Reader{r: bufio.NewReader(gz), closer: f}, nil
+}
+
+// Next returns the next complete block. It returns io.EOF once there are no
+// more complete blocks, the records which follow the last sync marker of the
+// file being ignored.
+func (r *Reader) Next() (*Block, error) {
+	var (
+		block   *Block
+		crc     uint32
+		corrupt bool
+	)
+	for {
+		kind, header, payload, err := readRecord(r.r)
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, errTornRecord) {
+				return nil, io.EOF
+			}
+			return nil, err
+		}
+
+		if kind == recordFinalizeBlockRequest {
+			// a block which wasn't committed is followed by the next one
+			block, crc, corrupt = &Block{}, 0, false
+		}
+		if kind == recordSyncMarker {
+			height, markerCRC, err := decodeSyncMarker(payload)
+			if err != nil || block == nil || corrupt || markerCRC != crc || height != block.Height {
+				// the block is incomplete or corrupted
+				block = nil
+				continue
+			}
+			return block, nil
+		}
+		if block == nil {
+			continue
+		}
+
+		crc = crc32.Update(crc, crcTable, header)
+		crc = crc32.Update(crc, crcTable, payload)
+		if err := block.unmarshalRecord(kind, payload); err != nil {
+			corrupt = true
+		}
+	}
+}
+
+// Close closes the file of the Reader, if it was opened by OpenReader.
+func (r *Reader) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+	return r.closer.Close()
+}
+
+func (b *Block) unmarshalRecord(kind byte, payload []byte) error {
+	switch kind {
+	case recordFinalizeBlockRequest:
+		if err := b.Request.Unmarshal(payload); err != nil {
+			return err
+		}
+		b.Height = b.Request.Height
+		return nil
+
+	case recordFinalizeBlockResponse:
+		return b.Response.Unmarshal(payload)
+
+	case recordStoreKVPair:
+		pair := &types.StoreKVPair{}
+		if err := pair.Unmarshal(payload); err != nil {
+			return err
+		}
+		b.ChangeSet = append(b.ChangeSet, pair)
+		return nil
+
+	case recordCommitResponse:
+		return b.Commit.Unmarshal(payload)
+
+	default:
+		return fmt.Errorf("unknown record kind %d", kind)
+	}
+}
+
+// Replay calls fn with the complete blocks of the files of dir from the given
+// height, in file order. A block whose records are corrupted is skipped, and a
+// torn record ends its file, the replay going on with the next file.
+func Replay(dir string, fromHeight int64, fn func(*Block) error) error {
+	paths, err := Files(dir)
+	if err != nil {
+		return err
+	}
+
+	for i, path := range paths {
+		// skip the files whose blocks are all below the height
+		if i+1 < len(paths) {
+			next, _, _ := parseFileName(filepath.Base(paths[i+1]))
+			if next <= fromHeight {
+				continue
+			}
+		}
+
+		if err := replayFile(path, fromHeight, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func replayFile(path string, fromHeight int64, fn func(*Block) error) error {
+	r, err := OpenReader(path)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	for {
+		block, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		if block.Height < fromHeight {
+			continue
+		}
+		if err := fn(block); err != nil {
+			return err
+		}
+	}
+}
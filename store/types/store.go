@@ -224,6 +224,18 @@ type CommitMultiStore interface {
 	SetMetrics(metrics metrics.StoreMetrics)
 }
 
+// ArchivingMultiStore is implemented by the CommitMultiStores which can move
+// their pruned versions to an archive database instead of deleting them.
+type ArchivingMultiStore interface {
+	// SetArchiveDB sets the database to which the pruned versions are moved
+	// instead of being deleted, which keeps them available for queries.
+	SetArchiveDB(db dbm.DB)
+
+	// CloseArchive stops the archiving in progress, if any, before the archive
+	// database is closed.
+	CloseArchive()
+}
+
 //---------subsp-------------------------------
 // KVStore
 
@@ -49,7 +49,7 @@ func txConfig() client.TxConfig {
 	ir := must(codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
 		ProtoFiles: proto.HybridResolver,
 		SigningOptions: signing.Options{
-			AddressCodec:          address.NewTaprootCodec(&sdk.BitcoinNetParams),
+			AddressCodec:          address.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams()),
 			ValidatorAddressCodec: address.NewBech32Codec("cosmosvaloper"),
 		},
 	}))
@@ -7,21 +7,19 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-	"sync"
 	"sync/atomic"
 
-	"github.com/hashicorp/golang-lru/simplelru"
 	"sigs.k8s.io/yaml"
 
 	errorsmod "cosmossdk.io/errors"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/internal/conv"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
-	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 )
 
@@ -36,6 +34,9 @@ const (
 	//	config.SetBech32PrefixForConsensusNode(yourBech32PrefixConsAddr, yourBech32PrefixConsPub)
 	//	config.SetPurpose(yourPurpose)
 	//	config.SetCoinType(yourCoinType)
+	//	config.SetBitcoinNetParams(&chaincfg.TestNet3Params)
+	//	config.SetAddressFormatForAccount(sdk.AddressFormatTaproot)
+	//	config.SetDualAddressMode(true)
 	//	config.Seal()
 
 	// Bech32MainPrefix defines the main SDK Bech32 prefix of an account's address
@@ -84,16 +85,23 @@ const (
 	Bech32PrefixConsPub = Bech32MainPrefix + PrefixValidator + PrefixConsensus + PrefixPublic
 )
 
+// BitcoinNetParams is the Bitcoin network of the SDK config, kept in sync by
+// Config.SetBitcoinNetParams.
+//
+// Deprecated: use GetConfig().GetBitcoinNetParams, or Config.SetBitcoinNetParams
+// and the bitcoin-network option of app.toml and client.toml to select the
+// network. Assigning BitcoinNetParams bypasses the sealing of the config.
+var BitcoinNetParams = chaincfg.MainNetParams
+
 // cache variables
 var (
 	// AccAddress.String() is expensive and if unoptimized dominantly showed up in profiles,
 	// yet has no mechanisms to trivially cache the result given that AccAddress is a []byte type.
-	accAddrMu     sync.Mutex
-	accAddrCache  *simplelru.LRU
-	consAddrMu    sync.Mutex
-	consAddrCache *simplelru.LRU
-	valAddrMu     sync.Mutex
-	valAddrCache  *simplelru.LRU
+	// @nubit: the caches are sharded so that parallel queries and block execution don't
+	// serialize on a single lock, see SetAddrCacheConfig.
+	accAddrCache  atomic.Pointer[addrCache]
+	consAddrCache atomic.Pointer[addrCache]
+	valAddrCache  atomic.Pointer[addrCache]
 
 	isCachingEnabled atomic.Bool
 )
@@ -103,23 +111,16 @@ var (
 	ErrEmptyHexAddress = errors.New("decoding address from hex string failed: empty address")
 )
 
-var (
-	BitcoinNetParams = chaincfg.MainNetParams
-)
-
 func init() {
-	var err error
 	SetAddrCacheEnabled(true)
 
-	// in total the cache size is 61k entries. Key is 32 bytes and value is around 50-70 bytes.
-	// That will make around 92 * 61k * 2 (LRU) bytes ~ 11 MB
-	if accAddrCache, err = simplelru.NewLRU(60000, nil); err != nil {
-		panic(err)
-	}
-	if consAddrCache, err = simplelru.NewLRU(500, nil); err != nil {
-		panic(err)
-	}
-	if valAddrCache, err = simplelru.NewLRU(500, nil); err != nil {
+	// taproot keys sign for the Bitcoin network selected in the SDK config.
+	taproot.SetBitcoinNetParamsFn(func() *chaincfg.Params {
+		return GetConfig().GetBitcoinNetParams()
+	})
+	taproot.BitcoinNetParams = GetConfig().GetBitcoinNetParams()
+
+	if err := SetAddrCacheConfig(DefaultAddrCacheConfig()); err != nil {
 		panic(err)
 	}
 }
@@ -203,29 +204,62 @@ func MustAccAddressFromBech32(address string) AccAddress {
 }
 
 // AccAddressFromBech32 creates an AccAddress from a Bech32 string.
-// @nubit: We replace the implementation of AccAddressFromBech32 with accAddressFromTaproot.
-// But we don't change the name of the function to avoid breaking the compatibility and introducing more changes.
+// @nubit: We replace the implementation of AccAddressFromBech32 to parse the
+// canonical account address format, Taproot by default, and the alternate one
+// in dual address mode. But we don't change the name of the function to avoid
+// breaking the compatibility and introducing more changes.
 func AccAddressFromBech32(address string) (addr AccAddress, err error) {
-	return accAddressFromTaproot(address)
+	config := GetConfig()
+	bz, err := addressBytesFromString(address, config.GetBech32AccountAddrPrefix(), config.GetAccountAddrFormat())
+	if err != nil {
+		return nil, err
+	}
+
+	return AccAddress(bz), nil
 }
 
-// The actual implementation of AccAddressFromTaprootAddress.
-func accAddressFromTaproot(address string) (addr AccAddress, err error) {
-	if address == "" {
+// addressBytesFromString decodes an address string in the canonical format, or
+// in the alternate format in dual address mode.
+func addressBytesFromString(address, bech32Prefix string, format AddressFormat) ([]byte, error) {
+	if len(strings.TrimSpace(address)) == 0 {
 		return nil, errors.New("empty address string is not allowed")
 	}
 
-	parsedAddress, err := btcutil.DecodeAddress(address, &BitcoinNetParams)
+	bz, err := decodeAddressString(address, bech32Prefix, format)
+	if err != nil && GetConfig().IsDualAddressMode() {
+		if alt, altErr := decodeAddressString(address, bech32Prefix, format.Alternate()); altErr == nil {
+			return alt, nil
+		}
+	}
+
+	return bz, err
+}
+
+func decodeAddressString(address, bech32Prefix string, format AddressFormat) ([]byte, error) {
+	if format == AddressFormatTaproot {
+		return addressBytesFromTaproot(address)
+	}
+
+	if bech32Prefix == "" {
+		return nil, errors.New("bech32 prefix is not set")
+	}
+
+	bz, err := GetFromBech32(address, bech32Prefix)
 	if err != nil {
 		return nil, err
 	}
 
-	taprootAddress, ok := parsedAddress.(*btcutil.AddressTaproot)
-	if !ok {
-		return nil, errors.New("address is not a taproot address")
+	if err := VerifyAddressFormat(bz); err != nil {
+		return nil, err
 	}
 
-	return AccAddress(taprootAddress.WitnessProgram()), nil
+	return bz, nil
+}
+
+// addressBytesFromTaproot decodes a P2TR address, or the P2WPKH address of a
+// 20-byte address.
+func addressBytesFromTaproot(address string) ([]byte, error) {
+	return taproot.DecodeSegwitAddress(address, GetConfig().GetBitcoinNetParams())
 }
 
 // Returns boolean for whether two AccAddresses are Equal
@@ -317,29 +351,16 @@ func (aa AccAddress) String() string {
 	if aa.Empty() {
 		return ""
 	}
-	if len(aa.Bytes()) != 32 {
-		panic(fmt.Errorf("invalid address length: %d", len(aa.Bytes())))
-	}
-	return aa.stringTaproot()
-}
-
-func (aa AccAddress) stringTaproot() string {
-	if aa.Empty() {
-		return ""
-	}
-
+	format := GetConfig().GetAccountAddrFormat()
 	key := conv.UnsafeBytesToStr(aa)
 
+	cache := accAddrCache.Load()
 	if IsAddrCacheEnabled() {
-		accAddrMu.Lock()
-		defer accAddrMu.Unlock()
-
-		addr, ok := accAddrCache.Get(key)
-		if ok {
-			return addr.(string)
+		if addr, ok := cache.get(key); ok {
+			return addr
 		}
 	}
-	return cacheTaprootAddr(aa, accAddrCache, key)
+	return cacheAddr(format, GetConfig().GetBech32AccountAddrPrefix(), aa, cache, key)
 }
 
 // Format implements the fmt.Formatter interface.
@@ -369,20 +390,16 @@ func ValAddressFromHex(address string) (addr ValAddress, err error) {
 	return ValAddress(bz), err
 }
 
-// ValAddressFromBech32 creates a ValAddress from a Bech32 string.
+// ValAddressFromBech32 creates a ValAddress from a string in the canonical
+// validator address format, Bech32 by default, or in the alternate format in
+// dual address mode.
 func ValAddressFromBech32(address string) (addr ValAddress, err error) {
 	if len(strings.TrimSpace(address)) == 0 {
 		return ValAddress{}, errors.New("empty address string is not allowed")
 	}
 
-	bech32PrefixValAddr := GetConfig().GetBech32ValidatorAddrPrefix()
-
-	bz, err := GetFromBech32(address, bech32PrefixValAddr)
-	if err != nil {
-		return nil, err
-	}
-
-	err = VerifyAddressFormat(bz)
+	config := GetConfig()
+	bz, err := addressBytesFromString(address, config.GetBech32ValidatorAddrPrefix(), config.GetValidatorAddrFormat())
 	if err != nil {
 		return nil, err
 	}
@@ -494,16 +511,13 @@ func (va ValAddress) String() string {
 
 	key := conv.UnsafeBytesToStr(va)
 
+	cache := valAddrCache.Load()
 	if IsAddrCacheEnabled() {
-		valAddrMu.Lock()
-		defer valAddrMu.Unlock()
-
-		addr, ok := valAddrCache.Get(key)
-		if ok {
-			return addr.(string)
+		if addr, ok := cache.get(key); ok {
+			return addr
 		}
 	}
-	return cacheBech32Addr(GetConfig().GetBech32ValidatorAddrPrefix(), va, valAddrCache, key)
+	return cacheAddr(GetConfig().GetValidatorAddrFormat(), GetConfig().GetBech32ValidatorAddrPrefix(), va, cache, key)
 }
 
 // Format implements the fmt.Formatter interface.
@@ -534,20 +548,16 @@ func ConsAddressFromHex(address string) (addr ConsAddress, err error) {
 	return ConsAddress(bz), err
 }
 
-// ConsAddressFromBech32 creates a ConsAddress from a Bech32 string.
+// ConsAddressFromBech32 creates a ConsAddress from a string in the canonical
+// consensus address format, Bech32 by default, or in the alternate format in
+// dual address mode.
 func ConsAddressFromBech32(address string) (addr ConsAddress, err error) {
 	if len(strings.TrimSpace(address)) == 0 {
 		return ConsAddress{}, errors.New("empty address string is not allowed")
 	}
 
-	bech32PrefixConsAddr := GetConfig().GetBech32ConsensusAddrPrefix()
-
-	bz, err := GetFromBech32(address, bech32PrefixConsAddr)
-	if err != nil {
-		return nil, err
-	}
-
-	err = VerifyAddressFormat(bz)
+	config := GetConfig()
+	bz, err := addressBytesFromString(address, config.GetBech32ConsensusAddrPrefix(), config.GetConsensusAddrFormat())
 	if err != nil {
 		return nil, err
 	}
@@ -654,16 +664,13 @@ func (ca ConsAddress) String() string {
 
 	key := conv.UnsafeBytesToStr(ca)
 
+	cache := consAddrCache.Load()
 	if IsAddrCacheEnabled() {
-		consAddrMu.Lock()
-		defer consAddrMu.Unlock()
-
-		addr, ok := consAddrCache.Get(key)
-		if ok {
-			return addr.(string)
+		if addr, ok := cache.get(key); ok {
+			return addr
 		}
 	}
-	return cacheBech32Addr(GetConfig().GetBech32ConsensusAddrPrefix(), ca, consAddrCache, key)
+	return cacheAddr(GetConfig().GetConsensusAddrFormat(), GetConfig().GetBech32ConsensusAddrPrefix(), ca, cache, key)
 }
 
 // Bech32ifyAddressBytes returns a bech32 representation of address bytes.
@@ -735,27 +742,46 @@ func addressBytesFromHexString(address string) ([]byte, error) {
 	return hex.DecodeString(address)
 }
 
-// cacheBech32Addr is not concurrency safe. Concurrent access to cache causes race condition.
-func cacheBech32Addr(prefix string, addr []byte, cache *simplelru.LRU, cacheKey string) string {
+// cacheBech32Addr encodes addr in bech32 and adds it to cache.
+func cacheBech32Addr(prefix string, addr []byte, cache *addrCache, cacheKey string) string {
 	bech32Addr, err := bech32.ConvertAndEncode(prefix, addr)
 	if err != nil {
 		panic(err)
 	}
 	if IsAddrCacheEnabled() {
-		cache.Add(cacheKey, bech32Addr)
+		cache.add(cacheKey, bech32Addr)
 	}
 	return bech32Addr
 }
 
-// cacheTaprootAddr is not concurrency safe. Concurrent access to cache causes race condition.
-func cacheTaprootAddr(addr []byte, cache *simplelru.LRU, cacheKey string) string {
-	taprootAddr, err := btcutil.NewAddressTaproot(addr, &BitcoinNetParams)
+// cacheAddr encodes addr in format and adds it to cache. The Taproot format
+// only has encodings for 20 and 32-byte addresses, other ones fall back to
+// Bech32, or to hex if bech32Prefix is unset, so that String never panics.
+func cacheAddr(format AddressFormat, bech32Prefix string, addr []byte, cache *addrCache, cacheKey string) string {
+	if format == AddressFormatTaproot {
+		switch {
+		case len(addr) == 20 || len(addr) == 32:
+			return cacheTaprootAddr(addr, cache, cacheKey)
+		case bech32Prefix == "":
+			hexAddr := hex.EncodeToString(addr)
+			if IsAddrCacheEnabled() {
+				cache.add(cacheKey, hexAddr)
+			}
+			return hexAddr
+		}
+	}
+	return cacheBech32Addr(bech32Prefix, addr, cache, cacheKey)
+}
+
+// cacheTaprootAddr encodes addr as a P2TR address, or as a P2WPKH address if it
+// is 20-byte long, and adds it to cache.
+func cacheTaprootAddr(addr []byte, cache *addrCache, cacheKey string) string {
+	taprootAddr, err := taproot.EncodeSegwitAddress(addr, GetConfig().GetBitcoinNetParams())
 	if err != nil {
-		fmt.Printf("creating taproot address from bytes, %x, failed: %v\n", addr, err)
-		panic(err)
+		panic(fmt.Errorf("creating taproot address from bytes, %x, failed: %w", addr, err))
 	}
 	if IsAddrCacheEnabled() {
-		cache.Add(cacheKey, taprootAddr.String())
+		cache.add(cacheKey, taprootAddr)
 	}
-	return taprootAddr.String()
+	return taprootAddr
 }
@@ -0,0 +1,201 @@
+package types
+
+import (
+	"errors"
+	"hash/maphash"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+)
+
+// AddrCacheConfig configures the caches of the address strings returned by
+// AccAddress.String, ValAddress.String and ConsAddress.String.
+type AddrCacheConfig struct {
+	// AccSize, ValSize and ConsSize are the number of entries of each cache.
+	AccSize, ValSize, ConsSize int
+	// Shards is the number of independently locked shards of each cache,
+	// rounded up to a power of two. Caches smaller than Shards use fewer.
+	Shards int
+}
+
+// DefaultAddrCacheConfig returns the default address cache configuration.
+// In total the caches hold 61k entries. Keys are 32 bytes and values around
+// 50-70 bytes, which makes around 92 * 61k * 2 (LRU) bytes ~ 11 MB.
+func DefaultAddrCacheConfig() AddrCacheConfig {
+	return AddrCacheConfig{
+		AccSize:  60000,
+		ValSize:  500,
+		ConsSize: 500,
+		Shards:   64,
+	}
+}
+
+// SetAddrCacheConfig replaces the address caches by empty caches of the given
+// configuration, resetting their statistics.
+func SetAddrCacheConfig(cfg AddrCacheConfig) error {
+	if cfg.AccSize <= 0 || cfg.ValSize <= 0 || cfg.ConsSize <= 0 {
+		return errors.New("address cache sizes must be positive")
+	}
+	if cfg.Shards <= 0 {
+		return errors.New("address cache shards must be positive")
+	}
+
+	accAddrCache.Store(newAddrCache(cfg.AccSize, cfg.Shards))
+	valAddrCache.Store(newAddrCache(cfg.ValSize, cfg.Shards))
+	consAddrCache.Store(newAddrCache(cfg.ConsSize, cfg.Shards))
+
+	return nil
+}
+
+// purgeAddrCaches empties the address caches, keeping their statistics.
+func purgeAddrCaches() {
+	for _, cache := range []*atomic.Pointer[addrCache]{&accAddrCache, &valAddrCache, &consAddrCache} {
+		if c := cache.Load(); c != nil {
+			c.purge()
+		}
+	}
+}
+
+// AddrCacheStats are the statistics of an address cache since its creation.
+type AddrCacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	// Len is the number of cached entries, out of Capacity.
+	Len      int
+	Capacity int
+}
+
+// HitRate returns the ratio of lookups served by the cache.
+func (s AddrCacheStats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// AccAddrCacheStats returns the statistics of the AccAddress cache.
+func AccAddrCacheStats() AddrCacheStats {
+	return accAddrCache.Load().stats()
+}
+
+// ValAddrCacheStats returns the statistics of the ValAddress cache.
+func ValAddrCacheStats() AddrCacheStats {
+	return valAddrCache.Load().stats()
+}
+
+// ConsAddrCacheStats returns the statistics of the ConsAddress cache.
+func ConsAddrCacheStats() AddrCacheStats {
+	return consAddrCache.Load().stats()
+}
+
+// addrCache is a concurrency safe LRU cache of address strings. Its entries
+// are striped over shards by the hash of their key, so that concurrent lookups
+// of different addresses rarely contend on the same lock.
+type addrCache struct {
+	seed     maphash.Seed
+	shards   []addrCacheShard
+	mask     uint64
+	capacity int
+}
+
+// addrCacheShard is a shard of an addrCache. Its statistics are guarded by its
+// lock, so that lookups don't contend on shared counters.
+type addrCacheShard struct {
+	mu        sync.Mutex
+	lru       *simplelru.LRU
+	hits      uint64
+	misses    uint64
+	evictions uint64
+	// pad shards to separate cache lines to avoid false sharing of the locks
+	_ [24]byte
+}
+
+func newAddrCache(size, shards int) *addrCache {
+	n := 1
+	for n < shards && n*2 <= size {
+		n *= 2
+	}
+
+	c := &addrCache{
+		seed:     maphash.MakeSeed(),
+		shards:   make([]addrCacheShard, n),
+		mask:     uint64(n - 1),
+		capacity: size,
+	}
+
+	for i := range c.shards {
+		shard := &c.shards[i]
+		// the first shards take the remainder of the size
+		shardSize := size / n
+		if i < size%n {
+			shardSize++
+		}
+
+		lru, err := simplelru.NewLRU(shardSize, func(_, _ any) { shard.evictions++ })
+		if err != nil {
+			panic(err)
+		}
+		shard.lru = lru
+	}
+
+	return c
+}
+
+func (c *addrCache) shard(key string) *addrCacheShard {
+	return &c.shards[maphash.String(c.seed, key)&c.mask]
+}
+
+func (c *addrCache) get(key string) (string, bool) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	addr, ok := s.lru.Get(key)
+	if !ok {
+		s.misses++
+		return "", false
+	}
+
+	s.hits++
+	return addr.(string), true
+}
+
+func (c *addrCache) add(key, addr string) {
+	// keys usually alias the bytes of the address, which the caller may reuse
+	key = strings.Clone(key)
+
+	s := c.shard(key)
+	s.mu.Lock()
+	s.lru.Add(key, addr)
+	s.mu.Unlock()
+}
+
+func (c *addrCache) purge() {
+	for i := range c.shards {
+		s := &c.shards[i]
+		s.mu.Lock()
+		// purged entries aren't evictions
+		evictions := s.evictions
+		s.lru.Purge()
+		s.evictions = evictions
+		s.mu.Unlock()
+	}
+}
+
+func (c *addrCache) stats() AddrCacheStats {
+	stats := AddrCacheStats{Capacity: c.capacity}
+	for i := range c.shards {
+		s := &c.shards[i]
+		s.mu.Lock()
+		stats.Hits += s.hits
+		stats.Misses += s.misses
+		stats.Evictions += s.evictions
+		stats.Len += s.lru.Len()
+		s.mu.Unlock()
+	}
+
+	return stats
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"encoding/binary"
+	"fmt"
 	"testing"
 	"time"
 
@@ -10,10 +11,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/types"
 )
 
+// testAddress returns the 32-byte address with prefix and i as its first bytes.
+func testAddress(prefix byte, i uint32) types.AccAddress {
+	bz := make([]byte, 32)
+	bz[0] = prefix
+	binary.BigEndian.PutUint32(bz[1:], i)
+	return bz
+}
+
 // generates AccAddress with `prefix` and calls String method
 func addressStringCaller(require *require.Assertions, prefix byte, max uint32, cancel chan bool, done chan<- bool) {
-	bz := make([]byte, 5) // prefix + 4 bytes for uint
-	bz[0] = prefix
 	for i := uint32(0); ; i++ {
 		if i >= max {
 			i = 0
@@ -23,8 +30,7 @@ func addressStringCaller(require *require.Assertions, prefix byte, max uint32, c
 			done <- true
 			return
 		default:
-			binary.BigEndian.PutUint32(bz[1:], i)
-			str := types.AccAddress(bz).String()
+			str := testAddress(prefix, i).String()
 			require.True(str != "")
 		}
 
@@ -56,3 +62,74 @@ func (s *addressTestSuite) TestAddressRace() {
 		<-done
 	}
 }
+
+func TestAddrCacheStats(t *testing.T) {
+	t.Cleanup(func() {
+		require.NoError(t, types.SetAddrCacheConfig(types.DefaultAddrCacheConfig()))
+	})
+	types.SetAddrCacheEnabled(true)
+
+	require.Error(t, types.SetAddrCacheConfig(types.AddrCacheConfig{AccSize: 0, ValSize: 1, ConsSize: 1, Shards: 1}))
+	require.Error(t, types.SetAddrCacheConfig(types.AddrCacheConfig{AccSize: 1, ValSize: 1, ConsSize: 1, Shards: 0}))
+	require.NoError(t, types.SetAddrCacheConfig(types.AddrCacheConfig{AccSize: 4, ValSize: 4, ConsSize: 4, Shards: 1}))
+
+	for i := uint32(0); i < 4; i++ {
+		addr := testAddress(3, i)
+		require.Equal(t, addr.String(), addr.String())
+	}
+	stats := types.AccAddrCacheStats()
+	require.Equal(t, types.AddrCacheStats{Hits: 4, Misses: 4, Len: 4, Capacity: 4}, stats)
+	require.Equal(t, 0.5, stats.HitRate())
+
+	// a fifth address evicts the least recently used one
+	_ = testAddress(3, 4).String()
+	_ = testAddress(3, 0).String()
+	stats = types.AccAddrCacheStats()
+	require.Equal(t, uint64(2), stats.Evictions)
+	require.Equal(t, uint64(6), stats.Misses)
+	require.Equal(t, 4, stats.Len)
+
+	// the other caches are independent
+	require.Equal(t, types.AddrCacheStats{Capacity: 4}, types.ValAddrCacheStats())
+	require.Equal(t, types.AddrCacheStats{Capacity: 4}, types.ConsAddrCacheStats())
+	require.Zero(t, types.ValAddrCacheStats().HitRate())
+}
+
+// BenchmarkAddrCacheParallel measures the throughput of AccAddress.String under
+// parallel load, with a single lock as before the cache was sharded, and with
+// the default shards. Run it with -race -cpu to compare them under contention.
+func BenchmarkAddrCacheParallel(b *testing.B) {
+	const addresses = 4096
+
+	defer func() {
+		require.NoError(b, types.SetAddrCacheConfig(types.DefaultAddrCacheConfig()))
+	}()
+	types.SetAddrCacheEnabled(true)
+
+	for _, shards := range []int{1, types.DefaultAddrCacheConfig().Shards} {
+		b.Run(fmt.Sprintf("shards=%d", shards), func(b *testing.B) {
+			cfg := types.DefaultAddrCacheConfig()
+			cfg.Shards = shards
+			require.NoError(b, types.SetAddrCacheConfig(cfg))
+
+			addrs := make([]types.AccAddress, addresses)
+			for i := range addrs {
+				addrs[i] = testAddress(4, uint32(i))
+				_ = addrs[i].String()
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for i := 0; pb.Next(); i++ {
+					if addrs[i%addresses].String() == "" {
+						b.Fatal("empty address")
+					}
+				}
+			})
+			b.StopTimer()
+
+			b.ReportMetric(types.AccAddrCacheStats().HitRate(), "hit-rate")
+		})
+	}
+}
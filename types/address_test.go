@@ -203,6 +203,125 @@ func (s *addressTestSuite) TestAddrCacheDisabled() {
 	s.Require().True(strings.HasPrefix(cosmosAddrBech32, "cosmos"))
 }
 
+func (s *addressTestSuite) TestDualAddressMode() {
+	conf := types.GetConfig()
+	prevPrefix, prevPubPrefix := conf.GetBech32AccountAddrPrefix(), conf.GetBech32AccountPubPrefix()
+	prevDual := conf.IsDualAddressMode()
+	conf.SetBech32PrefixForAccount("cosmos", "cosmospub")
+	conf.SetDualAddressMode(true)
+	defer func() {
+		conf.SetBech32PrefixForAccount(prevPrefix, prevPubPrefix)
+		conf.SetDualAddressMode(prevDual)
+	}()
+
+	bz := make([]byte, 32)
+	_, err := rand.Read(bz)
+	s.Require().NoError(err)
+	acc := types.AccAddress(bz)
+
+	taprootStr := acc.String()
+	s.Require().True(strings.HasPrefix(taprootStr, "bc1p"))
+	bech32Str, err := types.Bech32ifyAddressBytes("cosmos", bz)
+	s.Require().NoError(err)
+
+	// both encodings of the same bytes are accepted, the canonical one is rendered
+	for _, str := range []string{taprootStr, bech32Str} {
+		res, err := types.AccAddressFromBech32(str)
+		s.Require().NoError(err)
+		s.Require().Equal(acc, res)
+		s.Require().Equal(taprootStr, res.String())
+
+		var unmarshaled types.AccAddress
+		s.Require().NoError(unmarshaled.UnmarshalJSON([]byte(`"` + str + `"`)))
+		s.Require().Equal(acc, unmarshaled)
+	}
+
+	// a bech32 string of another chain is still rejected
+	osmoStr, err := types.Bech32ifyAddressBytes("osmo", bz)
+	s.Require().NoError(err)
+	_, err = types.AccAddressFromBech32(osmoStr)
+	s.Require().Error(err)
+
+	// validator addresses also accept their taproot encoding
+	val, err := types.ValAddressFromBech32(taprootStr)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ValAddress(bz), val)
+
+	conf.SetDualAddressMode(false)
+	_, err = types.AccAddressFromBech32(bech32Str)
+	s.Require().Error(err)
+	_, err = types.AccAddressFromBech32(taprootStr)
+	s.Require().NoError(err)
+	_, err = types.ValAddressFromBech32(taprootStr)
+	s.Require().Error(err)
+}
+
+func (s *addressTestSuite) TestSegwitAddressLengths() {
+	conf := types.GetConfig()
+	prevVal, prevCons := conf.GetValidatorAddrFormat(), conf.GetConsensusAddrFormat()
+	conf.SetAddressFormatForValidator(types.AddressFormatTaproot)
+	conf.SetAddressFormatForConsensusNode(types.AddressFormatTaproot)
+	defer func() {
+		conf.SetAddressFormatForValidator(prevVal)
+		conf.SetAddressFormatForConsensusNode(prevCons)
+	}()
+
+	for _, tc := range []struct {
+		size   int
+		prefix string
+	}{
+		{20, "bc1q"},
+		{32, "bc1p"},
+	} {
+		bz := make([]byte, tc.size)
+		_, err := rand.Read(bz)
+		s.Require().NoError(err)
+
+		acc := types.AccAddress(bz)
+		s.Require().True(strings.HasPrefix(acc.String(), tc.prefix), acc.String())
+		resAcc, err := types.AccAddressFromBech32(acc.String())
+		s.Require().NoError(err)
+		s.Require().Equal(acc, resAcc)
+		s.testMarshal(&acc, &resAcc, acc.MarshalJSON, (&resAcc).UnmarshalJSON)
+
+		val := types.ValAddress(bz)
+		s.Require().True(strings.HasPrefix(val.String(), tc.prefix), val.String())
+		resVal, err := types.ValAddressFromBech32(val.String())
+		s.Require().NoError(err)
+		s.Require().Equal(val, resVal)
+
+		cons := types.ConsAddress(bz)
+		s.Require().True(strings.HasPrefix(cons.String(), tc.prefix), cons.String())
+		resCons, err := types.ConsAddressFromBech32(cons.String())
+		s.Require().NoError(err)
+		s.Require().Equal(cons, resCons)
+	}
+
+	// the secp256k1 and ed25519 keys have 20-byte addresses
+	for _, pubKey := range []cryptotypes.PubKey{secp256k1.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey()} {
+		s.Require().True(strings.HasPrefix(types.AccAddress(pubKey.Address()).String(), "bc1q"))
+	}
+
+	// a P2WSH address would be rendered back as a P2TR address
+	_, err := types.AccAddressFromBech32("bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3")
+	s.Require().Error(err)
+
+	// addresses without a SegWit encoding fall back to Bech32, or to hex
+	// without a Bech32 prefix
+	bz := make([]byte, 21)
+	_, err = rand.Read(bz)
+	s.Require().NoError(err)
+	val := types.ValAddress(bz)
+	s.Require().True(strings.HasPrefix(val.String(), conf.GetBech32ValidatorAddrPrefix()+"1"), val.String())
+	resVal, err := types.ValAddressFromBech32(val.String())
+	s.Require().NoError(err)
+	s.Require().Equal(val, resVal)
+	s.Require().NotPanics(func() { _ = types.AccAddress(bz).String() })
+	if conf.GetBech32AccountAddrPrefix() == "" {
+		s.Require().Equal(hex.EncodeToString(bz), types.AccAddress(bz).String())
+	}
+}
+
 func (s *addressTestSuite) TestValAddr() {
 	pubBz := make([]byte, ed25519.PubKeySize)
 	pub := &ed25519.PubKey{Key: pubBz}
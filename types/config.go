@@ -5,12 +5,67 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/btcsuite/btcd/chaincfg"
+
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
 // DefaultKeyringServiceName defines a default service name for the keyring.
 const DefaultKeyringServiceName = "cosmos"
 
+// AddressFormat is the string encoding of addresses.
+type AddressFormat string
+
+const (
+	// AddressFormatTaproot encodes 32-byte addresses as Taproot (P2TR)
+	// addresses of the configured Bitcoin network, e.g. bc1p...
+	AddressFormatTaproot AddressFormat = "taproot"
+	// AddressFormatBech32 encodes addresses with Bech32 and the configured
+	// prefix, e.g. cosmos1...
+	AddressFormatBech32 AddressFormat = "bech32"
+)
+
+// ParseAddressFormat parses the name of an AddressFormat.
+func ParseAddressFormat(s string) (AddressFormat, error) {
+	switch format := AddressFormat(s); format {
+	case AddressFormatTaproot, AddressFormatBech32:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown address format %q, expected %q or %q", s, AddressFormatTaproot, AddressFormatBech32)
+	}
+}
+
+// bitcoinNetworks are the Bitcoin networks selectable by name.
+var bitcoinNetworks = []*chaincfg.Params{
+	&chaincfg.MainNetParams,
+	&chaincfg.TestNet3Params,
+	&chaincfg.SigNetParams,
+	&chaincfg.RegressionNetParams,
+	&chaincfg.SimNetParams,
+}
+
+// ParseBitcoinNetwork returns the parameters of a Bitcoin network from its
+// name: mainnet, testnet3, signet, regtest or simnet.
+func ParseBitcoinNetwork(name string) (*chaincfg.Params, error) {
+	names := make([]string, 0, len(bitcoinNetworks))
+	for _, params := range bitcoinNetworks {
+		if params.Name == name {
+			return params, nil
+		}
+		names = append(names, params.Name)
+	}
+	return nil, fmt.Errorf("unknown bitcoin network %q, expected one of %v", name, names)
+}
+
+// Alternate returns the other address format, which is also accepted in dual
+// address mode.
+func (f AddressFormat) Alternate() AddressFormat {
+	if f == AddressFormatTaproot {
+		return AddressFormatBech32
+	}
+	return AddressFormatTaproot
+}
+
 // Config is the structure that holds the SDK configuration parameters.
 // This could be used to initialize certain configuration parameters for the SDK.
 type Config struct {
@@ -18,6 +73,9 @@ type Config struct {
 	bech32AddressPrefix map[string]string
 	txEncoder           TxEncoder
 	addressVerifier     func([]byte) error
+	bitcoinNetParams    *chaincfg.Params
+	addressFormat       map[string]AddressFormat
+	dualAddressMode     bool
 	mtx                 sync.RWMutex
 
 	// SLIP-44 related
@@ -36,6 +94,7 @@ var (
 
 // New returns a new Config with default values.
 func NewConfig() *Config {
+	bitcoinNetParams := chaincfg.MainNetParams
 	return &Config{
 		sealedch: make(chan struct{}),
 		bech32AddressPrefix: map[string]string{
@@ -47,6 +106,15 @@ func NewConfig() *Config {
 			"consensus_pub":  Bech32PrefixConsPub,
 		},
 		fullFundraiserPath: FullFundraiserPath,
+		bitcoinNetParams:   &bitcoinNetParams,
+		// @nubit: accounts are rendered as Taproot addresses. The chains which
+		// still accept the Bech32 encoding of the same bytes opt in with
+		// SetDualAddressMode.
+		addressFormat: map[string]AddressFormat{
+			"account_addr":   AddressFormatTaproot,
+			"validator_addr": AddressFormatBech32,
+			"consensus_addr": AddressFormatBech32,
+		},
 
 		purpose:   Purpose,
 		coinType:  CoinType,
@@ -58,6 +126,8 @@ func NewConfig() *Config {
 func GetConfig() *Config {
 	initConfig.Do(func() {
 		sdkConfig = NewConfig()
+		// the deprecated BitcoinNetParams holds the network of the SDK config
+		sdkConfig.bitcoinNetParams = &BitcoinNetParams
 	})
 	return sdkConfig
 }
@@ -120,6 +190,82 @@ func (config *Config) SetAddressVerifier(addressVerifier func([]byte) error) {
 	config.addressVerifier = addressVerifier
 }
 
+// SetBitcoinNetParams builds the Config with the Bitcoin network used to encode
+// and decode Taproot addresses (e.g. bc1p, tb1p or bcrt1p) and to bind BIP-322
+// signatures of taproot keys to their address.
+//
+// The network is copied into the one returned by GetBitcoinNetParams, so that
+// address codecs built before, e.g. while the root command is constructed,
+// follow it. The address caches are emptied, as they may hold addresses of the
+// previous network.
+func (config *Config) SetBitcoinNetParams(params *chaincfg.Params) {
+	config.assertNotSealed()
+	if params == nil {
+		panic("bitcoin network params cannot be nil")
+	}
+	*config.bitcoinNetParams = *params
+	purgeAddrCaches()
+}
+
+// SetBitcoinNetwork builds the Config with the Bitcoin network of the given
+// name, as set by the bitcoin-network option of app.toml and client.toml.
+// Once the Config is sealed it only accepts the network it already uses, so
+// that the node and its CLI can't silently disagree on which addresses and
+// signatures are valid.
+func (config *Config) SetBitcoinNetwork(name string) error {
+	params, err := ParseBitcoinNetwork(name)
+	if err != nil {
+		return err
+	}
+
+	config.mtx.RLock()
+	sealed := config.sealed
+	config.mtx.RUnlock()
+	if sealed {
+		if current := config.GetBitcoinNetParams().Name; current != params.Name {
+			return fmt.Errorf("cannot use bitcoin network %q, the sealed config uses %q", params.Name, current)
+		}
+		return nil
+	}
+
+	config.SetBitcoinNetParams(params)
+	return nil
+}
+
+// SetAddressFormatForAccount builds the Config with the canonical string
+// encoding of account addresses.
+func (config *Config) SetAddressFormatForAccount(format AddressFormat) {
+	config.setAddressFormat("account_addr", format)
+}
+
+// SetAddressFormatForValidator builds the Config with the canonical string
+// encoding of validator operator addresses.
+func (config *Config) SetAddressFormatForValidator(format AddressFormat) {
+	config.setAddressFormat("validator_addr", format)
+}
+
+// SetAddressFormatForConsensusNode builds the Config with the canonical string
+// encoding of consensus node addresses.
+func (config *Config) SetAddressFormatForConsensusNode(format AddressFormat) {
+	config.setAddressFormat("consensus_addr", format)
+}
+
+func (config *Config) setAddressFormat(key string, format AddressFormat) {
+	config.assertNotSealed()
+	if _, err := ParseAddressFormat(string(format)); err != nil {
+		panic(err)
+	}
+	config.addressFormat[key] = format
+}
+
+// SetDualAddressMode builds the Config accepting, or not, address strings in
+// the alternate format of their canonical one. It's disabled by default.
+// Addresses are always rendered in their canonical format.
+func (config *Config) SetDualAddressMode(enabled bool) {
+	config.assertNotSealed()
+	config.dualAddressMode = enabled
+}
+
 // Set the FullFundraiserPath (BIP44Prefix) on the config.
 //
 // Deprecated: This method is supported for backward compatibility only and will be removed in a future release. Use SetPurpose and SetCoinType instead.
@@ -197,6 +343,34 @@ func (config *Config) GetAddressVerifier() func([]byte) error {
 	return config.addressVerifier
 }
 
+// GetBitcoinNetParams returns the Bitcoin network used for Taproot addresses.
+func (config *Config) GetBitcoinNetParams() *chaincfg.Params {
+	return config.bitcoinNetParams
+}
+
+// GetAccountAddrFormat returns the canonical format of account addresses.
+func (config *Config) GetAccountAddrFormat() AddressFormat {
+	return config.addressFormat["account_addr"]
+}
+
+// GetValidatorAddrFormat returns the canonical format of validator operator
+// addresses.
+func (config *Config) GetValidatorAddrFormat() AddressFormat {
+	return config.addressFormat["validator_addr"]
+}
+
+// GetConsensusAddrFormat returns the canonical format of consensus node
+// addresses.
+func (config *Config) GetConsensusAddrFormat() AddressFormat {
+	return config.addressFormat["consensus_addr"]
+}
+
+// IsDualAddressMode returns whether address strings are also accepted in the
+// alternate format of their canonical one.
+func (config *Config) IsDualAddressMode() bool {
+	return config.dualAddressMode
+}
+
 // GetPurpose returns the BIP-0044 Purpose code on the config.
 func (config *Config) GetPurpose() uint32 {
 	return config.purpose
@@ -4,8 +4,10 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -65,6 +67,95 @@ func (s *configTestSuite) TestConfig_SetFullFundraiserPath() {
 	s.Require().Panics(func() { config.SetFullFundraiserPath("x/test/path") })
 }
 
+func (s *configTestSuite) TestConfig_SetBitcoinNetParams() {
+	config := sdk.NewConfig()
+	s.Require().Equal(&chaincfg.MainNetParams, config.GetBitcoinNetParams())
+
+	config.SetBitcoinNetParams(&chaincfg.RegressionNetParams)
+	s.Require().Equal(&chaincfg.RegressionNetParams, config.GetBitcoinNetParams())
+	s.Require().Panics(func() { config.SetBitcoinNetParams(nil) })
+
+	config.Seal()
+	s.Require().Panics(func() { config.SetBitcoinNetParams(&chaincfg.TestNet3Params) })
+}
+
+func (s *configTestSuite) TestConfig_SetBitcoinNetwork() {
+	config := sdk.NewConfig()
+	params := config.GetBitcoinNetParams()
+
+	s.Require().Error(config.SetBitcoinNetwork("unknown"))
+	s.Require().NoError(config.SetBitcoinNetwork("regtest"))
+	s.Require().Equal(&chaincfg.RegressionNetParams, config.GetBitcoinNetParams())
+	// codecs holding the network follow its updates
+	s.Require().Equal("bcrt", params.Bech32HRPSegwit)
+
+	config.Seal()
+	s.Require().NoError(config.SetBitcoinNetwork("regtest"))
+	s.Require().Error(config.SetBitcoinNetwork("testnet3"))
+	s.Require().Equal(&chaincfg.RegressionNetParams, config.GetBitcoinNetParams())
+}
+
+func (s *configTestSuite) TestParseBitcoinNetwork() {
+	for _, params := range []*chaincfg.Params{
+		&chaincfg.MainNetParams,
+		&chaincfg.TestNet3Params,
+		&chaincfg.SigNetParams,
+		&chaincfg.RegressionNetParams,
+		&chaincfg.SimNetParams,
+	} {
+		parsed, err := sdk.ParseBitcoinNetwork(params.Name)
+		s.Require().NoError(err)
+		s.Require().Same(params, parsed)
+	}
+
+	_, err := sdk.ParseBitcoinNetwork("testnet")
+	s.Require().Error(err)
+}
+
+func (s *configTestSuite) TestBitcoinNetParamsDeprecated() {
+	//nolint:staticcheck // the deprecated variable holds the network of the SDK config
+	s.Require().Same(&sdk.BitcoinNetParams, sdk.GetConfig().GetBitcoinNetParams())
+	//nolint:staticcheck // the deprecated variable holds the network of the SDK config
+	s.Require().Same(taproot.BitcoinNetParams, sdk.GetConfig().GetBitcoinNetParams())
+}
+
+func (s *configTestSuite) TestConfig_SetAddressFormat() {
+	config := sdk.NewConfig()
+	s.Require().Equal(sdk.AddressFormatTaproot, config.GetAccountAddrFormat())
+	s.Require().Equal(sdk.AddressFormatBech32, config.GetValidatorAddrFormat())
+	s.Require().Equal(sdk.AddressFormatBech32, config.GetConsensusAddrFormat())
+	s.Require().False(config.IsDualAddressMode())
+
+	config.SetAddressFormatForAccount(sdk.AddressFormatBech32)
+	config.SetAddressFormatForValidator(sdk.AddressFormatTaproot)
+	config.SetAddressFormatForConsensusNode(sdk.AddressFormatTaproot)
+	config.SetDualAddressMode(true)
+	s.Require().Equal(sdk.AddressFormatBech32, config.GetAccountAddrFormat())
+	s.Require().Equal(sdk.AddressFormatTaproot, config.GetValidatorAddrFormat())
+	s.Require().Equal(sdk.AddressFormatTaproot, config.GetConsensusAddrFormat())
+	s.Require().True(config.IsDualAddressMode())
+	s.Require().Panics(func() { config.SetAddressFormatForAccount("hex") })
+
+	config.Seal()
+	s.Require().Panics(func() { config.SetAddressFormatForAccount(sdk.AddressFormatTaproot) })
+	s.Require().Panics(func() { config.SetDualAddressMode(false) })
+}
+
+func (s *configTestSuite) TestParseAddressFormat() {
+	format, err := sdk.ParseAddressFormat("taproot")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.AddressFormatTaproot, format)
+	s.Require().Equal(sdk.AddressFormatBech32, format.Alternate())
+
+	format, err = sdk.ParseAddressFormat("bech32")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.AddressFormatBech32, format)
+	s.Require().Equal(sdk.AddressFormatTaproot, format.Alternate())
+
+	_, err = sdk.ParseAddressFormat("hex")
+	s.Require().Error(err)
+}
+
 func (s *configTestSuite) TestKeyringServiceName() {
 	s.Require().Equal(sdk.DefaultKeyringServiceName, sdk.KeyringServiceName())
 }
@@ -3,4 +3,10 @@ package grpc
 const (
 	// GRPCBlockHeightHeader is the gRPC header for block height.
 	GRPCBlockHeightHeader = "x-cosmos-block-height"
+	// GRPCQueryProveHeader is the gRPC header requesting the proofs of the
+	// state read by a query.
+	GRPCQueryProveHeader = "x-cosmos-query-prove"
+	// GRPCQueryProofsTrailer is the binary gRPC trailer for the proofs of the
+	// state read by a query, one encoded abci.ResponseQuery per value.
+	GRPCQueryProofsTrailer = "x-cosmos-query-proofs-bin"
 )
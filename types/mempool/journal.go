@@ -0,0 +1,338 @@
+package mempool
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"sort"
+	"sync"
+)
+
+const (
+	journalOpInsert byte = iota + 1
+	journalOpRemove
+
+	// journalCompactMinRecords is the minimum number of records of a journal
+	// before it's compacted, when it holds more than twice as many records as
+	// transactions.
+	journalCompactMinRecords = 1024
+)
+
+// Journal is a write-ahead journal of the transactions accepted in a mempool
+// and of their removals, used to restore the mempool after a restart.
+//
+// The journal is a file of records, each made of an operation byte, the
+// uvarint length of its payload, the payload and the CRC-32 of the preceding
+// bytes. The payload of an insertion is the transaction bytes and the one of a
+// removal their SHA-256 hash. The records are written to the file as they are
+// appended but not synced, so that they survive a crash of the process; a torn
+// record at the end of the file is ignored. The journal is compacted, keeping
+// only the insertions of the transactions it holds, when it's opened and when
+// it grows to more than twice as many records as transactions.
+type Journal struct {
+	mtx  sync.Mutex
+	path string
+	file *os.File
+
+	// txs are the transactions of the journal by hash, with their insertion
+	// order.
+	txs     map[[sha256.Size]byte]journalTx
+	seq     uint64
+	records int
+}
+
+type journalTx struct {
+	seq     uint64
+	txBytes []byte
+}
+
+// OpenJournal opens the journal at path, creating it if it doesn't exist, and
+// reads its transactions.
+func OpenJournal(path string) (*Journal, error) {
+	j := &Journal{
+		path: path,
+		txs:  make(map[[sha256.Size]byte]journalTx),
+	}
+
+	f, err := os.Open(path)
+	switch {
+	case err == nil:
+		err = j.read(f)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read mempool journal %s: %w", path, err)
+		}
+
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, err
+	}
+
+	if err := j.compact(); err != nil {
+		return nil, err
+	}
+
+	return j, nil
+}
+
+// read reads the records of r, stopping at the first torn or corrupted one.
+func (j *Journal) read(r io.Reader) error {
+	br := bufio.NewReader(r)
+	for {
+		op, payload, err := readJournalRecord(br)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, errCorruptedJournalRecord) {
+			// the last record was torn by a crash
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch op {
+		case journalOpInsert:
+			if hash := sha256.Sum256(payload); !j.has(hash) {
+				j.insert(hash, payload)
+			}
+		case journalOpRemove:
+			var hash [sha256.Size]byte
+			copy(hash[:], payload)
+			delete(j.txs, hash)
+		}
+	}
+}
+
+var errCorruptedJournalRecord = errors.New("corrupted mempool journal record")
+
+// maxJournalPayload bounds the payload of a record, so that a corrupted length
+// doesn't allocate an arbitrary amount of memory.
+const maxJournalPayload = 64 << 20
+
+func readJournalRecord(r *bufio.Reader) (op byte, payload []byte, err error) {
+	op, err = r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	size, err := binary.ReadUvarint(r)
+	if errors.Is(err, io.EOF) {
+		return 0, nil, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return 0, nil, errCorruptedJournalRecord
+	}
+	if size > maxJournalPayload ||
+		(op == journalOpRemove && size != sha256.Size) ||
+		(op != journalOpInsert && op != journalOpRemove) {
+		return 0, nil, errCorruptedJournalRecord
+	}
+
+	payload = make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, io.ErrUnexpectedEOF
+	}
+
+	var checksum [4]byte
+	if _, err := io.ReadFull(r, checksum[:]); err != nil {
+		return 0, nil, io.ErrUnexpectedEOF
+	}
+	if record := journalRecord(op, payload); !bytes.Equal(record[len(record)-4:], checksum[:]) {
+		return 0, nil, errCorruptedJournalRecord
+	}
+
+	return op, payload, nil
+}
+
+// journalRecord returns the encoding of a record.
+func journalRecord(op byte, payload []byte) []byte {
+	record := make([]byte, 0, 1+binary.MaxVarintLen64+len(payload)+4)
+	record = append(record, op)
+	record = binary.AppendUvarint(record, uint64(len(payload)))
+	record = append(record, payload...)
+
+	return binary.BigEndian.AppendUint32(record, crc32.ChecksumIEEE(record))
+}
+
+func (j *Journal) has(hash [sha256.Size]byte) bool {
+	_, ok := j.txs[hash]
+	return ok
+}
+
+func (j *Journal) insert(hash [sha256.Size]byte, txBytes []byte) {
+	j.seq++
+	j.txs[hash] = journalTx{seq: j.seq, txBytes: txBytes}
+}
+
+// Insert records the insertion of a transaction, if it isn't in the journal.
+func (j *Journal) Insert(txBytes []byte) error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	hash := sha256.Sum256(txBytes)
+	if j.has(hash) {
+		return nil
+	}
+
+	txBytes = bytes.Clone(txBytes)
+	if err := j.append(journalOpInsert, txBytes); err != nil {
+		return err
+	}
+	j.insert(hash, txBytes)
+
+	return j.compactIfNeeded()
+}
+
+// Remove records the removal of a transaction, if it is in the journal.
+func (j *Journal) Remove(txBytes []byte) error {
+	return j.RemoveHash(sha256.Sum256(txBytes))
+}
+
+// RemoveHash records the removal of the transaction with the given SHA-256
+// hash, if it is in the journal.
+func (j *Journal) RemoveHash(hash [sha256.Size]byte) error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	if !j.has(hash) {
+		return nil
+	}
+
+	if err := j.append(journalOpRemove, hash[:]); err != nil {
+		return err
+	}
+	delete(j.txs, hash)
+
+	return j.compactIfNeeded()
+}
+
+func (j *Journal) append(op byte, payload []byte) error {
+	if j.file == nil {
+		return errors.New("mempool journal is closed")
+	}
+
+	if _, err := j.file.Write(journalRecord(op, payload)); err != nil {
+		return fmt.Errorf("failed to write mempool journal %s: %w", j.path, err)
+	}
+	j.records++
+
+	return nil
+}
+
+// Txs returns the transactions of the journal, in the order of their
+// insertion.
+func (j *Journal) Txs() [][]byte {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	return j.sortedTxs()
+}
+
+func (j *Journal) sortedTxs() [][]byte {
+	txs := make([]journalTx, 0, len(j.txs))
+	for _, tx := range j.txs {
+		txs = append(txs, tx)
+	}
+	sort.Slice(txs, func(a, b int) bool { return txs[a].seq < txs[b].seq })
+
+	txsBytes := make([][]byte, len(txs))
+	for i, tx := range txs {
+		txsBytes[i] = tx.txBytes
+	}
+
+	return txsBytes
+}
+
+// Len returns the number of transactions of the journal.
+func (j *Journal) Len() int {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	return len(j.txs)
+}
+
+// Compact rewrites the journal with only the insertions of its transactions.
+func (j *Journal) Compact() error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	return j.compact()
+}
+
+func (j *Journal) compactIfNeeded() error {
+	if j.records < journalCompactMinRecords || j.records <= 2*len(j.txs) {
+		return nil
+	}
+
+	return j.compact()
+}
+
+// compact writes the insertions of the transactions to a temporary file, which
+// atomically replaces the journal.
+func (j *Journal) compact() error {
+	txs := j.sortedTxs()
+	tmpPath := j.path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(tmp)
+	for _, tx := range txs {
+		if _, err = w.Write(journalRecord(journalOpInsert, tx)); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, j.path)
+	}
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to compact mempool journal %s: %w", j.path, err), os.Remove(tmpPath))
+	}
+
+	if j.file != nil {
+		if err := j.file.Close(); err != nil {
+			return err
+		}
+	}
+	j.file, err = os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		j.file = nil
+		return err
+	}
+	j.records = len(txs)
+
+	return nil
+}
+
+// Close closes the journal file.
+func (j *Journal) Close() error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	if j.file == nil {
+		return nil
+	}
+
+	err := j.file.Close()
+	j.file = nil
+
+	return err
+}
@@ -0,0 +1,81 @@
+package mempool_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+func TestJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mempool.wal")
+	j, err := mempool.OpenJournal(path)
+	require.NoError(t, err)
+	require.Empty(t, j.Txs())
+
+	tx := func(i int) []byte { return []byte(fmt.Sprintf("tx%d", i)) }
+	for i := range 5 {
+		require.NoError(t, j.Insert(tx(i)))
+	}
+	// inserting a tx again doesn't change its order, removing an unknown tx is
+	// a no-op
+	require.NoError(t, j.Insert(tx(0)))
+	require.NoError(t, j.Remove(tx(1)))
+	require.NoError(t, j.Remove(tx(10)))
+	require.Equal(t, [][]byte{tx(0), tx(2), tx(3), tx(4)}, j.Txs())
+	require.NoError(t, j.Close())
+	require.Error(t, j.Insert(tx(5)))
+
+	// the txs are read when the journal is opened again, and the journal is
+	// compacted
+	j, err = mempool.OpenJournal(path)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{tx(0), tx(2), tx(3), tx(4)}, j.Txs())
+	require.NoError(t, j.RemoveHash(sha256.Sum256(tx(3))))
+	require.NoError(t, j.Insert(tx(5)))
+	require.NoError(t, j.Close())
+	compacted, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	j, err = mempool.OpenJournal(path)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{tx(0), tx(2), tx(4), tx(5)}, j.Txs())
+	require.NoError(t, j.Close())
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Less(t, len(bz), len(compacted))
+
+	// a torn or corrupted record ends the journal
+	for _, tail := range [][]byte{
+		bz[:1],
+		bz[:5],
+		append([]byte{bz[0], bz[1], bz[2] ^ 0xff}, bz[3:9]...),
+		{0xff, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00},
+	} {
+		require.NoError(t, os.WriteFile(path, append(append([]byte{}, bz...), tail...), 0o600))
+		j, err = mempool.OpenJournal(path)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{tx(0), tx(2), tx(4), tx(5)}, j.Txs())
+		require.NoError(t, j.Close())
+	}
+
+	// the records are compacted once the journal holds more than twice as
+	// many records as txs
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+	j, err = mempool.OpenJournal(path)
+	require.NoError(t, err)
+	for i := range 2000 {
+		require.NoError(t, j.Insert(tx(100+i)))
+		require.NoError(t, j.Remove(tx(100+i)))
+	}
+	require.Equal(t, 4, j.Len())
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Less(t, info.Size(), int64(1024*40))
+	require.NoError(t, j.Close())
+}
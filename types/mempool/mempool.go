@@ -34,6 +34,43 @@ type ExtMempool interface {
 	SelectBy(context.Context, [][]byte, func(sdk.Tx) bool)
 }
 
+// EvictingMempool is implemented by the mempools which may evict transactions
+// on Insert to make room for new ones.
+type EvictingMempool interface {
+	Mempool
+
+	// SetOnEvict sets a callback called with every transaction evicted from the
+	// mempool.
+	SetOnEvict(onEvict func(tx sdk.Tx))
+}
+
+// Inspectable is implemented by the mempools whose content can be inspected,
+// e.g. by the operators of a node.
+type Inspectable interface {
+	// InspectTxs returns at most limit transactions of the mempool, after the
+	// first offset ones, in the order of their selection if the mempool selects
+	// them in a deterministic order, and the number of transactions of the
+	// mempool.
+	InspectTxs(offset, limit int) (txs []TxInfo, total int)
+
+	// InspectSenderTxs returns the transactions of a sender, in nonce order.
+	InspectSenderTxs(sender string) []TxInfo
+
+	// SenderCounts returns the number of transactions of each sender having
+	// transactions in the mempool.
+	SenderCounts() map[string]int
+}
+
+// TxInfo describes a transaction of a mempool.
+type TxInfo struct {
+	Tx     sdk.Tx
+	Sender string
+	Nonce  uint64
+	// Priority is the priority of the transaction, empty if the mempool doesn't
+	// prioritize transactions.
+	Priority string
+}
+
 // Iterator defines an app-side mempool iterator interface that is as minimal as
 // possible. The order of iteration is determined by the app-side mempool
 // implementation.
@@ -47,8 +84,10 @@ type Iterator interface {
 }
 
 var (
-	ErrTxNotFound           = errors.New("tx not found in mempool")
-	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+	ErrTxNotFound               = errors.New("tx not found in mempool")
+	ErrMempoolTxMaxCapacity     = errors.New("pool reached max tx capacity")
+	ErrMempoolSenderMaxCapacity = errors.New("sender reached max tx capacity")
+	ErrTxReplacementUnderpriced = errors.New("replacement tx underpriced")
 )
 
 // SelectBy is compatible with old interface to avoid breaking api.
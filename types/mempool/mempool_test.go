@@ -239,6 +239,44 @@ func TestMempoolTestSuite(t *testing.T) {
 	suite.Run(t, new(MempoolTestSuite))
 }
 
+func TestInspectable_SenderCounts(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+
+	pools := map[string]mempool.Mempool{
+		"sender nonce":   mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(5000)),
+		"priority nonce": mempool.DefaultPriorityMempool(),
+	}
+	for name, mp := range pools {
+		t.Run(name, func(t *testing.T) {
+			inspectable := mp.(mempool.Inspectable)
+			txs := []testTx{
+				{id: 0, nonce: 1, address: sa},
+				{id: 1, nonce: 2, address: sa},
+				{id: 2, nonce: 1, address: sb},
+			}
+			for _, tx := range txs {
+				require.NoError(t, mp.Insert(ctx, tx))
+			}
+			require.Equal(t, map[string]int{sa.String(): 2, sb.String(): 1}, inspectable.SenderCounts())
+
+			// a sender whose txs are all removed isn't counted
+			require.NoError(t, mp.Remove(txs[2]))
+			require.Equal(t, map[string]int{sa.String(): 2}, inspectable.SenderCounts())
+			require.Empty(t, inspectable.InspectSenderTxs(sb.String()))
+
+			require.NoError(t, mp.Remove(txs[0]))
+			require.NoError(t, mp.Remove(txs[1]))
+			require.Empty(t, inspectable.SenderCounts())
+			infos, total := inspectable.InspectTxs(0, 10)
+			require.Empty(t, infos)
+			require.Zero(t, total)
+		})
+	}
+}
+
 func (s *MempoolTestSuite) TestSampleTxs() {
 	ctxt := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
 	t := s.T()
@@ -8,12 +8,16 @@ import (
 
 	"github.com/huandu/skiplist"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
-	_ ExtMempool = (*PriorityNonceMempool[int64])(nil)
-	_ Iterator   = (*PriorityNonceIterator[int64])(nil)
+	_ ExtMempool      = (*PriorityNonceMempool[int64])(nil)
+	_ EvictingMempool = (*PriorityNonceMempool[int64])(nil)
+	_ Inspectable     = (*PriorityNonceMempool[int64])(nil)
+	_ Iterator        = (*PriorityNonceIterator[int64])(nil)
 )
 
 type (
@@ -31,6 +35,14 @@ type (
 		// replacement rule based on tx priority or certain transaction fields.
 		TxReplacement func(op, np C, oTx, nTx sdk.Tx) bool
 
+		// MinFeeBumpPercent is the minimum increase, in percent, of the fee of a
+		// transaction replacing the transaction of the same sender and nonce: each
+		// denomination of the fee of the replaced transaction must be bumped by at
+		// least this percentage, and transactions which don't implement sdk.FeeTx
+		// can't be replaced. If 0, no fee bump is required. The rule is applied
+		// before TxReplacement.
+		MinFeeBumpPercent uint64
+
 		// MaxTx sets the maximum number of transactions allowed in the mempool with
 		// the semantics:
 		// - if MaxTx == 0, there is no cap on the number of transactions in the mempool
@@ -40,6 +52,17 @@ type (
 		// - if MaxTx < 0, `Insert` is a no-op.
 		MaxTx int
 
+		// EvictLowerPriorityTxs makes a new transaction evict, when the mempool is
+		// full, the lowest priority transaction having the highest nonce of another
+		// sender, if its priority is strictly higher. The new transaction is
+		// rejected otherwise, as when it's false.
+		EvictLowerPriorityTxs bool
+
+		// MaxTxPerSender is the maximum number of transactions of a sender in the
+		// mempool, if 0 there is no cap. Replacing a transaction of the same nonce
+		// isn't limited.
+		MaxTxPerSender int
+
 		// SignerExtractor is an implementation which retrieves signer data from a sdk.Tx
 		SignerExtractor SignerExtractionAdapter
 	}
@@ -58,6 +81,13 @@ type (
 		senderIndices  map[string]*skiplist.SkipList
 		scores         map[txMeta[C]]txMeta[C]
 		cfg            PriorityNonceMempoolConfig[C]
+		onEvict        func(tx sdk.Tx)
+
+		// tailIndex holds, when EvictLowerPriorityTxs is set, the key of the
+		// highest nonce transaction of every sender, ordered as the priority
+		// index, and senderTails the key of each sender in it.
+		tailIndex   *skiplist.SkipList
+		senderTails map[string]txMeta[C]
 	}
 
 	// PriorityNonceIterator defines an iterator that is used for mempool iteration
@@ -170,10 +200,23 @@ func NewPriorityMempool[C comparable](cfg PriorityNonceMempoolConfig[C]) *Priori
 		scores:         make(map[txMeta[C]]txMeta[C]),
 		cfg:            cfg,
 	}
+	if cfg.EvictLowerPriorityTxs {
+		mp.tailIndex = skiplist.New(skiplistComparable(cfg.TxPriority))
+		mp.senderTails = make(map[string]txMeta[C])
+	}
 
 	return mp
 }
 
+// SetOnEvict sets a callback called with every transaction evicted by Insert,
+// once the lock of the mempool is released.
+func (mp *PriorityNonceMempool[C]) SetOnEvict(onEvict func(tx sdk.Tx)) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	mp.onEvict = onEvict
+}
+
 // DefaultPriorityMempool returns a priorityNonceMempool with no options.
 func DefaultPriorityMempool() *PriorityNonceMempool[int64] {
 	return NewPriorityMempool(DefaultPriorityNonceMempoolConfig())
@@ -197,25 +240,38 @@ func (mp *PriorityNonceMempool[C]) NextSenderTx(sender string) sdk.Tx {
 // transaction's first signature.
 //
 // Transactions are unique by sender and nonce. Inserting a duplicate tx is an
-// O(log n) no-op.
+// O(log n) no-op, unless MinFeeBumpPercent is set, in which case it's rejected
+// as its fee isn't bumped.
 //
 // Inserting a duplicate tx with a different priority overwrites the existing tx,
-// changing the total order of the mempool.
+// changing the total order of the mempool, if it fits the replacement rules.
 func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error {
 	mp.mtx.Lock()
-	defer mp.mtx.Unlock()
-	if mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
-		return ErrMempoolTxMaxCapacity
+	evicted, err := mp.insert(ctx, tx)
+	onEvict := mp.onEvict
+	mp.mtx.Unlock()
+
+	if evicted != nil && onEvict != nil {
+		onEvict(evicted)
+	}
+
+	return err
+}
+
+// insert inserts tx and returns the transaction evicted for it, if any.
+func (mp *PriorityNonceMempool[C]) insert(ctx context.Context, tx sdk.Tx) (evicted sdk.Tx, err error) {
+	if mp.cfg.MaxTx > 0 && !mp.cfg.EvictLowerPriorityTxs && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
+		return nil, ErrMempoolTxMaxCapacity
 	} else if mp.cfg.MaxTx < 0 {
-		return nil
+		return nil, nil
 	}
 
 	sigs, err := mp.cfg.SignerExtractor.GetSigners(tx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if len(sigs) == 0 {
-		return fmt.Errorf("tx must have at least one signer")
+		return nil, fmt.Errorf("tx must have at least one signer")
 	}
 
 	sig := sigs[0]
@@ -223,12 +279,26 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	priority := mp.cfg.TxPriority.GetTxPriority(ctx, tx)
 	nonce, err := ChooseNonce(sig.Sequence, tx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender}
+	sk := txMeta[C]{nonce: nonce, sender: sender}
+	oldScore, txExists := mp.scores[sk]
 
 	senderIndex, ok := mp.senderIndices[sender]
+	if !txExists {
+		if ok && mp.cfg.MaxTxPerSender > 0 && senderIndex.Len() >= mp.cfg.MaxTxPerSender {
+			return nil, fmt.Errorf("%w: sender %s has %d txs", ErrMempoolSenderMaxCapacity, sender, senderIndex.Len())
+		}
+
+		if mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
+			if evicted, err = mp.evict(sender, priority); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	if !ok {
 		senderIndex = skiplist.New(skiplist.LessThanFunc(func(a, b any) int {
 			return skiplist.Uint64.Compare(b.(txMeta[C]).nonce, a.(txMeta[C]).nonce)
@@ -245,14 +315,22 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	//
 	// This O(log n) remove operation is rare and only happens when a tx's priority
 	// changes.
-	sk := txMeta[C]{nonce: nonce, sender: sender}
-	if oldScore, txExists := mp.scores[sk]; txExists {
-		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx) {
-			return fmt.Errorf(
+	if txExists {
+		oldTx := senderIndex.Get(key).Value.(sdk.Tx)
+		if mp.cfg.MinFeeBumpPercent > 0 && !feeBumped(oldTx, tx, mp.cfg.MinFeeBumpPercent) {
+			return nil, fmt.Errorf(
+				"%w: the fee must be bumped by at least %d%%",
+				ErrTxReplacementUnderpriced,
+				mp.cfg.MinFeeBumpPercent,
+			)
+		}
+
+		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, oldTx, tx) {
+			return nil, fmt.Errorf(
 				"tx doesn't fit the replacement rule, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
 				oldScore.priority,
 				priority,
-				senderIndex.Get(key).Value.(sdk.Tx),
+				oldTx,
 				tx,
 			)
 		}
@@ -264,18 +342,97 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 			weight:   oldScore.weight,
 		})
 		mp.priorityCounts[oldScore.priority]--
+
+		// The sender index keeps the key of an existing element on Set, so the
+		// key of the replaced tx must be removed for its priority to be updated.
+		senderIndex.Remove(key)
 	}
 
 	mp.priorityCounts[priority]++
 
-	// Since senderIndex is scored by nonce, a changed priority will overwrite the
-	// existing key.
 	key.senderElement = senderIndex.Set(key, tx)
 
 	mp.scores[sk] = txMeta[C]{priority: priority}
 	mp.priorityIndex.Set(key, tx)
+	mp.updateSenderTail(sender)
 
-	return nil
+	return evicted, nil
+}
+
+// evict evicts and returns the lowest priority transaction which may be evicted
+// for a new transaction of sender with the given priority in O(log n), or
+// returns ErrMempoolTxMaxCapacity if there is none. Only the transactions of other
+// senders having the highest nonce of their sender may be evicted, so that no
+// nonce gap is left, and only if their priority is strictly lower.
+func (mp *PriorityNonceMempool[C]) evict(sender string, priority C) (sdk.Tx, error) {
+	// the tail index holds one transaction per sender, so the candidate is one
+	// of its two lowest priority transactions
+	node := mp.tailIndex.Back()
+	if node != nil && node.Key().(txMeta[C]).sender == sender {
+		node = node.Prev()
+	}
+	if node == nil {
+		return nil, ErrMempoolTxMaxCapacity
+	}
+
+	key := node.Key().(txMeta[C])
+	if mp.cfg.TxPriority.Compare(key.priority, priority) >= 0 {
+		return nil, ErrMempoolTxMaxCapacity
+	}
+
+	evicted := mp.senderIndices[key.sender].Back().Value.(sdk.Tx)
+	if err := mp.remove(key.sender, key.nonce); err != nil {
+		return nil, err
+	}
+
+	return evicted, nil
+}
+
+// updateSenderTail updates the key of sender in the tail index, after its
+// transactions changed.
+func (mp *PriorityNonceMempool[C]) updateSenderTail(sender string) {
+	if mp.tailIndex == nil {
+		return
+	}
+
+	if key, ok := mp.senderTails[sender]; ok {
+		mp.tailIndex.Remove(key)
+		delete(mp.senderTails, sender)
+	}
+
+	senderIndex, ok := mp.senderIndices[sender]
+	if !ok || senderIndex.Len() == 0 {
+		return
+	}
+
+	nonce := senderIndex.Back().Key().(txMeta[C]).nonce
+	score := mp.scores[txMeta[C]{nonce: nonce, sender: sender}]
+	key := txMeta[C]{nonce: nonce, priority: score.priority, sender: sender}
+	mp.tailIndex.Set(key, nil)
+	mp.senderTails[sender] = key
+}
+
+// feeBumped returns whether every denomination of the fee of oTx is bumped by
+// at least bumpPercent percent in the fee of nTx.
+func feeBumped(oTx, nTx sdk.Tx, bumpPercent uint64) bool {
+	oFeeTx, ok := oTx.(sdk.FeeTx)
+	if !ok {
+		return false
+	}
+	nFeeTx, ok := nTx.(sdk.FeeTx)
+	if !ok {
+		return false
+	}
+
+	nFee := nFeeTx.GetFee()
+	multiplier := sdkmath.NewIntFromUint64(bumpPercent).AddRaw(100)
+	for _, coin := range oFeeTx.GetFee() {
+		if nFee.AmountOf(coin.Denom).MulRaw(100).LT(coin.Amount.Mul(multiplier)) {
+			return false
+		}
+	}
+
+	return true
 }
 
 func (i *PriorityNonceIterator[C]) iteratePriority() Iterator {
@@ -465,6 +622,11 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 		return err
 	}
 
+	return mp.remove(sender, nonce)
+}
+
+// remove removes the transaction of sender and nonce from the indices.
+func (mp *PriorityNonceMempool[C]) remove(sender string, nonce uint64) error {
 	scoreKey := txMeta[C]{nonce: nonce, sender: sender}
 	score, ok := mp.scores[scoreKey]
 	if !ok {
@@ -481,10 +643,83 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	senderTxs.Remove(tk)
 	delete(mp.scores, scoreKey)
 	mp.priorityCounts[score.priority]--
+	mp.updateSenderTail(sender)
 
 	return nil
 }
 
+// InspectTxs returns at most limit transactions of the mempool in the order of
+// Select, after the first offset ones, and the number of transactions of the
+// mempool. The selection stops once limit transactions are returned.
+func (mp *PriorityNonceMempool[C]) InspectTxs(offset, limit int) (txs []TxInfo, total int) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	total = mp.priorityIndex.Len()
+	if offset >= total || limit <= 0 {
+		return nil, total
+	}
+
+	for iter := mp.doSelect(context.Background(), nil); iter != nil && len(txs) < limit; iter = iter.Next() {
+		if offset > 0 {
+			offset--
+			continue
+		}
+
+		i := iter.(*PriorityNonceIterator[C])
+		txs = append(txs, txInfo[C](i.senderCursors[i.sender]))
+	}
+
+	return txs, total
+}
+
+// InspectSenderTxs returns the transactions of sender in nonce order.
+func (mp *PriorityNonceMempool[C]) InspectSenderTxs(sender string) []TxInfo {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	senderIndex, ok := mp.senderIndices[sender]
+	if !ok {
+		return nil
+	}
+
+	var txs []TxInfo
+	for e := senderIndex.Front(); e != nil; e = e.Next() {
+		txs = append(txs, txInfo[C](e))
+	}
+
+	return txs
+}
+
+// SenderCounts returns the number of transactions of each sender having
+// transactions in the mempool. The sender indices are kept once empty, so the
+// senders without transactions are skipped.
+func (mp *PriorityNonceMempool[C]) SenderCounts() map[string]int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	counts := make(map[string]int, len(mp.senderIndices))
+	for sender, senderIndex := range mp.senderIndices {
+		if senderIndex.Len() > 0 {
+			counts[sender] = senderIndex.Len()
+		}
+	}
+
+	return counts
+}
+
+// txInfo returns the description of the transaction of an element of a sender
+// index.
+func txInfo[C comparable](e *skiplist.Element) TxInfo {
+	key := e.Key().(txMeta[C])
+	return TxInfo{
+		Tx:       e.Value.(sdk.Tx),
+		Sender:   key.sender,
+		Nonce:    key.nonce,
+		Priority: fmt.Sprint(key.priority),
+	}
+}
+
 func IsEmpty[C comparable](mempool Mempool) error {
 	mp := mempool.(*PriorityNonceMempool[C])
 	if mp.priorityIndex.Len() != 0 {
@@ -0,0 +1,157 @@
+package mempool_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	mempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var _ sdk.FeeTx = (*feeTestTx)(nil)
+
+// feeTestTx is a testTx paying a fee.
+type feeTestTx struct {
+	testTx
+	fee sdk.Coins
+}
+
+func (tx feeTestTx) GetGas() uint64 { return 0 }
+
+func (tx feeTestTx) GetFee() sdk.Coins { return tx.fee }
+
+func (tx feeTestTx) FeePayer() []byte { return tx.address }
+
+func (tx feeTestTx) FeeGranter() []byte { return nil }
+
+// poolKey identifies a transaction of the mempool by sender and nonce.
+type poolKey struct {
+	sender string
+	nonce  uint64
+}
+
+func poolContent(mp mempool.Mempool, ctx sdk.Context) map[poolKey]feeTestTx {
+	content := make(map[poolKey]feeTestTx)
+	for it := mp.Select(ctx, nil); it != nil; it = it.Next() {
+		tx := it.Tx().(feeTestTx)
+		content[poolKey{tx.address.String(), tx.nonce}] = tx
+	}
+
+	return content
+}
+
+// Property Based Testing
+// Insert random transactions of few senders and nonces in a PriorityNonceMempool
+// with a fee bump, a per sender and an evicting global cap, and check after
+// every insert that the content of the mempool is the previous one:
+// - with the tx of the same sender and nonce replaced, if the fee is bumped,
+// - with the tx added, if the sender and the mempool aren't full,
+// - with the tx added and the lowest priority tx having the highest nonce of
+// another sender evicted, if the mempool is full and its priority is lower,
+// - unchanged with the corresponding error otherwise.
+func testPriorityNonceMempoolProperties(t *rapid.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	cfg := mempool.DefaultPriorityNonceMempoolConfig()
+	cfg.MinFeeBumpPercent = rapid.Uint64Range(0, 100).Draw(t, "minFeeBumpPercent")
+	cfg.MaxTx = rapid.IntRange(1, 20).Draw(t, "maxTx")
+	cfg.MaxTxPerSender = rapid.IntRange(0, 5).Draw(t, "maxTxPerSender")
+	cfg.EvictLowerPriorityTxs = true
+	mp := mempool.NewPriorityMempool(cfg)
+
+	accounts := rapid.SliceOfNDistinct(AddressGenerator(t), 1, 8, func(acc sdk.AccAddress) string {
+		return acc.String()
+	}).Draw(t, "address")
+	genTx := rapid.Custom(func(t *rapid.T) feeTestTx {
+		return feeTestTx{
+			testTx: testTx{
+				priority: rapid.Int64Range(0, 100).Draw(t, "priority"),
+				nonce:    rapid.Uint64Range(0, 8).Draw(t, "nonce"),
+				address:  rapid.SampledFrom(accounts).Draw(t, "acc"),
+			},
+			fee: sdk.NewCoins(sdk.NewInt64Coin("stake", rapid.Int64Range(0, 1000).Draw(t, "fee"))),
+		}
+	})
+	txs := rapid.SliceOfN(genTx, 1, 300).Draw(t, "txs")
+
+	content := make(map[poolKey]feeTestTx)
+	for i, tx := range txs {
+		tx.id = i
+		key := poolKey{tx.address.String(), tx.nonce}
+		err := mp.Insert(ctx.WithPriority(tx.priority), tx)
+		next := poolContent(mp, ctx)
+		require.Equal(t, len(next), mp.CountTx())
+
+		// the transactions of each sender and their highest nonce
+		senderTxs := make(map[string]int)
+		lastNonces := make(map[string]uint64)
+		for k := range content {
+			senderTxs[k.sender]++
+			lastNonces[k.sender] = max(lastNonces[k.sender], k.nonce)
+		}
+
+		old, exists := content[key]
+		switch {
+		case exists:
+			bumped := true
+			if cfg.MinFeeBumpPercent > 0 {
+				minFee := old.fee.AmountOf("stake").MulRaw(int64(100 + cfg.MinFeeBumpPercent))
+				bumped = tx.fee.AmountOf("stake").MulRaw(100).GTE(minFee)
+			}
+			if !bumped {
+				require.ErrorIs(t, err, mempool.ErrTxReplacementUnderpriced)
+				break
+			}
+			require.NoError(t, err)
+			content[key] = tx
+
+		case cfg.MaxTxPerSender > 0 && senderTxs[key.sender] >= cfg.MaxTxPerSender:
+			require.ErrorIs(t, err, mempool.ErrMempoolSenderMaxCapacity)
+
+		case len(content) >= cfg.MaxTx:
+			// the candidates are the txs of other senders having the highest nonce
+			// of their sender and a lower priority, the ties between the lowest
+			// priority ones being broken by the mempool
+			var candidates []poolKey
+			minPriority := tx.priority
+			for k, v := range content {
+				if k.sender != key.sender && k.nonce == lastNonces[k.sender] && v.priority < tx.priority {
+					candidates = append(candidates, k)
+					minPriority = min(minPriority, v.priority)
+				}
+			}
+			if len(candidates) == 0 {
+				require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+				break
+			}
+			require.NoError(t, err)
+
+			var evicted []poolKey
+			for k := range content {
+				if _, ok := next[k]; !ok {
+					evicted = append(evicted, k)
+				}
+			}
+			require.Len(t, evicted, 1)
+			require.Contains(t, candidates, evicted[0])
+			require.Equal(t, minPriority, content[evicted[0]].priority)
+			delete(content, evicted[0])
+			content[key] = tx
+
+		default:
+			require.NoError(t, err)
+			content[key] = tx
+		}
+
+		require.Equal(t, content, next)
+		require.LessOrEqual(t, len(content), cfg.MaxTx)
+	}
+}
+
+func TestPriorityNonceMempoolProperties(t *testing.T) {
+	rapid.Check(t, testPriorityNonceMempoolProperties)
+}
@@ -973,6 +973,180 @@ func TestNextSenderTx_TxReplacement(t *testing.T) {
 	require.Equal(t, txs[3], iter.Tx())
 }
 
+func TestNextSenderTx_TxEviction(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+
+	txs := []testTx{
+		{priority: 20, nonce: 1, address: sa},
+		{priority: 21, nonce: 1, address: sb},
+		{priority: 15, nonce: 2, address: sa},
+		{priority: 88, nonce: 2, address: sb},
+		{priority: 66, nonce: 3, address: sa},
+		{priority: 15, nonce: 3, address: sb},
+		{priority: 20, nonce: 4, address: sa},
+		{priority: 21, nonce: 4, address: sb},
+		{priority: 88, nonce: 5, address: sa},
+		{priority: 66, nonce: 5, address: sb},
+	}
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:            mempool.NewDefaultTxPriority(),
+			MaxTx:                 3,
+			EvictLowerPriorityTxs: true,
+			SignerExtractor:       mempool.NewDefaultSignerExtractionAdapter(),
+		},
+	)
+	var evicted []sdk.Tx
+	// the callback is called once the mempool is unlocked and the new tx is
+	// inserted, so it may use the mempool
+	mp.SetOnEvict(func(tx sdk.Tx) {
+		require.Equal(t, 3, mp.CountTx())
+		evicted = append(evicted, tx)
+	})
+
+	// once full, a tx evicts the lowest priority tx with the highest nonce of
+	// another sender if its priority is higher
+	evicting := map[int]bool{3: true, 7: true, 8: true}
+	for i, tx := range txs {
+		c := ctx.WithPriority(tx.priority)
+		err := mp.Insert(c, tx)
+		switch {
+		case i < 3:
+			require.NoError(t, err)
+			require.Equal(t, i+1, mp.CountTx())
+		case evicting[i]:
+			require.NoError(t, err)
+			require.Equal(t, 3, mp.CountTx())
+		default:
+			require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+			require.Equal(t, 3, mp.CountTx())
+		}
+	}
+	// sa2 was evicted by sb2, sa1 by sb4 and sb4 by sa5
+	require.Equal(t, []sdk.Tx{txs[2], txs[0], txs[7]}, evicted)
+	require.ElementsMatch(t, []sdk.Tx{txs[1], txs[3], txs[8]}, fetchTxs(mp.Select(ctx, nil), 3))
+
+	// a full mempool still accepts replacements
+	replacement := testTx{priority: 90, nonce: 5, address: sa}
+	require.NoError(t, mp.Insert(ctx.WithPriority(replacement.priority), replacement))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestNextSenderTx_FeeBump(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+
+	fee := func(amounts ...int64) sdk.Coins {
+		coins := sdk.NewCoins(sdk.NewInt64Coin("stake", amounts[0]))
+		if len(amounts) > 1 {
+			coins = coins.Add(sdk.NewInt64Coin("atom", amounts[1]))
+		}
+		return coins
+	}
+	txs := []feeTestTx{
+		{testTx: testTx{id: 0, priority: 20, nonce: 1, address: sa}, fee: fee(100, 10)},
+		{testTx: testTx{id: 1, priority: 30, nonce: 1, address: sa}, fee: fee(109, 20)}, // stake isn't bumped by 10%
+		{testTx: testTx{id: 2, priority: 30, nonce: 1, address: sa}, fee: fee(110)},     // atom isn't bumped
+		{testTx: testTx{id: 3, priority: 10, nonce: 1, address: sa}, fee: fee(110, 11)}, // bumped, whatever the priority
+	}
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:        mempool.NewDefaultTxPriority(),
+			MinFeeBumpPercent: 10,
+		},
+	)
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(txs[0].priority), txs[0]))
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(txs[0].priority), txs[0]), mempool.ErrTxReplacementUnderpriced)
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(txs[1].priority), txs[1]), mempool.ErrTxReplacementUnderpriced)
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(txs[2].priority), txs[2]), mempool.ErrTxReplacementUnderpriced)
+	require.Equal(t, txs[0], mp.Select(ctx, nil).Tx())
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(txs[3].priority), txs[3]))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, txs[3], mp.Select(ctx, nil).Tx())
+
+	// a tx without fee can't be replaced
+	tx := testTx{priority: 10, nonce: 2, address: sa}
+	require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(tx.priority), feeTestTx{testTx: tx, fee: fee(1000)}), mempool.ErrTxReplacementUnderpriced)
+}
+
+func TestNextSenderTx_SenderLimit(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:     mempool.NewDefaultTxPriority(),
+			MaxTxPerSender: 2,
+		},
+	)
+
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		require.NoError(t, mp.Insert(ctx, testTx{nonce: nonce, address: sa}))
+	}
+	require.ErrorIs(t, mp.Insert(ctx, testTx{nonce: 3, address: sa}), mempool.ErrMempoolSenderMaxCapacity)
+
+	// replacing a tx isn't limited, nor are the txs of other senders
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{priority: 10, nonce: 2, address: sa}))
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 1, address: sb}))
+	require.Equal(t, 3, mp.CountTx())
+
+	// removing a tx frees room for the sender
+	require.NoError(t, mp.Remove(testTx{nonce: 1, address: sa}))
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 3, address: sa}))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestPriorityNonceMempool_Inspect(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+
+	mp := mempool.DefaultPriorityMempool()
+	txs := []testTx{
+		{id: 0, priority: 30, nonce: 2, address: sa},
+		{id: 1, priority: 20, nonce: 1, address: sb},
+		{id: 2, priority: 10, nonce: 1, address: sa},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	info := func(tx testTx) mempool.TxInfo {
+		return mempool.TxInfo{Tx: tx, Sender: tx.address.String(), Nonce: tx.nonce, Priority: fmt.Sprint(tx.priority)}
+	}
+	// the txs of sa are selected in nonce order
+	infos, total := mp.InspectTxs(0, 10)
+	require.Equal(t, []mempool.TxInfo{info(txs[1]), info(txs[2]), info(txs[0])}, infos)
+	require.Equal(t, 3, total)
+	infos, total = mp.InspectTxs(1, 1)
+	require.Equal(t, []mempool.TxInfo{info(txs[2])}, infos)
+	require.Equal(t, 3, total)
+	infos, total = mp.InspectTxs(3, 1)
+	require.Empty(t, infos)
+	require.Equal(t, 3, total)
+	require.Equal(t, []mempool.TxInfo{info(txs[2]), info(txs[0])}, mp.InspectSenderTxs(sa.String()))
+	require.Empty(t, mp.InspectSenderTxs("unknown"))
+	require.Equal(t, map[string]int{sa.String(): 2, sb.String(): 1}, mp.SenderCounts())
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.Equal(t, map[string]int{sa.String(): 2}, mp.SenderCounts())
+	infos, total = mp.InspectTxs(0, 10)
+	require.Equal(t, []mempool.TxInfo{info(txs[2]), info(txs[0])}, infos)
+	require.Equal(t, 2, total)
+}
+
 func TestPriorityNonceMempool_UnorderedTx_FailsForSequence(t *testing.T) {
 	mp := mempool.DefaultPriorityMempool()
 	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
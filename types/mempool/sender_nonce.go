@@ -16,8 +16,9 @@ import (
 )
 
 var (
-	_ ExtMempool = (*SenderNonceMempool)(nil)
-	_ Iterator   = (*senderNonceMempoolIterator)(nil)
+	_ ExtMempool  = (*SenderNonceMempool)(nil)
+	_ Inspectable = (*SenderNonceMempool)(nil)
+	_ Iterator    = (*senderNonceMempoolIterator)(nil)
 )
 
 var DefaultMaxTx = -1
@@ -254,6 +255,85 @@ func (snm *SenderNonceMempool) Remove(tx sdk.Tx) error {
 	return nil
 }
 
+// InspectTxs returns at most limit transactions of the mempool, after the first
+// offset ones, and the number of transactions of the mempool. As senders are
+// selected randomly, the transactions are ordered by sender and then by nonce.
+// The senders whose transactions are all skipped aren't iterated.
+func (snm *SenderNonceMempool) InspectTxs(offset, limit int) (txs []TxInfo, total int) {
+	snm.mtx.Lock()
+	defer snm.mtx.Unlock()
+
+	total = len(snm.existingTx)
+	if offset >= total || limit <= 0 {
+		return nil, total
+	}
+
+	senders := make([]string, 0, len(snm.senders))
+	for sender := range snm.senders {
+		senders = append(senders, sender)
+	}
+	slices.Sort(senders)
+
+	for _, sender := range senders {
+		senderTxs := snm.senders[sender]
+		if offset >= senderTxs.Len() {
+			offset -= senderTxs.Len()
+			continue
+		}
+
+		for e := senderTxs.Front(); e != nil && len(txs) < limit; e = e.Next() {
+			if offset > 0 {
+				offset--
+				continue
+			}
+			txs = append(txs, TxInfo{Tx: e.Value.(sdk.Tx), Sender: sender, Nonce: e.Key().(uint64)})
+		}
+		if len(txs) == limit {
+			break
+		}
+	}
+
+	return txs, total
+}
+
+// InspectSenderTxs returns the transactions of sender in nonce order.
+func (snm *SenderNonceMempool) InspectSenderTxs(sender string) []TxInfo {
+	snm.mtx.Lock()
+	defer snm.mtx.Unlock()
+
+	return snm.inspectSenderTxs(sender)
+}
+
+func (snm *SenderNonceMempool) inspectSenderTxs(sender string) []TxInfo {
+	senderTxs, found := snm.senders[sender]
+	if !found {
+		return nil
+	}
+
+	var txs []TxInfo
+	for e := senderTxs.Front(); e != nil; e = e.Next() {
+		txs = append(txs, TxInfo{Tx: e.Value.(sdk.Tx), Sender: sender, Nonce: e.Key().(uint64)})
+	}
+
+	return txs
+}
+
+// SenderCounts returns the number of transactions of each sender having
+// transactions in the mempool.
+func (snm *SenderNonceMempool) SenderCounts() map[string]int {
+	snm.mtx.Lock()
+	defer snm.mtx.Unlock()
+
+	counts := make(map[string]int, len(snm.senders))
+	for sender, senderTxs := range snm.senders {
+		if senderTxs.Len() > 0 {
+			counts[sender] = senderTxs.Len()
+		}
+	}
+
+	return counts
+}
+
 type senderNonceMempoolIterator struct {
 	rnd           *rand.Rand
 	currentTx     *skiplist.Element
@@ -186,6 +186,49 @@ func (s *MempoolTestSuite) TestTxRejectedWithUnorderedAndSequence() {
 	require.ErrorContains(t, err, "unordered txs must not have sequence set")
 }
 
+func (s *MempoolTestSuite) TestInspect() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+
+	mp := mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(5000))
+	txs := []testTx{
+		{id: 0, nonce: 2, address: sa},
+		{id: 1, nonce: 1, address: sb},
+		{id: 2, nonce: 1, address: sa},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+
+	info := func(tx testTx) mempool.TxInfo {
+		return mempool.TxInfo{Tx: tx, Sender: tx.address.String(), Nonce: tx.nonce}
+	}
+	require.Equal(t, []mempool.TxInfo{info(txs[2]), info(txs[0])}, mp.InspectSenderTxs(sa.String()))
+	require.Empty(t, mp.InspectSenderTxs("unknown"))
+	require.Equal(t, map[string]int{sa.String(): 2, sb.String(): 1}, mp.SenderCounts())
+
+	expected := []mempool.TxInfo{info(txs[2]), info(txs[0]), info(txs[1])}
+	if sb.String() < sa.String() {
+		expected = []mempool.TxInfo{info(txs[1]), info(txs[2]), info(txs[0])}
+	}
+	infos, total := mp.InspectTxs(0, 10)
+	require.Equal(t, expected, infos)
+	require.Equal(t, 3, total)
+	// the txs are paged across the senders
+	infos, total = mp.InspectTxs(1, 2)
+	require.Equal(t, expected[1:], infos)
+	require.Equal(t, 3, total)
+	infos, total = mp.InspectTxs(2, 10)
+	require.Equal(t, expected[2:], infos)
+	require.Equal(t, 3, total)
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.Equal(t, map[string]int{sa.String(): 2}, mp.SenderCounts())
+}
+
 func (s *MempoolTestSuite) TestTxNotFoundOnSender() {
 	t := s.T()
 	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
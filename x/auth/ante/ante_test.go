@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -1244,6 +1245,10 @@ func TestCountSubkeys(t *testing.T) {
 	multiLevelMultiKey := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{
 		multiLevelSubKey1, multiLevelSubKey2, secp256k1.GenPrivKey().PubKey(),
 	})
+	muSig2Key, err := taproot.NewMuSig2PubKey([]cryptotypes.PubKey{
+		taproot.GenPrivKey().PubKey(), taproot.GenPrivKey().PubKey(), taproot.GenPrivKey().PubKey(),
+	})
+	require.NoError(t, err)
 	type args struct {
 		pub cryptotypes.PubKey
 	}
@@ -1255,6 +1260,7 @@ func TestCountSubkeys(t *testing.T) {
 		{"single key", args{singleKey}, 1},
 		{"single level multikey", args{singleLevelMultiKey}, 5},
 		{"multi level multikey", args{multiLevelMultiKey}, 11},
+		{"musig2 key", args{muSig2Key}, 3},
 		{"nil key", args{nil}, 0},
 	}
 	for _, tc := range testCases {
@@ -409,12 +409,43 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 				return ctx, errorsmod.Wrap(sdkerrors.ErrUnauthorized, errMsg)
 
 			}
+
+			// @nubit: the script engine checks OP_CHECKLOCKTIMEVERIFY against
+			// the lock time of a tapscript signature, which must also be
+			// reached by the block.
+			if err := verifyTapscriptLockTime(ctx, pubKey, sig.Data); err != nil {
+				return ctx, err
+			}
 		}
 	}
 
 	return next(ctx, tx, simulate)
 }
 
+// verifyTapscriptLockTime checks that the block reached the lock time of the
+// signature of a tapscript key.
+func verifyTapscriptLockTime(ctx sdk.Context, pubKey cryptotypes.PubKey, sigData signing.SignatureData) error {
+	if _, ok := pubKey.(*taproot.TapscriptPubKey); !ok {
+		return nil
+	}
+
+	data, ok := sigData.(*signing.SingleSignatureData)
+	if !ok || taproot.IsSchnorrSignature(data.Signature) {
+		return nil
+	}
+
+	tapSig, err := taproot.ParseTapscriptSignature(data.Signature)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
+	if !tapSig.LockTimeReached(ctx.BlockHeight(), ctx.BlockTime()) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "tapscript signature is locked until %d", tapSig.LockTime)
+	}
+
+	return nil
+}
+
 // verifyUnorderedNonce verifies the unordered nonce of an unordered transaction.
 // This checks that:
 // 1. The unordered transaction's timeout timestamp is set.
@@ -581,8 +612,30 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
 		return nil
 
-	case *taproot.PubKey:
-		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: taproot")
+	case *taproot.PubKey, *taproot.MuSig2PubKey:
+		// A MuSig2 signature is a single signature of the aggregate key, so it
+		// costs the same as the signature of a single taproot key.
+		if data, ok := sig.Data.(*signing.SingleSignatureData); ok && taproot.IsSchnorrSignature(data.Signature) {
+			meter.ConsumeGas(params.SigVerifyCostSchnorrOrDefault(), "ante verify: taproot schnorr")
+			return nil
+		}
+		meter.ConsumeGas(params.SigVerifyCostTaprootOrDefault(), "ante verify: taproot")
+		return nil
+
+	case *taproot.TapscriptPubKey:
+		data, ok := sig.Data.(*signing.SingleSignatureData)
+		if ok && taproot.IsSchnorrSignature(data.Signature) {
+			meter.ConsumeGas(params.SigVerifyCostSchnorrOrDefault(), "ante verify: taproot schnorr")
+			return nil
+		}
+		// The script engine may check several signatures of the leaf script,
+		// the first one is covered by the cost of running the engine.
+		meter.ConsumeGas(params.SigVerifyCostTaprootOrDefault(), "ante verify: tapscript")
+		if ok {
+			if tapSig, err := taproot.ParseTapscriptSignature(data.Signature); err == nil && tapSig.NumSignatures() > 1 {
+				meter.ConsumeGas(params.SigVerifyCostSchnorrOrDefault()*uint64(tapSig.NumSignatures()-1), "ante verify: tapscript signatures")
+			}
+		}
 		return nil
 
 	case *secp256r1.PubKey:
@@ -649,6 +702,12 @@ func CountSubKeys(pub cryptotypes.PubKey) int {
 	if pub == nil {
 		return 0
 	}
+	// @nubit: every co-signer of a MuSig2 key takes part in the key
+	// aggregation done on verification, so they count towards the limit.
+	if v, ok := pub.(*taproot.MuSig2PubKey); ok {
+		return len(v.PubKeys)
+	}
+
 	v, ok := pub.(*kmultisig.LegacyAminoPubKey)
 	if !ok {
 		return 1
@@ -15,6 +15,7 @@ import (
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -107,7 +108,31 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	msg := []byte{1, 2, 3, 4}
 
 	p := types.DefaultParams()
+	// params stored before the taproot costs existed
+	unsetParams := types.DefaultParams()
+	unsetParams.SigVerifyCostTaproot = 0
+	unsetParams.SigVerifyCostSchnorr = 0
 	skR1, _ := secp256r1.GenPrivKey()
+	skTaproot := taproot.GenPrivKey()
+	schnorrSig, err := skTaproot.SignSchnorr(msg)
+	require.NoError(t, err)
+	bip322Sig, err := skTaproot.Sign(msg)
+	require.NoError(t, err)
+	muSig2Key, err := taproot.NewMuSig2PubKey([]cryptotypes.PubKey{skTaproot.PubKey(), taproot.GenPrivKey().PubKey()})
+	require.NoError(t, err)
+	skRecovery := taproot.GenPrivKey()
+	recoveryScript, err := taproot.NewMultiSigScript(2, []*taproot.PubKey{skTaproot.PubKey().(*taproot.PubKey), skRecovery.PubKey().(*taproot.PubKey)})
+	require.NoError(t, err)
+	tapscriptKey, err := taproot.NewTapscriptPubKey(skTaproot.PubKey().Address(), [][]byte{recoveryScript})
+	require.NoError(t, err)
+	tapscriptKeySig, err := taproot.TapscriptKeyPathSign(skTaproot, tapscriptKey, msg)
+	require.NoError(t, err)
+	leafSig1, err := taproot.TapscriptLeafSign(skTaproot, tapscriptKey, msg, 0, 0)
+	require.NoError(t, err)
+	leafSig2, err := taproot.TapscriptLeafSign(skRecovery, tapscriptKey, msg, 0, 0)
+	require.NoError(t, err)
+	tapscriptSig, err := tapscriptKey.ScriptPathSignature(0, 0, [][]byte{leafSig2, leafSig1})
+	require.NoError(t, err)
 	pkSet1, sigSet1 := generatePubKeysAndSignatures(5, msg, false)
 	multisigKey1 := kmultisig.NewLegacyAminoPubKey(2, pkSet1)
 	multisignature1 := multisig.NewMultisig(len(pkSet1))
@@ -135,6 +160,14 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		{"PubKeyEd25519", args{storetypes.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, p.SigVerifyCostED25519, false},
 		{"PubKeySecp256k1", args{storetypes.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
 		{"PubKeySecp256r1", args{storetypes.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
+		{"PubKeyTaproot BIP-322", args{storetypes.NewInfiniteGasMeter(), &signing.SingleSignatureData{Signature: bip322Sig}, skTaproot.PubKey(), params}, p.SigVerifyCostTaproot, false},
+		{"PubKeyTaproot Schnorr", args{storetypes.NewInfiniteGasMeter(), &signing.SingleSignatureData{Signature: schnorrSig}, skTaproot.PubKey(), params}, p.SigVerifyCostSchnorr, false},
+		{"PubKeyTaproot BIP-322 unset cost", args{storetypes.NewInfiniteGasMeter(), &signing.SingleSignatureData{Signature: bip322Sig}, skTaproot.PubKey(), unsetParams}, types.DefaultSigVerifyCostTaproot, false},
+		{"PubKeyTaproot Schnorr unset cost", args{storetypes.NewInfiniteGasMeter(), &signing.SingleSignatureData{Signature: schnorrSig}, skTaproot.PubKey(), unsetParams}, types.DefaultSigVerifyCostSchnorr, false},
+		{"MuSig2 BIP-322", args{storetypes.NewInfiniteGasMeter(), &signing.SingleSignatureData{Signature: bip322Sig}, muSig2Key, params}, p.SigVerifyCostTaproot, false},
+		{"MuSig2 Schnorr", args{storetypes.NewInfiniteGasMeter(), &signing.SingleSignatureData{Signature: schnorrSig}, muSig2Key, params}, p.SigVerifyCostSchnorr, false},
+		{"Tapscript key path", args{storetypes.NewInfiniteGasMeter(), &signing.SingleSignatureData{Signature: tapscriptKeySig}, tapscriptKey, params}, p.SigVerifyCostSchnorr, false},
+		{"Tapscript script path", args{storetypes.NewInfiniteGasMeter(), &signing.SingleSignatureData{Signature: tapscriptSig}, tapscriptKey, params}, p.SigVerifyCostTaproot + p.SigVerifyCostSchnorr, false},
 		{"Multisig", args{storetypes.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{storetypes.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
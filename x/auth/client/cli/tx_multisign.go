@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"strings"
@@ -18,6 +19,8 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -52,6 +55,11 @@ If the --skip-signature-verification flag is on, the command will not verify the
 signatures in the provided signature files. This is useful when the multisig
 account is a signer in a nested multisig scenario.
 
+A [signature] file may also be a PSBT signed by a Bitcoin wallet on behalf of a
+taproot key of the multisig. The --export-psbt flag prints the PSBTs to sign, one
+base64 PSBT per line for each taproot key of the multisig in the order of the keys,
+instead of reading signatures.
+
 The current multisig implementation defaults to amino-json sign mode.
 The SIGN_MODE_DIRECT sign mode is not supported.'
 `,
@@ -59,10 +67,11 @@ The SIGN_MODE_DIRECT sign mode is not supported.'
 			),
 		),
 		RunE: makeMultiSignCmd(),
-		Args: cobra.MinimumNArgs(3),
+		Args: cobra.MinimumNArgs(2),
 	}
 
 	cmd.Flags().Bool(flagSkipSignatureVerification, false, "Skip signature verification")
+	cmd.Flags().Bool(flagExportPSBT, false, "Print the PSBTs to sign with Bitcoin wallets instead of reading signatures")
 	cmd.Flags().Bool(flagSigOnly, false, "Print only the generated signature, then exit")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
 	flags.AddTxFlagsToCmd(cmd)
@@ -127,17 +136,25 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 			txFactory = txFactory.WithAccountNumber(accnum).WithSequence(seq)
 		}
 
+		if exportPSBT, _ := cmd.Flags().GetBool(flagExportPSBT); exportPSBT {
+			return printMultisigPSBTs(cmd, txFactory, txBuilder, multisigPub)
+		}
+
+		if len(args) < 3 {
+			return fmt.Errorf("requires at least 1 signature file")
+		}
+
 		// read each signature and add it to the multisig if valid
 		for i := 2; i < len(args); i++ {
-			sigs, err := unmarshalSignatureJSON(clientCtx, args[i])
-			if err != nil {
-				return err
-			}
-
 			if txFactory.ChainID() == "" {
 				return fmt.Errorf("set the chain id with either the --chain-id flag or config file")
 			}
 
+			sigs, err := readMultisigSignatures(cmd, clientCtx, txFactory, txBuilder, multisigPub, args[i])
+			if err != nil {
+				return err
+			}
+
 			for _, sig := range sigs {
 				anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
 				if err != nil {
@@ -384,12 +401,91 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 	}
 }
 
-func unmarshalSignatureJSON(clientCtx client.Context, filename string) (sigs []signingtypes.SignatureV2, err error) {
-	var bytes []byte
-	if bytes, err = os.ReadFile(filename); err != nil {
-		return
+// printMultisigPSBTs prints the PSBT of each taproot key of the multisig, in
+// the order of the keys.
+func printMultisigPSBTs(cmd *cobra.Command, txFactory tx.Factory, txBuilder client.TxBuilder, multisigPub *kmultisig.LegacyAminoPubKey) error {
+	var packets []string
+	for _, pubKey := range multisigPub.GetPubKeys() {
+		if !canSignPSBT(pubKey) {
+			continue
+		}
+
+		packet, err := tx.ExportPSBT(cmd.Context(), txFactory, pubKey, txBuilder, true)
+		if err != nil {
+			return err
+		}
+
+		encoded, err := packet.B64Encode()
+		if err != nil {
+			return err
+		}
+		packets = append(packets, encoded)
+	}
+
+	if len(packets) == 0 {
+		return fmt.Errorf("multisig has no taproot key")
+	}
+
+	closeFunc, err := setOutputFile(cmd)
+	if err != nil {
+		return err
+	}
+	defer closeFunc()
+
+	cmd.Printf("%s\n", strings.Join(packets, "\n"))
+
+	return nil
+}
+
+// readMultisigSignatures reads the signatures of a signature file, which is
+// either a JSON signature file or a PSBT signed on behalf of a taproot key of
+// the multisig.
+func readMultisigSignatures(cmd *cobra.Command, clientCtx client.Context, txFactory tx.Factory,
+	txBuilder client.TxBuilder, multisigPub *kmultisig.LegacyAminoPubKey, filename string,
+) ([]signingtypes.SignatureV2, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	if !taproot.IsPSBT(bz) {
+		return clientCtx.TxConfig.UnmarshalSignatureJSON(bz)
+	}
+
+	packet, err := taproot.DecodePSBT(bz)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read PSBT %s: %w", filename, err)
+	}
+
+	outputKey, err := taproot.PacketOutputKey(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pubKey := range multisigPub.GetPubKeys() {
+		if !canSignPSBT(pubKey) || !bytes.Equal(pubKey.Address(), outputKey) {
+			continue
+		}
+
+		// the signature is set on txBuilder, whose signatures are overwritten by
+		// the multisig signature afterwards
+		if err := tx.SignWithPSBT(cmd.Context(), txFactory, pubKey, txBuilder, packet, true); err != nil {
+			return nil, fmt.Errorf("couldn't read PSBT %s: %w", filename, err)
+		}
+
+		return txBuilder.GetTx().GetSignaturesV2()
+	}
+
+	return nil, fmt.Errorf("PSBT %s isn't signed by a key of the multisig", filename)
+}
+
+func canSignPSBT(pubKey cryptotypes.PubKey) bool {
+	switch pubKey.(type) {
+	case *taproot.PubKey, *taproot.MuSig2PubKey:
+		return true
+	default:
+		return false
 	}
-	return clientCtx.TxConfig.UnmarshalSignatureJSON(bytes)
 }
 
 func readSignaturesFromFile(ctx client.Context, filename string) (sigs []signingtypes.SignatureV2, err error) {
@@ -22,6 +22,8 @@ const (
 	flagSkipSignatureVerification = "skip-signature-verification"
 	flagNoAutoIncrement           = "no-auto-increment"
 	flagAppend                    = "append"
+	flagExportPSBT                = "export-psbt"
+	flagImportPSBT                = "import-psbt"
 )
 
 // GetSignBatchCommand returns the transaction sign-batch command.
@@ -341,6 +343,12 @@ the transaction to fail.
 The --multisig=<multisig_key> flag generates a signature on behalf of a multisig account
 key. It implies --signature-only. Full multisig signed transactions may eventually
 be generated via the 'multisign' command.
+
+The --export-psbt flag prints the BIP-322 to_sign virtual transaction the --from
+taproot key has to sign as a base64 PSBT instead of signing, so that the signature
+can be produced by a Bitcoin wallet. The --import-psbt=<file> flag then attaches the
+signature of the signed PSBT to the transaction. Both must be run with the same
+flags, and no private key needs to be stored in the keyring.
 `,
 		PreRun: preSignCmd,
 		RunE:   makeSignCmd(),
@@ -351,6 +359,9 @@ be generated via the 'multisign' command.
 	cmd.Flags().Bool(flagOverwrite, false, "Overwrite existing signatures with a new one. If disabled, new signature will be appended")
 	cmd.Flags().Bool(flagSigOnly, false, "Print only the signatures")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
+	cmd.Flags().Bool(flagExportPSBT, false, "Print the PSBT to sign with a Bitcoin wallet instead of signing")
+	cmd.Flags().String(flagImportPSBT, "", "Attach the signature of the given PSBT signed by a Bitcoin wallet")
+	cmd.MarkFlagsMutuallyExclusive(flagExportPSBT, flagImportPSBT)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -416,6 +427,16 @@ func signTx(cmd *cobra.Command, clientCtx client.Context, txF tx.Factory, newTx
 		return err
 	}
 
+	exportPSBT, err := f.GetBool(flagExportPSBT)
+	if err != nil {
+		return err
+	}
+
+	importPSBT, err := f.GetString(flagImportPSBT)
+	if err != nil {
+		return err
+	}
+
 	if multisig != "" {
 		// Bech32 decode error, maybe it's a name, we try to fetch from keyring
 		multisigAddr, multisigName, _, err := client.GetFromFields(clientCtx, txF.Keybase(), multisig)
@@ -448,14 +469,28 @@ func signTx(cmd *cobra.Command, clientCtx client.Context, txF tx.Factory, newTx
 			return fmt.Errorf("signing key is not a part of multisig key")
 		}
 
-		err = authclient.SignTxWithSignerAddress(
-			txF, clientCtx, multisigAddr, fromName, txBuilder, clientCtx.Offline, overwrite)
+		switch {
+		case exportPSBT:
+			return printPSBT(cmd, clientCtx, txF, fromName, multisigAddr, txBuilder, overwrite)
+		case importPSBT != "":
+			err = signWithPSBT(clientCtx, txF, fromName, multisigAddr, txBuilder, importPSBT, overwrite)
+		default:
+			err = authclient.SignTxWithSignerAddress(
+				txF, clientCtx, multisigAddr, fromName, txBuilder, clientCtx.Offline, overwrite)
+		}
 		if err != nil {
 			return err
 		}
 		printSignatureOnly = true
 	} else {
-		err = authclient.SignTx(txF, clientCtx, clientCtx.FromName, txBuilder, clientCtx.Offline, overwrite)
+		switch {
+		case exportPSBT:
+			return printPSBT(cmd, clientCtx, txF, clientCtx.FromName, nil, txBuilder, overwrite)
+		case importPSBT != "":
+			err = signWithPSBT(clientCtx, txF, clientCtx.FromName, nil, txBuilder, importPSBT, overwrite)
+		default:
+			err = authclient.SignTx(txF, clientCtx, clientCtx.FromName, txBuilder, clientCtx.Offline, overwrite)
+		}
 	}
 	if err != nil {
 		return err
@@ -481,6 +516,45 @@ func signTx(cmd *cobra.Command, clientCtx client.Context, txF tx.Factory, newTx
 	return err
 }
 
+// printPSBT prints the PSBT a Bitcoin wallet signs to produce the signature of
+// the `name` key, in its base64 encoding.
+func printPSBT(cmd *cobra.Command, clientCtx client.Context, txF tx.Factory, name string,
+	multisigAddr sdk.AccAddress, txBuilder client.TxBuilder, overwrite bool,
+) error {
+	packet, err := authclient.ExportTxPSBT(txF, clientCtx, name, multisigAddr, txBuilder, clientCtx.Offline, overwrite)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := packet.B64Encode()
+	if err != nil {
+		return err
+	}
+
+	closeFunc, err := setOutputFile(cmd)
+	if err != nil {
+		return err
+	}
+	defer closeFunc()
+
+	cmd.Printf("%s\n", encoded)
+
+	return nil
+}
+
+// signWithPSBT attaches the signature of the `name` key read from the signed
+// PSBT file.
+func signWithPSBT(clientCtx client.Context, txF tx.Factory, name string,
+	multisigAddr sdk.AccAddress, txBuilder client.TxBuilder, filename string, overwrite bool,
+) error {
+	packet, err := authclient.ReadPSBTFromFile(filename)
+	if err != nil {
+		return fmt.Errorf("couldn't read PSBT %s: %w", filename, err)
+	}
+
+	return authclient.SignTxWithPSBT(txF, clientCtx, name, multisigAddr, txBuilder, packet, clientCtx.Offline, overwrite)
+}
+
 func marshalSignatureJSON(txConfig client.TxConfig, txBldr client.TxBuilder, signatureOnly bool) ([]byte, error) {
 	parsedTx := txBldr.GetTx()
 	if signatureOnly {
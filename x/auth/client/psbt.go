@@ -0,0 +1,91 @@
+package client
+
+import (
+	"io"
+	"os"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExportTxPSBT returns the PSBT a Bitcoin wallet signs to produce the signature
+// of the `name` key for the transaction managed by the TxBuilder. The key
+// signs for its own account if multisigAddr is nil, or on behalf of the
+// multisig account multisigAddr otherwise, as in SignTxWithSignerAddress.
+// Don't perform online validation or lookups if offline is true.
+func ExportTxPSBT(txFactory tx.Factory, clientCtx client.Context, name string, multisigAddr sdk.AccAddress,
+	txBuilder client.TxBuilder, offline, overwriteSig bool,
+) (*psbt.Packet, error) {
+	txFactory, pubKey, err := psbtTxFactory(txFactory, clientCtx, name, multisigAddr, txBuilder, offline)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.ExportPSBT(clientCtx.CmdContext, txFactory, pubKey, txBuilder, overwriteSig)
+}
+
+// SignTxWithPSBT attaches the signature of the `name` key extracted from a
+// PSBT exported by ExportTxPSBT, with the same arguments, and signed by a
+// Bitcoin wallet.
+func SignTxWithPSBT(txFactory tx.Factory, clientCtx client.Context, name string, multisigAddr sdk.AccAddress,
+	txBuilder client.TxBuilder, packet *psbt.Packet, offline, overwriteSig bool,
+) error {
+	txFactory, pubKey, err := psbtTxFactory(txFactory, clientCtx, name, multisigAddr, txBuilder, offline)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.SignWithPSBT(clientCtx.CmdContext, txFactory, pubKey, txBuilder, packet, overwriteSig); err != nil {
+		return err
+	}
+
+	return txFactory.PreprocessTx(name, txBuilder)
+}
+
+// ReadPSBTFromFile reads a binary or base64 encoded PSBT from the given
+// filename. Can pass "-" to read from stdin.
+func ReadPSBTFromFile(filename string) (*psbt.Packet, error) {
+	var (
+		bz  []byte
+		err error
+	)
+
+	if filename == "-" {
+		bz, err = io.ReadAll(os.Stdin)
+	} else {
+		bz, err = os.ReadFile(filename)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return taproot.DecodePSBT(bz)
+}
+
+func psbtTxFactory(txFactory tx.Factory, clientCtx client.Context, name string, multisigAddr sdk.AccAddress,
+	txBuilder client.TxBuilder, offline bool,
+) (tx.Factory, cryptotypes.PubKey, error) {
+	k, err := txFactory.Keybase().Key(name)
+	if err != nil {
+		return txFactory, nil, err
+	}
+
+	pubKey, err := k.GetPubKey()
+	if err != nil {
+		return txFactory, nil, err
+	}
+
+	if multisigAddr == nil {
+		txFactory, err = signTxFactory(txFactory, clientCtx, name, txBuilder, offline)
+	} else {
+		txFactory, err = signerAddressTxFactory(txFactory, clientCtx, multisigAddr, offline)
+	}
+
+	return txFactory, pubKey, err
+}
@@ -32,11 +32,22 @@ func (gr GasEstimateResponse) String() string {
 // The new signature is appended to the TxBuilder when overwrite=false or overwritten otherwise.
 // Don't perform online validation or lookups if offline is true.
 func SignTx(txFactory tx.Factory, clientCtx client.Context, name string, txBuilder client.TxBuilder, offline, overwriteSig bool) error {
-	k, err := txFactory.Keybase().Key(name)
+	txFactory, err := signTxFactory(txFactory, clientCtx, name, txBuilder, offline)
 	if err != nil {
 		return err
 	}
 
+	return tx.Sign(clientCtx.CmdContext, txFactory, name, txBuilder, overwriteSig)
+}
+
+// signTxFactory checks that the `name` key signs the transaction managed by
+// the TxBuilder, and returns txFactory set up to sign it with the key.
+func signTxFactory(txFactory tx.Factory, clientCtx client.Context, name string, txBuilder client.TxBuilder, offline bool) (tx.Factory, error) {
+	k, err := txFactory.Keybase().Key(name)
+	if err != nil {
+		return txFactory, err
+	}
+
 	// Ledger and Multisigs only support LEGACY_AMINO_JSON signing.
 	if txFactory.SignMode() == signing.SignMode_SIGN_MODE_UNSPECIFIED &&
 		(k.GetType() == keyring.TypeLedger || k.GetType() == keyring.TypeMulti) {
@@ -45,24 +56,21 @@ func SignTx(txFactory tx.Factory, clientCtx client.Context, name string, txBuild
 
 	pubKey, err := k.GetPubKey()
 	if err != nil {
-		return err
+		return txFactory, err
 	}
 	addr := sdk.AccAddress(pubKey.Address())
 	signers, err := txBuilder.GetTx().GetSigners()
 	if err != nil {
-		return err
+		return txFactory, err
 	}
 	if !isTxSigner(addr, signers) {
-		return fmt.Errorf("%w: %s", errors.ErrorInvalidSigner, name)
+		return txFactory, fmt.Errorf("%w: %s", errors.ErrorInvalidSigner, name)
 	}
 	if !offline {
-		txFactory, err = populateAccountFromState(txFactory, clientCtx, addr)
-		if err != nil {
-			return err
-		}
+		return populateAccountFromState(txFactory, clientCtx, addr)
 	}
 
-	return tx.Sign(clientCtx.CmdContext, txFactory, name, txBuilder, overwriteSig)
+	return txFactory, nil
 }
 
 // SignTxWithSignerAddress attaches a signature to a transaction.
@@ -73,19 +81,27 @@ func SignTx(txFactory tx.Factory, clientCtx client.Context, name string, txBuild
 func SignTxWithSignerAddress(txFactory tx.Factory, clientCtx client.Context, addr sdk.AccAddress,
 	name string, txBuilder client.TxBuilder, offline, overwrite bool,
 ) (err error) {
+	txFactory, err = signerAddressTxFactory(txFactory, clientCtx, addr, offline)
+	if err != nil {
+		return err
+	}
+
+	return tx.Sign(clientCtx.CmdContext, txFactory, name, txBuilder, overwrite)
+}
+
+// signerAddressTxFactory returns txFactory set up to sign on behalf of the
+// multisig account addr.
+func signerAddressTxFactory(txFactory tx.Factory, clientCtx client.Context, addr sdk.AccAddress, offline bool) (tx.Factory, error) {
 	// Multisigs only support LEGACY_AMINO_JSON signing.
 	if txFactory.SignMode() == signing.SignMode_SIGN_MODE_UNSPECIFIED {
 		txFactory = txFactory.WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
 	}
 
 	if !offline {
-		txFactory, err = populateAccountFromState(txFactory, clientCtx, addr)
-		if err != nil {
-			return err
-		}
+		return populateAccountFromState(txFactory, clientCtx, addr)
 	}
 
-	return tx.Sign(clientCtx.CmdContext, txFactory, name, txBuilder, overwrite)
+	return txFactory, nil
 }
 
 // Read and decode a StdTx from the given filename. Can pass "-" to read from stdin.
@@ -6,8 +6,9 @@ import (
 
 	"cosmossdk.io/core/address"
 
-	originBtcutil "github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
 )
 
 type taprootCodec struct {
@@ -20,42 +21,22 @@ func NewTaprootCodec(btcNetworkParams *chaincfg.Params) address.Codec {
 	return taprootCodec{btcNetworkParams}
 }
 
-// StringToBytes encodes text to bytes
+// StringToBytes encodes text to bytes. Both P2TR and P2WPKH addresses are
+// accepted.
 func (bc taprootCodec) StringToBytes(text string) ([]byte, error) {
 	if len(strings.TrimSpace(text)) == 0 {
 		return []byte{}, errors.New("empty address string is not allowed")
 	}
 
-	address, err := originBtcutil.DecodeAddress(text, bc.btcNetworkParams)
-	if err != nil {
-		return nil, err
-	}
-
-	if _, ok := address.(*originBtcutil.AddressTaproot); !ok {
-		return nil, errors.New("address is not a taproot address")
-	}
-
-	if address.ScriptAddress() == nil {
-		return nil, errors.New("invalid taproot address with nil script address")
-	}
-
-	return address.ScriptAddress(), nil
+	return taproot.DecodeSegwitAddress(text, bc.btcNetworkParams)
 }
 
-// BytesToString decodes bytes to text
+// BytesToString decodes bytes to text, as a P2TR address for 32-byte
+// addresses and as a P2WPKH address for 20-byte ones.
 func (bc taprootCodec) BytesToString(bz []byte) (string, error) {
 	if len(bz) == 0 {
 		return "", nil
 	}
 
-	if len(bz) != 32 {
-		return "", errors.New("invalid taproot address with invalid length")
-	}
-
-	addr, err := originBtcutil.NewAddressTaproot(bz, bc.btcNetworkParams)
-	if err != nil {
-		return "", err
-	}
-
-	return addr.String(), nil
+	return taproot.EncodeSegwitAddress(bz, bc.btcNetworkParams)
 }
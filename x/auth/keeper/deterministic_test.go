@@ -200,12 +200,14 @@ func (suite *DeterministicTestSuite) TestGRPCQueryAccountAddressByID() {
 
 func (suite *DeterministicTestSuite) TestGRPCQueryParameters() {
 	rapid.Check(suite.T(), func(t *rapid.T) {
-		params := types.NewParams(
+		params := types.NewParamsWithTaprootCosts(
 			rapid.Uint64Min(1).Draw(t, "max-memo-characters"),
 			rapid.Uint64Min(1).Draw(t, "tx-sig-limit"),
 			rapid.Uint64Min(1).Draw(t, "tx-size-cost-per-byte"),
 			rapid.Uint64Min(1).Draw(t, "sig-verify-cost-ed25519"),
 			rapid.Uint64Min(1).Draw(t, "sig-verify-cost-Secp256k1"),
+			rapid.Uint64Min(1).Draw(t, "sig-verify-cost-taproot"),
+			rapid.Uint64Min(1).Draw(t, "sig-verify-cost-schnorr"),
 		)
 		err := suite.accountKeeper.Params.Set(suite.ctx, params)
 		suite.Require().NoError(err)
@@ -215,13 +217,13 @@ func (suite *DeterministicTestSuite) TestGRPCQueryParameters() {
 	})
 
 	// Regression test
-	params := types.NewParams(15, 167, 100, 1, 21457)
+	params := types.NewParamsWithTaprootCosts(15, 167, 100, 1, 21457, 1120, 1060)
 
 	err := suite.accountKeeper.Params.Set(suite.ctx, params)
 	suite.Require().NoError(err)
 
 	req := &types.QueryParamsRequest{}
-	testdata.DeterministicIterations(suite.ctx, suite.T(), req, suite.queryClient.Params, 1042, false)
+	testdata.DeterministicIterations(suite.ctx, suite.T(), req, suite.queryClient.Params, 1060, false)
 }
 
 func (suite *DeterministicTestSuite) TestGRPCQueryAccountInfo() {
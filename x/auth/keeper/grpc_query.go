@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sort"
 	"strings"
 
@@ -10,6 +11,8 @@ import (
 	"google.golang.org/grpc/status"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -17,6 +20,10 @@ import (
 
 var _ types.QueryServer = queryServer{}
 
+// taprootOutputKeySize is the size of taproot addresses, which are the x-only
+// output keys of their accounts.
+const taprootOutputKeySize = 32
+
 func NewQueryServer(k AccountKeeper) types.QueryServer {
 	return queryServer{k: k}
 }
@@ -245,3 +252,66 @@ func (s queryServer) AccountInfo(ctx context.Context, req *types.QueryAccountInf
 		},
 	}, nil
 }
+
+// VerifyMessage verifies the BIP-322 signature of a message by the taproot
+// output key of an account. The signature is verified against the address of
+// accounts without a public key yet, if it is a taproot output key. Raw Schnorr
+// signatures are not BIP-322 signatures and are reported as invalid.
+func (s queryServer) VerifyMessage(ctx context.Context, req *types.QueryVerifyMessageRequest) (*types.QueryVerifyMessageResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	if len(req.Signature) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "signature cannot be empty")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	var pubKey cryptotypes.PubKey
+	if account := s.k.GetAccount(ctx, addr); account != nil {
+		pubKey = account.GetPubKey()
+	}
+
+	var outputKey []byte
+	switch {
+	case pubKey != nil:
+		outputKey, err = taprootOutputKey(pubKey)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "account %s: %s", req.Address, err)
+		}
+	case len(addr) == taprootOutputKeySize:
+		outputKey = addr
+	default:
+		return nil, status.Errorf(codes.NotFound, "public key of account %s not found", req.Address)
+	}
+
+	return &types.QueryVerifyMessageResponse{
+		Valid:  taproot.Bip322VerifyOutputKey(req.Message, req.Signature, outputKey, taproot.GetBitcoinNetParams()) == nil,
+		Format: taproot.DetectBip322Format(req.Signature).String(),
+	}, nil
+}
+
+// taprootOutputKey returns the x-only taproot output key of pubKey, which is
+// the key BIP-322 signatures of the account are made with.
+func taprootOutputKey(pubKey cryptotypes.PubKey) ([]byte, error) {
+	switch pk := pubKey.(type) {
+	case *taproot.PubKey:
+		return pk.Address(), nil
+	case interface{ OutputKey() (*taproot.PubKey, error) }:
+		outputKey, err := pk.OutputKey()
+		if err != nil {
+			return nil, err
+		}
+		return outputKey.Address(), nil
+	default:
+		return nil, fmt.Errorf("%s key is not a taproot key", pubKey.Type())
+	}
+}
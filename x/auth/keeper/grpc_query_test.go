@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/gogoproto/proto"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -535,3 +536,61 @@ func (suite *KeeperTestSuite) TestQueryAccountInfoWithoutPubKey() {
 	suite.Require().Equal(addr.String(), res.Info.Address)
 	suite.Require().Nil(res.Info.PubKey)
 }
+
+func (suite *KeeperTestSuite) TestQueryVerifyMessage() {
+	privKey := taproot.GenPrivKey()
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+	addrStr, err := suite.accountKeeper.AddressCodec().BytesToString(addr)
+	suite.Require().NoError(err)
+	msg := []byte("proof of ownership")
+	sig, err := privKey.Sign(msg)
+	suite.Require().NoError(err)
+
+	// the address of an account without public key is its output key
+	res, err := suite.queryClient.VerifyMessage(context.Background(), &types.QueryVerifyMessageRequest{
+		Address: addrStr, Message: msg, Signature: sig,
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Valid)
+	suite.Require().Equal("simple", res.Format)
+
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.Require().NoError(acc.SetPubKey(privKey.PubKey()))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	res, err = suite.queryClient.VerifyMessage(context.Background(), &types.QueryVerifyMessageRequest{
+		Address: addrStr, Message: msg, Signature: sig,
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Valid)
+
+	res, err = suite.queryClient.VerifyMessage(context.Background(), &types.QueryVerifyMessageRequest{
+		Address: addrStr, Message: []byte("another message"), Signature: sig,
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Valid)
+
+	// a raw Schnorr signature is a valid transaction signature, but not a
+	// BIP-322 signature
+	schnorrSig, err := privKey.SignSchnorr(msg)
+	suite.Require().NoError(err)
+	suite.Require().True(privKey.PubKey().VerifySignature(msg, schnorrSig))
+	res, err = suite.queryClient.VerifyMessage(context.Background(), &types.QueryVerifyMessageRequest{
+		Address: addrStr, Message: msg, Signature: schnorrSig,
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Valid)
+
+	otherSig, err := taproot.GenPrivKey().Sign(msg)
+	suite.Require().NoError(err)
+	res, err = suite.queryClient.VerifyMessage(context.Background(), &types.QueryVerifyMessageRequest{
+		Address: addrStr, Message: msg, Signature: otherSig,
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Valid)
+
+	_, err = suite.queryClient.VerifyMessage(context.Background(), &types.QueryVerifyMessageRequest{
+		Address: addrStr, Message: msg,
+	})
+	suite.Require().ErrorContains(err, "signature cannot be empty")
+}
@@ -153,7 +153,7 @@ func NewAccountKeeper(
 		Params:          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		AccountNumber:   collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
 		Accounts:        collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
-		UnorderedNonces: collections.NewKeySet(sb, types.UnorderedNoncesKey, "unordered_nonces", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey)),
+		UnorderedNonces: collections.NewKeySet(sb, types.UnorderedNoncesKey, "unordered_nonces", collections.NamedPairKeyCodec("timeout", collections.Int64Key, "sender", collections.BytesKey)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
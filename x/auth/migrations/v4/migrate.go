@@ -20,7 +20,12 @@ func Migrate(ctx sdk.Context, storeService storetypes.KVStoreService, legacySubs
 	var currParams types.Params
 	legacySubspace.GetParamSet(ctx, &currParams)
 
-	if err := currParams.Validate(); err != nil {
+	// x/params never managed the taproot signature verification costs, they
+	// are stored unset and set by the v6 migration.
+	legacyParams := currParams
+	legacyParams.SigVerifyCostTaproot = types.DefaultSigVerifyCostTaproot
+	legacyParams.SigVerifyCostSchnorr = types.DefaultSigVerifyCostSchnorr
+	if err := legacyParams.Validate(); err != nil {
 		return err
 	}
 
@@ -0,0 +1,33 @@
+package v6
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Migrate migrates the x/auth module state from the consensus version 5 to 6.
+// It sets the newly introduced SigVerifyCostTaproot parameter, which BIP-322
+// signatures of taproot keys were previously charged under SigVerifyCostSecp256k1,
+// and the SigVerifyCostSchnorr parameter to their default values.
+func Migrate(ctx context.Context, params collections.Item[authtypes.Params]) error {
+	currParams, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if currParams.SigVerifyCostTaproot == 0 {
+		currParams.SigVerifyCostTaproot = authtypes.DefaultSigVerifyCostTaproot
+	}
+	if currParams.SigVerifyCostSchnorr == 0 {
+		currParams.SigVerifyCostSchnorr = authtypes.DefaultSigVerifyCostSchnorr
+	}
+
+	if err := currParams.Validate(); err != nil {
+		return err
+	}
+
+	return params.Set(ctx, currParams)
+}
@@ -0,0 +1,45 @@
+package v6
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMigrate(t *testing.T) {
+	kv, ctx := colltest.MockStore()
+	sb := collections.NewSchemaBuilder(kv)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	params := collections.NewItem(sb, authtypes.ParamsKey, "params", codec.CollValue[authtypes.Params](cdc))
+
+	// params stored before the taproot cost existed
+	oldParams := authtypes.DefaultParams()
+	oldParams.SigVerifyCostSecp256k1 = 2000
+	oldParams.SigVerifyCostTaproot = 0
+	oldParams.SigVerifyCostSchnorr = 0
+	require.NoError(t, params.Set(ctx, oldParams))
+
+	require.NoError(t, Migrate(ctx, params))
+
+	gotParams, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, authtypes.DefaultSigVerifyCostTaproot, gotParams.SigVerifyCostTaproot)
+	require.Equal(t, authtypes.DefaultSigVerifyCostSchnorr, gotParams.SigVerifyCostSchnorr)
+	require.Equal(t, uint64(2000), gotParams.SigVerifyCostSecp256k1)
+
+	// an already set value is kept
+	gotParams.SigVerifyCostTaproot = 5000
+	require.NoError(t, params.Set(ctx, gotParams))
+	require.NoError(t, Migrate(ctx, params))
+
+	gotParams, err = params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5000), gotParams.SigVerifyCostTaproot)
+}
@@ -17,6 +17,8 @@ const (
 	TxSizeCostPerByte      = "tx_size_cost_per_byte"
 	SigVerifyCostED25519   = "sig_verify_cost_ed25519"
 	SigVerifyCostSECP256K1 = "sig_verify_cost_secp256k1"
+	SigVerifyCostTaproot   = "sig_verify_cost_taproot"
+	SigVerifyCostSchnorr   = "sig_verify_cost_schnorr"
 )
 
 // RandomGenesisAccounts defines the default RandomGenesisAccountsFn used on the SDK.
@@ -88,6 +90,16 @@ func GenSigVerifyCostSECP256K1(r *rand.Rand) uint64 {
 	return uint64(simulation.RandIntBetween(r, 500, 1000))
 }
 
+// GenSigVerifyCostTaproot randomized SigVerifyCostTaproot
+func GenSigVerifyCostTaproot(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 560, 1120))
+}
+
+// GenSigVerifyCostSchnorr randomized SigVerifyCostSchnorr
+func GenSigVerifyCostSchnorr(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 530, 1060))
+}
+
 // RandomizedGenState generates a random GenesisState for auth
 func RandomizedGenState(simState *module.SimulationState, randGenAccountsFn types.RandomGenesisAccountsFn) {
 	var maxMemoChars uint64
@@ -105,8 +117,14 @@ func RandomizedGenState(simState *module.SimulationState, randGenAccountsFn type
 	var sigVerifyCostSECP256K1 uint64
 	simState.AppParams.GetOrGenerate(SigVerifyCostSECP256K1, &sigVerifyCostSECP256K1, simState.Rand, func(r *rand.Rand) { sigVerifyCostSECP256K1 = GenSigVerifyCostSECP256K1(r) })
 
-	params := types.NewParams(maxMemoChars, txSigLimit, txSizeCostPerByte,
-		sigVerifyCostED25519, sigVerifyCostSECP256K1)
+	var sigVerifyCostTaproot uint64
+	simState.AppParams.GetOrGenerate(SigVerifyCostTaproot, &sigVerifyCostTaproot, simState.Rand, func(r *rand.Rand) { sigVerifyCostTaproot = GenSigVerifyCostTaproot(r) })
+
+	var sigVerifyCostSchnorr uint64
+	simState.AppParams.GetOrGenerate(SigVerifyCostSchnorr, &sigVerifyCostSchnorr, simState.Rand, func(r *rand.Rand) { sigVerifyCostSchnorr = GenSigVerifyCostSchnorr(r) })
+
+	params := types.NewParamsWithTaprootCosts(maxMemoChars, txSigLimit, txSizeCostPerByte,
+		sigVerifyCostED25519, sigVerifyCostSECP256K1, sigVerifyCostTaproot, sigVerifyCostSchnorr)
 	genesisAccs := randGenAccountsFn(simState)
 
 	authGenesis := types.NewGenesisState(params, genesisAccs)
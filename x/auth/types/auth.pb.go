@@ -172,6 +172,10 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// sig_verify_cost_taproot is the gas charged for verifying a BIP-322 signature of a taproot key.
+	SigVerifyCostTaproot uint64 `protobuf:"varint,6,opt,name=sig_verify_cost_taproot,json=sigVerifyCostTaproot,proto3" json:"sig_verify_cost_taproot,omitempty"`
+	// sig_verify_cost_schnorr is the gas charged for verifying a raw BIP-340 Schnorr signature of a taproot key.
+	SigVerifyCostSchnorr uint64 `protobuf:"varint,7,opt,name=sig_verify_cost_schnorr,json=sigVerifyCostSchnorr,proto3" json:"sig_verify_cost_schnorr,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -242,6 +246,20 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetSigVerifyCostTaproot() uint64 {
+	if m != nil {
+		return m.SigVerifyCostTaproot
+	}
+	return 0
+}
+
+func (m *Params) GetSigVerifyCostSchnorr() uint64 {
+	if m != nil {
+		return m.SigVerifyCostSchnorr
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
@@ -252,54 +270,56 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 745 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x93, 0xd0, 0xa5, 0x93, 0x6e, 0x97, 0x7a, 0x43, 0xf1, 0x46, 0x28, 0xf6, 0x46, 0x42,
-	0x1b, 0x2a, 0x62, 0x6f, 0x02, 0x05, 0x35, 0xb7, 0x26, 0x20, 0x54, 0x95, 0x96, 0xca, 0x11, 0x3d,
-	0xf4, 0x62, 0x8d, 0x9d, 0xa9, 0x3b, 0x6a, 0xc6, 0x63, 0x3c, 0xe3, 0x2a, 0xee, 0x2f, 0xa8, 0x38,
-	0x21, 0x2e, 0x5c, 0x0b, 0xbf, 0xa0, 0x87, 0xfe, 0x08, 0xc4, 0xa9, 0xea, 0x05, 0x4e, 0x11, 0x4a,
-	0x0f, 0xad, 0x10, 0x3f, 0x02, 0x79, 0xc6, 0x69, 0x92, 0x6e, 0x2e, 0x91, 0xdf, 0xf7, 0x7d, 0xef,
-	0xbd, 0xef, 0xbd, 0x3c, 0x1b, 0x54, 0x3d, 0xca, 0x08, 0x65, 0x16, 0x8c, 0xf9, 0x89, 0x75, 0xd6,
-	0x74, 0x11, 0x87, 0x4d, 0x11, 0x98, 0x61, 0x44, 0x39, 0x55, 0x5f, 0x4a, 0xde, 0x14, 0x50, 0xc6,
-	0x57, 0xd6, 0x20, 0xc1, 0x01, 0xb5, 0xc4, 0xaf, 0xd4, 0x55, 0x5e, 0x49, 0x9d, 0x23, 0x22, 0x2b,
-	0x4b, 0x92, 0x54, 0xd9, 0xa7, 0x3e, 0x95, 0x78, 0xfa, 0x34, 0x49, 0xf0, 0x29, 0xf5, 0x07, 0xc8,
-	0x12, 0x91, 0x1b, 0x1f, 0x5b, 0x30, 0x48, 0x24, 0x55, 0xfb, 0x2d, 0x0f, 0x4a, 0x1d, 0xc8, 0xd0,
-	0xb6, 0xe7, 0xd1, 0x38, 0xe0, 0x6a, 0x0b, 0x3c, 0x83, 0xfd, 0x7e, 0x84, 0x18, 0xd3, 0x14, 0x43,
-	0xa9, 0x2f, 0x77, 0xb4, 0xdb, 0xeb, 0x46, 0x39, 0xeb, 0xb1, 0x2d, 0x99, 0x1e, 0x8f, 0x70, 0xe0,
-	0xdb, 0x13, 0xa1, 0x7a, 0x08, 0x9e, 0x85, 0xb1, 0xeb, 0x9c, 0xa2, 0x44, 0xcb, 0x1b, 0x4a, 0xbd,
-	0xd4, 0x2a, 0x9b, 0xb2, 0xa1, 0x39, 0x69, 0x68, 0x6e, 0x07, 0x49, 0xe7, 0xcd, 0xbf, 0x23, 0xbd,
-	0x1c, 0xc6, 0xee, 0x00, 0x7b, 0xa9, 0xf6, 0x33, 0x4a, 0x30, 0x47, 0x24, 0xe4, 0xc9, 0xef, 0xf7,
-	0x57, 0x1b, 0x60, 0x4a, 0xd8, 0x4b, 0x61, 0xec, 0xee, 0xa2, 0x44, 0xfd, 0x04, 0xac, 0x42, 0x69,
-	0xcb, 0x09, 0x62, 0xe2, 0xa2, 0x48, 0x2b, 0x18, 0x4a, 0xbd, 0x68, 0x3f, 0xcf, 0xd0, 0x7d, 0x01,
-	0xaa, 0x15, 0xf0, 0x3e, 0x43, 0x3f, 0xc6, 0x28, 0xf0, 0x90, 0x56, 0x14, 0x82, 0xc7, 0xb8, 0xdd,
-	0xbd, 0xb8, 0xd4, 0x73, 0x0f, 0x97, 0x7a, 0xee, 0xcf, 0xeb, 0xc6, 0xc7, 0x0b, 0xd6, 0x6b, 0x66,
-	0x73, 0xef, 0xfc, 0x74, 0x7f, 0xb5, 0xb1, 0x2e, 0x05, 0x0d, 0xd6, 0x3f, 0xb5, 0x66, 0x76, 0x52,
-	0xfb, 0x4f, 0x01, 0xcf, 0xf7, 0x68, 0x3f, 0x1e, 0x3c, 0x6e, 0x69, 0x07, 0xac, 0xb8, 0x90, 0x21,
-	0x27, 0x33, 0x22, 0x56, 0x55, 0x6a, 0x19, 0xe6, 0xa2, 0x0e, 0x33, 0x95, 0x3a, 0xc5, 0x9b, 0x91,
-	0xae, 0xd8, 0x25, 0x77, 0x66, 0xe1, 0x2a, 0x28, 0x06, 0x90, 0x20, 0xb1, 0xb9, 0x65, 0x5b, 0x3c,
-	0xab, 0x06, 0x28, 0x85, 0x28, 0x22, 0x98, 0x31, 0x4c, 0x03, 0xa6, 0x15, 0x8c, 0x42, 0x7d, 0xd9,
-	0x9e, 0x85, 0xda, 0x47, 0x17, 0x72, 0xa6, 0xda, 0xa2, 0x8e, 0x73, 0x5e, 0xc5, 0x64, 0xda, 0xcc,
-	0x64, 0x73, 0xec, 0x2f, 0xf7, 0x57, 0x1b, 0xab, 0x44, 0x20, 0x93, 0x61, 0x6a, 0xbf, 0x2a, 0xe0,
-	0x03, 0x29, 0xea, 0x46, 0xa8, 0x8f, 0x02, 0x8e, 0xe1, 0x40, 0xd5, 0x41, 0x29, 0x93, 0x09, 0xb7,
-	0xe2, 0x36, 0x6c, 0x20, 0xa1, 0xfd, 0xd4, 0xf3, 0x1b, 0xf0, 0xa2, 0x8f, 0x22, 0x7c, 0x06, 0x39,
-	0xa6, 0x41, 0xfa, 0x37, 0x32, 0x2d, 0x6f, 0x14, 0xea, 0x2b, 0xf6, 0xea, 0x14, 0xde, 0x45, 0x09,
-	0x6b, 0x6f, 0xdd, 0x5e, 0x37, 0x5e, 0x4c, 0xfd, 0x18, 0x6f, 0xcd, 0x2f, 0xbe, 0x4a, 0x3d, 0xbe,
-	0x9e, 0xf1, 0xf8, 0x6d, 0x44, 0xe3, 0x30, 0xb3, 0x38, 0x35, 0x51, 0xfb, 0x2b, 0x0f, 0x96, 0x0e,
-	0x60, 0x04, 0x09, 0x53, 0x4d, 0xf0, 0x92, 0xc0, 0xa1, 0x43, 0x10, 0xa1, 0x8e, 0x77, 0x02, 0x23,
-	0xe8, 0x71, 0x14, 0xc9, 0x9b, 0x2d, 0xda, 0x6b, 0x04, 0x0e, 0xf7, 0x10, 0xa1, 0xdd, 0x47, 0x42,
-	0x35, 0xc0, 0x0a, 0x1f, 0x3a, 0x0c, 0xfb, 0xce, 0x00, 0x13, 0xcc, 0xc5, 0xba, 0x8b, 0x36, 0xe0,
-	0xc3, 0x1e, 0xf6, 0xbf, 0x4b, 0x11, 0xf5, 0x2d, 0xf8, 0x50, 0x28, 0xce, 0x91, 0xe3, 0x51, 0xc6,
-	0x9d, 0x10, 0x45, 0x8e, 0x9b, 0x70, 0x94, 0x1d, 0xdd, 0x5a, 0x2a, 0x3d, 0x47, 0x5d, 0xca, 0xf8,
-	0x01, 0x8a, 0x3a, 0x09, 0x47, 0xea, 0xf7, 0xe0, 0xa3, 0xb4, 0xe0, 0x19, 0x8a, 0xf0, 0x71, 0x22,
-	0x93, 0x50, 0xbf, 0xb5, 0xb9, 0xd9, 0xdc, 0x92, 0x77, 0xd8, 0xd1, 0xc6, 0x23, 0xbd, 0xdc, 0xc3,
-	0xfe, 0xa1, 0x50, 0xa4, 0xa9, 0xdf, 0x7c, 0x2d, 0x78, 0xbb, 0xcc, 0xe6, 0x50, 0x99, 0xa5, 0xfe,
-	0x00, 0x5e, 0x3d, 0x2d, 0xc8, 0x90, 0x17, 0xb6, 0x36, 0xbf, 0x3c, 0x6d, 0x6a, 0xef, 0x89, 0x92,
-	0x95, 0xf1, 0x48, 0x5f, 0x9f, 0x2b, 0xd9, 0x9b, 0x28, 0xec, 0x75, 0xb6, 0x10, 0x6f, 0xbf, 0x7e,
-	0xb8, 0xd4, 0x95, 0xa7, 0x67, 0x30, 0x94, 0x9f, 0x21, 0xb9, 0xce, 0x4e, 0xf7, 0x8f, 0x71, 0x55,
-	0xb9, 0x19, 0x57, 0x95, 0x7f, 0xc6, 0x55, 0xe5, 0xe7, 0xbb, 0x6a, 0xee, 0xe6, 0xae, 0x9a, 0xfb,
-	0xfb, 0xae, 0x9a, 0x3b, 0xfa, 0xd4, 0xc7, 0xfc, 0x24, 0x76, 0x4d, 0x8f, 0x92, 0xec, 0x53, 0x63,
-	0xbd, 0x5b, 0x85, 0x27, 0x21, 0x62, 0xee, 0x92, 0x78, 0xdd, 0x3f, 0xff, 0x3f, 0x00, 0x00, 0xff,
-	0xff, 0x8c, 0x05, 0x77, 0x9c, 0xe8, 0x04, 0x00, 0x00,
+	// 784 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xc6, 0x26, 0x21, 0xe3, 0x34, 0x25, 0x5b, 0x13, 0xb6, 0x16, 0xf2, 0x6e, 0x2d, 0xa1,
+	0x9a, 0x08, 0xef, 0xd6, 0x86, 0x80, 0xea, 0x5b, 0x6c, 0x10, 0xaa, 0x4a, 0x4b, 0xb5, 0x86, 0x1e,
+	0x7a, 0x59, 0xcd, 0xae, 0xa7, 0xeb, 0x51, 0x3c, 0x3b, 0xcb, 0xcc, 0x6c, 0xe4, 0xed, 0x27, 0xa8,
+	0x38, 0x21, 0x2e, 0x5c, 0x03, 0x9f, 0x20, 0x87, 0x7c, 0x08, 0xc4, 0x29, 0xea, 0x89, 0x93, 0x85,
+	0x9c, 0x43, 0x2a, 0xc4, 0x17, 0xe0, 0x86, 0x76, 0x66, 0x1d, 0xff, 0xc1, 0xb9, 0xac, 0x76, 0x7e,
+	0xbf, 0xdf, 0x7b, 0xef, 0xf7, 0xde, 0x3c, 0x0d, 0xa8, 0x05, 0x94, 0x13, 0xca, 0x1d, 0x98, 0x88,
+	0xa1, 0x73, 0xd2, 0xf2, 0x91, 0x80, 0x2d, 0x79, 0xb0, 0x63, 0x46, 0x05, 0xd5, 0xef, 0x28, 0xde,
+	0x96, 0x50, 0xce, 0x57, 0xf7, 0x20, 0xc1, 0x11, 0x75, 0xe4, 0x57, 0xe9, 0xaa, 0x77, 0x95, 0xce,
+	0x93, 0x27, 0x27, 0x0f, 0x52, 0x54, 0x25, 0xa4, 0x21, 0x55, 0x78, 0xf6, 0x37, 0x0b, 0x08, 0x29,
+	0x0d, 0x47, 0xc8, 0x91, 0x27, 0x3f, 0x79, 0xe9, 0xc0, 0x28, 0x55, 0x54, 0xfd, 0xd7, 0x0d, 0x50,
+	0xee, 0x42, 0x8e, 0x8e, 0x82, 0x80, 0x26, 0x91, 0xd0, 0xdb, 0x60, 0x0b, 0x0e, 0x06, 0x0c, 0x71,
+	0x6e, 0x68, 0x96, 0xd6, 0xd8, 0xee, 0x1a, 0x6f, 0xce, 0x9b, 0x95, 0xbc, 0xc6, 0x91, 0x62, 0xfa,
+	0x82, 0xe1, 0x28, 0x74, 0x67, 0x42, 0xfd, 0x39, 0xd8, 0x8a, 0x13, 0xdf, 0x3b, 0x46, 0xa9, 0xb1,
+	0x61, 0x69, 0x8d, 0x72, 0xbb, 0x62, 0xab, 0x82, 0xf6, 0xac, 0xa0, 0x7d, 0x14, 0xa5, 0xdd, 0xfb,
+	0x7f, 0x4f, 0xcc, 0x4a, 0x9c, 0xf8, 0x23, 0x1c, 0x64, 0xda, 0x4f, 0x28, 0xc1, 0x02, 0x91, 0x58,
+	0xa4, 0xbf, 0x5d, 0x9d, 0x1d, 0x80, 0x39, 0xe1, 0x6e, 0xc6, 0x89, 0xff, 0x18, 0xa5, 0xfa, 0x47,
+	0x60, 0x17, 0x2a, 0x5b, 0x5e, 0x94, 0x10, 0x1f, 0x31, 0xa3, 0x68, 0x69, 0x8d, 0x92, 0x7b, 0x2b,
+	0x47, 0x9f, 0x4a, 0x50, 0xaf, 0x82, 0x77, 0x39, 0xfa, 0x21, 0x41, 0x51, 0x80, 0x8c, 0x92, 0x14,
+	0x5c, 0x9f, 0x3b, 0xbd, 0xd7, 0xa7, 0x66, 0xe1, 0xed, 0xa9, 0x59, 0xf8, 0xe3, 0xbc, 0xf9, 0xe1,
+	0x9a, 0xf1, 0xda, 0x79, 0xdf, 0x8f, 0x7e, 0xbc, 0x3a, 0x3b, 0xd8, 0x57, 0x82, 0x26, 0x1f, 0x1c,
+	0x3b, 0x0b, 0x33, 0xa9, 0xff, 0xa3, 0x81, 0x5b, 0x4f, 0xe8, 0x20, 0x19, 0x5d, 0x4f, 0xe9, 0x11,
+	0xd8, 0xf1, 0x21, 0x47, 0x5e, 0x6e, 0x44, 0x8e, 0xaa, 0xdc, 0xb6, 0xec, 0x75, 0x15, 0x16, 0x32,
+	0x75, 0x4b, 0x17, 0x13, 0x53, 0x73, 0xcb, 0xfe, 0xc2, 0xc0, 0x75, 0x50, 0x8a, 0x20, 0x41, 0x72,
+	0x72, 0xdb, 0xae, 0xfc, 0xd7, 0x2d, 0x50, 0x8e, 0x11, 0x23, 0x98, 0x73, 0x4c, 0x23, 0x6e, 0x14,
+	0xad, 0x62, 0x63, 0xdb, 0x5d, 0x84, 0x3a, 0x2f, 0x5e, 0xab, 0x9e, 0xea, 0xeb, 0x2a, 0x2e, 0x79,
+	0x95, 0x9d, 0x19, 0x0b, 0x9d, 0x2d, 0xb1, 0x3f, 0x5f, 0x9d, 0x1d, 0xec, 0x12, 0x89, 0xcc, 0x9a,
+	0xa9, 0xff, 0xa2, 0x81, 0xf7, 0x94, 0xa8, 0xc7, 0xd0, 0x00, 0x45, 0x02, 0xc3, 0x91, 0x6e, 0x82,
+	0x72, 0x2e, 0x93, 0x6e, 0xe5, 0x6e, 0xb8, 0x40, 0x41, 0x4f, 0x33, 0xcf, 0xf7, 0xc1, 0xed, 0x01,
+	0x62, 0xf8, 0x04, 0x0a, 0x4c, 0xa3, 0xec, 0x1a, 0xb9, 0xb1, 0x61, 0x15, 0x1b, 0x3b, 0xee, 0xee,
+	0x1c, 0x7e, 0x8c, 0x52, 0xde, 0x79, 0xf8, 0xe6, 0xbc, 0x79, 0x7b, 0xee, 0xc7, 0x7a, 0x60, 0x7f,
+	0xf6, 0x45, 0xe6, 0xf1, 0xde, 0x82, 0xc7, 0xaf, 0x19, 0x4d, 0xe2, 0xdc, 0xe2, 0xdc, 0x44, 0xfd,
+	0xdf, 0x22, 0xd8, 0x7c, 0x06, 0x19, 0x24, 0x5c, 0xb7, 0xc1, 0x1d, 0x02, 0xc7, 0x1e, 0x41, 0x84,
+	0x7a, 0xc1, 0x10, 0x32, 0x18, 0x08, 0xc4, 0xd4, 0xce, 0x96, 0xdc, 0x3d, 0x02, 0xc7, 0x4f, 0x10,
+	0xa1, 0xbd, 0x6b, 0x42, 0xb7, 0xc0, 0x8e, 0x18, 0x7b, 0x1c, 0x87, 0xde, 0x08, 0x13, 0x2c, 0xe4,
+	0xb8, 0x4b, 0x2e, 0x10, 0xe3, 0x3e, 0x0e, 0xbf, 0xc9, 0x10, 0xfd, 0x01, 0x78, 0x5f, 0x2a, 0x5e,
+	0x21, 0x2f, 0xa0, 0x5c, 0x78, 0x31, 0x62, 0x9e, 0x9f, 0x0a, 0x94, 0x2f, 0xdd, 0x5e, 0x26, 0x7d,
+	0x85, 0x7a, 0x94, 0x8b, 0x67, 0x88, 0x75, 0x53, 0x81, 0xf4, 0x6f, 0xc1, 0x07, 0x59, 0xc2, 0x13,
+	0xc4, 0xf0, 0xcb, 0x54, 0x05, 0xa1, 0x41, 0xfb, 0xf0, 0xb0, 0xf5, 0x50, 0xed, 0x61, 0xd7, 0x98,
+	0x4e, 0xcc, 0x4a, 0x1f, 0x87, 0xcf, 0xa5, 0x22, 0x0b, 0xfd, 0xea, 0x4b, 0xc9, 0xbb, 0x15, 0xbe,
+	0x84, 0xaa, 0x28, 0xfd, 0x7b, 0x70, 0x77, 0x35, 0x21, 0x47, 0x41, 0xdc, 0x3e, 0xfc, 0xfc, 0xb8,
+	0x65, 0xbc, 0x23, 0x53, 0x56, 0xa7, 0x13, 0x73, 0x7f, 0x29, 0x65, 0x7f, 0xa6, 0x70, 0xf7, 0xf9,
+	0x5a, 0x7c, 0x9d, 0x4f, 0x01, 0x63, 0x46, 0xa9, 0x30, 0x36, 0x6f, 0xf0, 0xf9, 0x9d, 0xe2, 0x57,
+	0x7c, 0xe6, 0xe8, 0xba, 0x84, 0x3c, 0x18, 0x46, 0x94, 0x31, 0x63, 0xeb, 0x86, 0x84, 0x7d, 0xc5,
+	0xaf, 0x24, 0xcc, 0xd1, 0xce, 0xbd, 0xb7, 0xa7, 0xa6, 0xb6, 0xba, 0xa8, 0x63, 0xf5, 0x50, 0xaa,
+	0x0b, 0xef, 0xf6, 0x7e, 0x9f, 0xd6, 0xb4, 0x8b, 0x69, 0x4d, 0xfb, 0x6b, 0x5a, 0xd3, 0x7e, 0xba,
+	0xac, 0x15, 0x2e, 0x2e, 0x6b, 0x85, 0x3f, 0x2f, 0x6b, 0x85, 0x17, 0x1f, 0x87, 0x58, 0x0c, 0x13,
+	0xdf, 0x0e, 0x28, 0xc9, 0x1f, 0x43, 0xe7, 0xff, 0x59, 0x44, 0x1a, 0x23, 0xee, 0x6f, 0xca, 0x07,
+	0xe9, 0xd3, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x05, 0x9a, 0x97, 0x11, 0x8a, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -336,6 +356,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.SigVerifyCostTaproot != that1.SigVerifyCostTaproot {
+		return false
+	}
+	if this.SigVerifyCostSchnorr != that1.SigVerifyCostSchnorr {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -500,6 +526,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SigVerifyCostSchnorr != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSchnorr))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SigVerifyCostTaproot != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostTaproot))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -625,6 +661,12 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.SigVerifyCostTaproot != 0 {
+		n += 1 + sovAuth(uint64(m.SigVerifyCostTaproot))
+	}
+	if m.SigVerifyCostSchnorr != 0 {
+		n += 1 + sovAuth(uint64(m.SigVerifyCostSchnorr))
+	}
 	return n
 }
 
@@ -1178,6 +1220,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostTaproot", wireType)
+			}
+			m.SigVerifyCostTaproot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostTaproot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSchnorr", wireType)
+			}
+			m.SigVerifyCostSchnorr = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostSchnorr |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -11,16 +11,53 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+
+	// The signature verification costs of taproot keys are set by benchmarking
+	// them against a secp256k1 ECDSA check (crypto/keys/secp256k1/bench_test.go
+	// and crypto/keys/taproot/bench_test.go, median of 10 runs):
+	//
+	//	BenchmarkVerification/secp256k1        9313   293495 ns/op    864 B/op   19 allocs/op
+	//	BenchmarkVerification/taproot          7702   328298 ns/op   6816 B/op   87 allocs/op
+	//	BenchmarkSchnorrVerification/taproot   8017   311473 ns/op    400 B/op    7 allocs/op
+	//
+	// Each cost is DefaultSigVerifyCostSecp256k1 scaled by the ratio of its
+	// time to the secp256k1 one. Transactions only carry BIP-322 simple
+	// signatures, whose verification is dominated by its single Schnorr check:
+	// building the virtual to_spend/to_sign transactions, computing the taproot
+	// sighash and executing the script adds about 12% and 8x the allocations.
+	// The costlier full and legacy formats are only verified offchain.
+
+	// DefaultSigVerifyCostTaproot is the cost of a BIP-322 simple signature,
+	// about 1.12x a secp256k1 ECDSA check.
+	DefaultSigVerifyCostTaproot uint64 = 1120
+	// DefaultSigVerifyCostSchnorr is the cost of a raw BIP-340 Schnorr
+	// signature, about 1.06x a secp256k1 ECDSA check.
+	DefaultSigVerifyCostSchnorr uint64 = 1060
 )
 
-// NewParams creates a new Params object
+// NewParams creates a new Params object, with the default signature
+// verification costs of taproot keys.
 func NewParams(maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64) Params {
+	return NewParamsWithTaprootCosts(
+		maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1,
+		DefaultSigVerifyCostTaproot, DefaultSigVerifyCostSchnorr,
+	)
+}
+
+// NewParamsWithTaprootCosts creates a new Params object, with the given
+// signature verification costs of taproot keys.
+func NewParamsWithTaprootCosts(
+	maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1,
+	sigVerifyCostTaproot, sigVerifyCostSchnorr uint64,
+) Params {
 	return Params{
 		MaxMemoCharacters:      maxMemoCharacters,
 		TxSigLimit:             txSigLimit,
 		TxSizeCostPerByte:      txSizeCostPerByte,
 		SigVerifyCostED25519:   sigVerifyCostED25519,
 		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		SigVerifyCostTaproot:   sigVerifyCostTaproot,
+		SigVerifyCostSchnorr:   sigVerifyCostSchnorr,
 	}
 }
 
@@ -32,6 +69,8 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		SigVerifyCostTaproot:   DefaultSigVerifyCostTaproot,
+		SigVerifyCostSchnorr:   DefaultSigVerifyCostSchnorr,
 	}
 }
 
@@ -47,6 +86,26 @@ func (p Params) SigVerifyCostSecp256r1() uint64 {
 	return p.SigVerifyCostSecp256k1 / 2
 }
 
+// SigVerifyCostTaprootOrDefault returns the gas fee of a BIP-322 signature
+// verification for taproot keys, which is the default one when
+// SigVerifyCostTaproot is unset, as in the params stored before it existed.
+func (p Params) SigVerifyCostTaprootOrDefault() uint64 {
+	if p.SigVerifyCostTaproot == 0 {
+		return DefaultSigVerifyCostTaproot
+	}
+	return p.SigVerifyCostTaproot
+}
+
+// SigVerifyCostSchnorrOrDefault returns the gas fee of a raw BIP-340 Schnorr
+// signature verification for taproot keys, which is the default one when
+// SigVerifyCostSchnorr is unset, as in the params stored before it existed.
+func (p Params) SigVerifyCostSchnorrOrDefault() uint64 {
+	if p.SigVerifyCostSchnorr == 0 {
+		return DefaultSigVerifyCostSchnorr
+	}
+	return p.SigVerifyCostSchnorr
+}
+
 func validateTxSigLimit(i any) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -86,6 +145,32 @@ func validateSigVerifyCostSecp256k1(i any) error {
 	return nil
 }
 
+func validateSigVerifyCostTaproot(i any) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid taproot signature verification cost: %d", v)
+	}
+
+	return nil
+}
+
+func validateSigVerifyCostSchnorr(i any) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid schnorr signature verification cost: %d", v)
+	}
+
+	return nil
+}
+
 func validateMaxMemoCharacters(i any) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -123,6 +208,12 @@ func (p Params) Validate() error {
 	if err := validateSigVerifyCostSecp256k1(p.SigVerifyCostSecp256k1); err != nil {
 		return err
 	}
+	if err := validateSigVerifyCostTaproot(p.SigVerifyCostTaproot); err != nil {
+		return err
+	}
+	if err := validateSigVerifyCostSchnorr(p.SigVerifyCostSchnorr); err != nil {
+		return err
+	}
 	if err := validateMaxMemoCharacters(p.MaxMemoCharacters); err != nil {
 		return err
 	}
@@ -932,6 +932,126 @@ func (m *QueryAccountInfoResponse) GetInfo() *BaseAccount {
 	return nil
 }
 
+// QueryVerifyMessageRequest is the Query/VerifyMessage request type.
+type QueryVerifyMessageRequest struct {
+	// address is the account address string.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// message is the signed message.
+	Message []byte `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// signature is the BIP-322 signature of message, in the simple, full or
+	// legacy format.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *QueryVerifyMessageRequest) Reset()         { *m = QueryVerifyMessageRequest{} }
+func (m *QueryVerifyMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyMessageRequest) ProtoMessage()    {}
+func (*QueryVerifyMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{20}
+}
+func (m *QueryVerifyMessageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyMessageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyMessageRequest.Merge(m, src)
+}
+func (m *QueryVerifyMessageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyMessageRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyMessageRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryVerifyMessageRequest) GetMessage() []byte {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *QueryVerifyMessageRequest) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// QueryVerifyMessageResponse is the Query/VerifyMessage response type.
+type QueryVerifyMessageResponse struct {
+	// valid is true if signature is a signature of message by the account.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// format is the BIP-322 format of signature.
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (m *QueryVerifyMessageResponse) Reset()         { *m = QueryVerifyMessageResponse{} }
+func (m *QueryVerifyMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyMessageResponse) ProtoMessage()    {}
+func (*QueryVerifyMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{21}
+}
+func (m *QueryVerifyMessageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyMessageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyMessageResponse.Merge(m, src)
+}
+func (m *QueryVerifyMessageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyMessageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyMessageResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyMessageResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *QueryVerifyMessageResponse) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -953,84 +1073,93 @@ func init() {
 	proto.RegisterType((*QueryAccountAddressByIDResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDResponse")
 	proto.RegisterType((*QueryAccountInfoRequest)(nil), "cosmos.auth.v1beta1.QueryAccountInfoRequest")
 	proto.RegisterType((*QueryAccountInfoResponse)(nil), "cosmos.auth.v1beta1.QueryAccountInfoResponse")
+	proto.RegisterType((*QueryVerifyMessageRequest)(nil), "cosmos.auth.v1beta1.QueryVerifyMessageRequest")
+	proto.RegisterType((*QueryVerifyMessageResponse)(nil), "cosmos.auth.v1beta1.QueryVerifyMessageResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 1145 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xa6, 0xa1, 0x49, 0x5f, 0x3e, 0x2a, 0xc6, 0xae, 0x08, 0x9b, 0xd4, 0xb6, 0xb6, 0x90,
-	0x2f, 0xea, 0xdd, 0xc6, 0x49, 0x13, 0x88, 0xc4, 0x21, 0x4b, 0xd5, 0x2a, 0x42, 0x20, 0xb3, 0xad,
-	0xa0, 0xf4, 0x62, 0xad, 0xbd, 0x6b, 0x67, 0x45, 0xbd, 0xeb, 0x7a, 0xd7, 0xa8, 0x26, 0xb2, 0x90,
-	0x90, 0x90, 0x7a, 0xe0, 0x80, 0x04, 0x37, 0x2e, 0xf9, 0x13, 0x38, 0x58, 0x42, 0x48, 0xdc, 0xe0,
-	0x50, 0x7c, 0xaa, 0xc2, 0x05, 0x71, 0x40, 0x28, 0x41, 0x82, 0xbf, 0x80, 0x33, 0xf2, 0xcc, 0xdb,
-	0x0f, 0xc7, 0x63, 0x7b, 0xd3, 0xde, 0xec, 0x9d, 0xf7, 0x7e, 0x1f, 0x6f, 0xde, 0xcc, 0x1b, 0xc8,
-	0x94, 0x1d, 0xb7, 0xe6, 0xb8, 0x8a, 0xde, 0xf4, 0x0e, 0x94, 0x4f, 0x37, 0x4a, 0xa6, 0xa7, 0x6f,
-	0x28, 0x8f, 0x9a, 0x66, 0xa3, 0x25, 0xd7, 0x1b, 0x8e, 0xe7, 0x90, 0x24, 0x0b, 0x90, 0x7b, 0x01,
-	0x32, 0x06, 0x88, 0xeb, 0x98, 0x55, 0xd2, 0x5d, 0x93, 0x45, 0x07, 0xb9, 0x75, 0xbd, 0x6a, 0xd9,
-	0xba, 0x67, 0x39, 0x36, 0x03, 0x10, 0x53, 0x55, 0xa7, 0xea, 0xd0, 0x9f, 0x4a, 0xef, 0x17, 0x7e,
-	0x7d, 0xb5, 0xea, 0x38, 0xd5, 0x87, 0xa6, 0x42, 0xff, 0x95, 0x9a, 0x15, 0x45, 0xb7, 0x91, 0x51,
-	0x5c, 0xc2, 0x25, 0xbd, 0x6e, 0x29, 0xba, 0x6d, 0x3b, 0x1e, 0x45, 0x73, 0x71, 0x35, 0xcd, 0x13,
-	0x4c, 0xc5, 0x21, 0x30, 0x5b, 0x2f, 0x32, 0x46, 0x14, 0xcf, 0x96, 0x16, 0x31, 0xd5, 0x17, 0x1c,
-	0xf5, 0x29, 0xb9, 0x90, 0xfa, 0xa0, 0xf7, 0x77, 0xaf, 0x5c, 0x76, 0x9a, 0xb6, 0xe7, 0x6a, 0xe6,
-	0xa3, 0xa6, 0xe9, 0x7a, 0xe4, 0x36, 0x40, 0x68, 0x69, 0x41, 0xc8, 0x0a, 0xab, 0x33, 0xf9, 0x65,
-	0x19, 0x71, 0x7b, 0xfe, 0x65, 0x86, 0x82, 0x52, 0xe4, 0x82, 0x5e, 0x35, 0x31, 0x57, 0x8b, 0x64,
-	0xee, 0x26, 0x8f, 0x3b, 0xb9, 0xcb, 0x2c, 0x2d, 0xe7, 0x1a, 0x9f, 0x64, 0x6f, 0xc8, 0x5b, 0x9b,
-	0xd2, 0xaf, 0x02, 0x5c, 0x39, 0xc3, 0xea, 0xd6, 0x1d, 0xdb, 0x35, 0x89, 0x06, 0xd3, 0x3a, 0x7e,
-	0x5b, 0x10, 0xb2, 0x17, 0x56, 0x67, 0xf2, 0x29, 0x99, 0xd5, 0x45, 0xf6, 0x4b, 0x26, 0xef, 0xd9,
-	0x2d, 0x35, 0xdb, 0xed, 0xe4, 0x96, 0x38, 0x5b, 0x24, 0x23, 0xe2, 0xbe, 0x16, 0xe0, 0x90, 0x3b,
-	0x7d, 0x56, 0x26, 0xa8, 0x95, 0x95, 0xb1, 0x56, 0x98, 0xa0, 0xf1, 0x5e, 0xee, 0x42, 0x32, 0x6a,
-	0xc5, 0xaf, 0x5f, 0x1e, 0xa6, 0x74, 0xc3, 0x68, 0x98, 0xae, 0x4b, 0x8b, 0x77, 0x49, 0x5d, 0x38,
-	0xee, 0xe4, 0x52, 0x48, 0xba, 0xc7, 0x56, 0xee, 0x7a, 0x0d, 0xcb, 0xae, 0x6a, 0x7e, 0xe0, 0xee,
-	0xf4, 0x93, 0xa3, 0x4c, 0xe2, 0xdf, 0xa3, 0x4c, 0x42, 0x3a, 0xe8, 0xdf, 0x95, 0xa0, 0x3c, 0x05,
-	0x98, 0x42, 0x5b, 0xb8, 0x25, 0xcf, 0x5b, 0x1d, 0x1f, 0x46, 0x4a, 0x01, 0xa1, 0x4c, 0x05, 0xbd,
-	0xa1, 0xd7, 0xfc, 0xdd, 0x97, 0x0a, 0x68, 0xca, 0xff, 0x8a, 0xf4, 0x6f, 0xc1, 0xc5, 0x3a, 0xfd,
-	0x82, 0xec, 0x8b, 0x32, 0x8f, 0x84, 0x25, 0xa9, 0x93, 0x4f, 0xff, 0xcc, 0x24, 0x34, 0x4c, 0x90,
-	0x36, 0x40, 0xa4, 0x88, 0xef, 0x39, 0x46, 0xf3, 0xa1, 0x79, 0xa6, 0xdb, 0x78, 0x95, 0xdd, 0x96,
-	0xbe, 0x12, 0x60, 0x91, 0x9b, 0x83, 0x6a, 0xee, 0xc7, 0xec, 0x95, 0xe5, 0x6e, 0x27, 0x27, 0xf1,
-	0x84, 0xf6, 0xe1, 0x46, 0x3a, 0x86, 0x2f, 0xe7, 0x26, 0x64, 0x06, 0xd5, 0xa8, 0xad, 0xf7, 0xf5,
-	0x9a, 0xdf, 0xf8, 0x84, 0xc0, 0xa4, 0xad, 0xd7, 0x4c, 0xb6, 0xe3, 0x1a, 0xfd, 0x2d, 0x7d, 0x06,
-	0xd9, 0xe1, 0x69, 0xe8, 0xe4, 0xc3, 0x78, 0xdb, 0x1a, 0xd7, 0x48, 0xb0, 0xb9, 0xeb, 0x90, 0x54,
-	0xcd, 0xf2, 0xc1, 0x66, 0xbe, 0xd0, 0x30, 0x2b, 0xd6, 0xe3, 0x91, 0xd5, 0x2e, 0x40, 0xaa, 0x3f,
-	0x16, 0xb5, 0x5d, 0x83, 0xb9, 0x12, 0xfd, 0x5e, 0xac, 0xd3, 0x05, 0x34, 0x37, 0x5b, 0x8a, 0x04,
-	0xf3, 0x11, 0x3f, 0x82, 0x45, 0x6c, 0x74, 0xb5, 0xe5, 0x99, 0xee, 0x3d, 0x07, 0xfb, 0x1d, 0x8b,
-	0x75, 0x0d, 0xe6, 0xb0, 0xf1, 0x8b, 0xa5, 0xde, 0x3a, 0x05, 0x9e, 0xd5, 0x66, 0xf5, 0x48, 0x0e,
-	0x1f, 0xf8, 0x01, 0x2c, 0xf1, 0x81, 0x51, 0xf2, 0xeb, 0x30, 0xef, 0x23, 0xbb, 0x74, 0x05, 0x35,
-	0xfb, 0x7c, 0x2c, 0x9c, 0x8f, 0xfd, 0x71, 0x20, 0x9a, 0x45, 0xdd, 0x73, 0x28, 0x87, 0x2f, 0xfa,
-	0x45, 0xa0, 0xef, 0x07, 0xb2, 0xcf, 0x40, 0x87, 0x95, 0x7e, 0xce, 0x82, 0x7c, 0x0e, 0xe9, 0xe8,
-	0x75, 0x11, 0x14, 0x67, 0xff, 0x56, 0xd8, 0x99, 0x13, 0x96, 0x41, 0x01, 0x2f, 0xa8, 0x13, 0x0b,
-	0x82, 0x36, 0x61, 0x19, 0x24, 0x0f, 0x80, 0x8d, 0x52, 0xb4, 0x0c, 0x7a, 0x2f, 0x4e, 0xaa, 0xc9,
-	0x3f, 0x06, 0xe0, 0x77, 0xb4, 0x4b, 0x18, 0xb6, 0x6f, 0xec, 0x5e, 0x39, 0xee, 0xe4, 0x5e, 0x3e,
-	0x43, 0x2f, 0xe7, 0xa5, 0x43, 0x3c, 0x1b, 0x3c, 0x01, 0xe8, 0x6e, 0x0f, 0x2e, 0xfb, 0x6c, 0x71,
-	0x2f, 0xc6, 0x79, 0xbd, 0x0f, 0x6e, 0x18, 0x79, 0x09, 0x5e, 0x89, 0x92, 0xef, 0xdb, 0x15, 0xe7,
-	0x45, 0x6e, 0x61, 0x4e, 0x85, 0x77, 0x24, 0x13, 0x16, 0x06, 0x39, 0xd0, 0xd9, 0x16, 0x4c, 0x5a,
-	0x76, 0xc5, 0xc1, 0xa3, 0x9b, 0xe5, 0xde, 0x89, 0xaa, 0xee, 0xfa, 0xe7, 0x53, 0xa3, 0xd1, 0x5c,
-	0x9a, 0xfc, 0x7f, 0x73, 0xf0, 0x12, 0xe5, 0x21, 0x47, 0x02, 0x4c, 0xfb, 0x37, 0x1e, 0x59, 0xe3,
-	0x62, 0xf2, 0xe6, 0xb6, 0xb8, 0x1e, 0x27, 0x94, 0x09, 0x97, 0xde, 0xee, 0x0e, 0xce, 0xb3, 0x27,
-	0xff, 0x7c, 0xbf, 0x2e, 0x7c, 0xf1, 0xdb, 0xdf, 0xdf, 0x4c, 0x64, 0xc8, 0x55, 0x85, 0xfb, 0xe8,
-	0xf0, 0x55, 0x7d, 0x2b, 0xc0, 0x14, 0x62, 0x92, 0xd5, 0xb1, 0xb4, 0xbe, 0xc0, 0xb5, 0x18, 0x91,
-	0xa8, 0x6f, 0x2b, 0x14, 0xb3, 0x46, 0x56, 0x46, 0x8a, 0x51, 0x0e, 0x71, 0xfb, 0xda, 0xa4, 0x2b,
-	0x00, 0x19, 0xec, 0x43, 0xb2, 0x39, 0x96, 0x77, 0xf0, 0xd8, 0x88, 0x5b, 0xe7, 0x4b, 0x42, 0xdd,
-	0xfb, 0x5d, 0x5e, 0x9f, 0xc6, 0x30, 0x13, 0x9c, 0xfe, 0xa2, 0x65, 0x28, 0x87, 0x96, 0xd1, 0x26,
-	0x5f, 0x0a, 0x70, 0x91, 0xcd, 0x53, 0xb2, 0x32, 0x5c, 0x4b, 0xdf, 0xf0, 0x16, 0x57, 0xc7, 0x07,
-	0xa2, 0xd0, 0xd5, 0x50, 0xd3, 0x55, 0xb2, 0xc8, 0xd5, 0xc4, 0xc6, 0x37, 0xf9, 0x51, 0x80, 0xf9,
-	0xfe, 0x31, 0x4c, 0x94, 0xe1, 0x34, 0xdc, 0x21, 0x2f, 0xde, 0x88, 0x9f, 0x80, 0xfa, 0x6e, 0x0d,
-	0x36, 0xe8, 0x76, 0x28, 0x79, 0x99, 0xbc, 0xc6, 0x95, 0x5c, 0xa3, 0x60, 0xc5, 0xa0, 0x4f, 0x7f,
-	0x12, 0x20, 0xc9, 0x99, 0xbe, 0x64, 0x2b, 0xa6, 0x9e, 0xbe, 0x19, 0x2f, 0xde, 0x3c, 0x67, 0x16,
-	0x5a, 0x79, 0x33, 0xd4, 0x9d, 0x23, 0x6f, 0xc4, 0xd1, 0xad, 0x1c, 0xf6, 0xde, 0x0f, 0x6d, 0xf2,
-	0x9d, 0x00, 0xb3, 0xd1, 0xc9, 0x3c, 0xe4, 0xac, 0x71, 0x06, 0xfd, 0x90, 0xb3, 0xc6, 0x1b, 0xf3,
-	0xd2, 0x36, 0xa7, 0xd4, 0x23, 0x1b, 0x83, 0xcd, 0x7f, 0xf2, 0xb3, 0x00, 0x29, 0xde, 0x30, 0x26,
-	0xfc, 0xdd, 0x1e, 0xf1, 0x20, 0x10, 0x37, 0xce, 0x91, 0x81, 0xaa, 0x6f, 0x0f, 0x53, 0x3d, 0xac,
-	0xc6, 0x4c, 0x75, 0x70, 0x5b, 0xb0, 0x41, 0xdb, 0x26, 0xbf, 0x84, 0x2e, 0xfa, 0x66, 0xf3, 0x68,
-	0x17, 0xbc, 0x17, 0xc2, 0x68, 0x17, 0xdc, 0xc1, 0x2f, 0xdd, 0x19, 0xe6, 0x42, 0x26, 0xd7, 0x63,
-	0xb9, 0x60, 0x4f, 0x91, 0x36, 0xf9, 0x41, 0x80, 0x99, 0xc8, 0x84, 0x22, 0xd7, 0xc7, 0x5e, 0x5f,
-	0x91, 0x61, 0x29, 0xe6, 0x62, 0x46, 0xa3, 0xea, 0x77, 0x07, 0x55, 0xef, 0x8c, 0x6f, 0xf2, 0xe0,
-	0xc5, 0x61, 0x57, 0x9c, 0xf0, 0xd2, 0x56, 0xdf, 0x79, 0x7a, 0x92, 0x16, 0x9e, 0x9d, 0xa4, 0x85,
-	0xbf, 0x4e, 0xd2, 0xc2, 0xd7, 0xa7, 0xe9, 0xc4, 0xb3, 0xd3, 0x74, 0xe2, 0xf7, 0xd3, 0x74, 0xe2,
-	0xc1, 0x5a, 0xd5, 0xf2, 0x0e, 0x9a, 0x25, 0xb9, 0xec, 0xd4, 0x7c, 0xc0, 0x90, 0x54, 0x79, 0xcc,
-	0xd0, 0xbd, 0x56, 0xdd, 0x74, 0x4b, 0x17, 0xe9, 0x6b, 0x79, 0xf3, 0xff, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x68, 0xf1, 0x1c, 0xea, 0xdd, 0x0f, 0x00, 0x00,
+	// 1259 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4b, 0x6f, 0x1b, 0x55,
+	0x14, 0xf6, 0x24, 0x69, 0x1e, 0x27, 0x2f, 0x71, 0xed, 0x82, 0x3b, 0x49, 0x6d, 0x6b, 0x0a, 0x79,
+	0x51, 0xcf, 0x34, 0xce, 0x0b, 0x22, 0xb1, 0x88, 0xa9, 0x5a, 0x05, 0x54, 0x64, 0xa6, 0x55, 0x29,
+	0xdd, 0x58, 0xe3, 0x78, 0xec, 0x8c, 0x88, 0x67, 0x5c, 0xcf, 0x38, 0xaa, 0x89, 0x22, 0x24, 0x24,
+	0x44, 0x17, 0x2c, 0x90, 0x60, 0xc7, 0x26, 0x3b, 0xb6, 0x2c, 0x22, 0x21, 0x24, 0x76, 0xb0, 0x28,
+	0x59, 0x55, 0x61, 0x83, 0x58, 0x20, 0x94, 0x20, 0xc1, 0xcf, 0x40, 0xbe, 0xf7, 0xcc, 0xc3, 0xf6,
+	0xb5, 0x3d, 0x49, 0x77, 0x9e, 0xb9, 0xe7, 0x7c, 0xe7, 0xfb, 0xce, 0x3d, 0xf7, 0xce, 0x67, 0x48,
+	0xee, 0x58, 0x76, 0xc5, 0xb2, 0x15, 0xad, 0xee, 0xec, 0x2a, 0xfb, 0xcb, 0x05, 0xdd, 0xd1, 0x96,
+	0x95, 0x27, 0x75, 0xbd, 0xd6, 0x90, 0xab, 0x35, 0xcb, 0xb1, 0x48, 0x94, 0x05, 0xc8, 0xcd, 0x00,
+	0x19, 0x03, 0xc4, 0x25, 0xcc, 0x2a, 0x68, 0xb6, 0xce, 0xa2, 0xbd, 0xdc, 0xaa, 0x56, 0x36, 0x4c,
+	0xcd, 0x31, 0x2c, 0x93, 0x01, 0x88, 0xb1, 0xb2, 0x55, 0xb6, 0xe8, 0x4f, 0xa5, 0xf9, 0x0b, 0xdf,
+	0x5e, 0x2b, 0x5b, 0x56, 0x79, 0x4f, 0x57, 0xe8, 0x53, 0xa1, 0x5e, 0x52, 0x34, 0x13, 0x2b, 0x8a,
+	0xb3, 0xb8, 0xa4, 0x55, 0x0d, 0x45, 0x33, 0x4d, 0xcb, 0xa1, 0x68, 0x36, 0xae, 0x26, 0x78, 0x84,
+	0x29, 0x39, 0x04, 0x66, 0xeb, 0x79, 0x56, 0x11, 0xc9, 0xb3, 0xa5, 0x19, 0x4c, 0x75, 0x09, 0x07,
+	0x75, 0x4a, 0x36, 0xc4, 0x3e, 0x6c, 0x3e, 0x6e, 0xed, 0xec, 0x58, 0x75, 0xd3, 0xb1, 0x55, 0xfd,
+	0x49, 0x5d, 0xb7, 0x1d, 0x72, 0x07, 0xc0, 0x97, 0x14, 0x17, 0x52, 0xc2, 0xc2, 0x78, 0x66, 0x4e,
+	0x46, 0xdc, 0xa6, 0x7e, 0x99, 0xa1, 0x20, 0x15, 0x39, 0xa7, 0x95, 0x75, 0xcc, 0x55, 0x03, 0x99,
+	0x9b, 0xd1, 0xd3, 0xe3, 0xf4, 0x34, 0x4b, 0x4b, 0xdb, 0xc5, 0x4f, 0x52, 0xb7, 0xe4, 0xd5, 0x15,
+	0xe9, 0x37, 0x01, 0xae, 0xb6, 0x55, 0xb5, 0xab, 0x96, 0x69, 0xeb, 0x44, 0x85, 0x51, 0x0d, 0xdf,
+	0xc5, 0x85, 0xd4, 0xe0, 0xc2, 0x78, 0x26, 0x26, 0xb3, 0xbe, 0xc8, 0x6e, 0xcb, 0xe4, 0x2d, 0xb3,
+	0x91, 0x4d, 0x9d, 0x1c, 0xa7, 0x67, 0x39, 0x5b, 0x24, 0x23, 0xe2, 0xb6, 0xea, 0xe1, 0x90, 0xbb,
+	0x2d, 0x52, 0x06, 0xa8, 0x94, 0xf9, 0xbe, 0x52, 0x18, 0xa1, 0xfe, 0x5a, 0xee, 0x43, 0x34, 0x28,
+	0xc5, 0xed, 0x5f, 0x06, 0x46, 0xb4, 0x62, 0xb1, 0xa6, 0xdb, 0x36, 0x6d, 0xde, 0x58, 0x36, 0x7e,
+	0x7a, 0x9c, 0x8e, 0x61, 0xd1, 0x2d, 0xb6, 0x72, 0xdf, 0xa9, 0x19, 0x66, 0x59, 0x75, 0x03, 0x37,
+	0x47, 0x9f, 0x1d, 0x25, 0x23, 0xff, 0x1d, 0x25, 0x23, 0xd2, 0x6e, 0xeb, 0xae, 0x78, 0xed, 0xc9,
+	0xc1, 0x08, 0xca, 0xc2, 0x2d, 0xb9, 0x6c, 0x77, 0x5c, 0x18, 0x29, 0x06, 0x84, 0x56, 0xca, 0x69,
+	0x35, 0xad, 0xe2, 0xee, 0xbe, 0x94, 0x43, 0x51, 0xee, 0x5b, 0x2c, 0xff, 0x36, 0x0c, 0x57, 0xe9,
+	0x1b, 0xac, 0x3e, 0x23, 0xf3, 0x8a, 0xb0, 0xa4, 0xec, 0xd0, 0xf3, 0xbf, 0x92, 0x11, 0x15, 0x13,
+	0xa4, 0x65, 0x10, 0x29, 0xe2, 0x3d, 0xab, 0x58, 0xdf, 0xd3, 0xdb, 0xa6, 0x8d, 0xd7, 0xd9, 0x75,
+	0xe9, 0x2b, 0x01, 0x66, 0xb8, 0x39, 0xc8, 0xe6, 0x51, 0xc8, 0x59, 0x99, 0x3b, 0x39, 0x4e, 0x4b,
+	0x3c, 0xa2, 0x2d, 0xb8, 0x81, 0x89, 0xe1, 0xd3, 0x59, 0x83, 0x64, 0x27, 0x9b, 0x6c, 0xe3, 0x03,
+	0xad, 0xe2, 0x0e, 0x3e, 0x21, 0x30, 0x64, 0x6a, 0x15, 0x9d, 0xed, 0xb8, 0x4a, 0x7f, 0x4b, 0x9f,
+	0x42, 0xaa, 0x7b, 0x1a, 0x2a, 0x79, 0x18, 0x6e, 0x5b, 0xc3, 0x0a, 0xf1, 0x36, 0x77, 0x09, 0xa2,
+	0x59, 0x7d, 0x67, 0x77, 0x25, 0x93, 0xab, 0xe9, 0x25, 0xe3, 0x69, 0xcf, 0x6e, 0xe7, 0x20, 0xd6,
+	0x1a, 0x8b, 0xdc, 0x6e, 0xc0, 0x64, 0x81, 0xbe, 0xcf, 0x57, 0xe9, 0x02, 0x8a, 0x9b, 0x28, 0x04,
+	0x82, 0xf9, 0x88, 0x1f, 0xc1, 0x0c, 0x0e, 0x7a, 0xb6, 0xe1, 0xe8, 0xf6, 0x03, 0x0b, 0xe7, 0x1d,
+	0x9b, 0x75, 0x03, 0x26, 0x71, 0xf0, 0xf3, 0x85, 0xe6, 0x3a, 0x05, 0x9e, 0x50, 0x27, 0xb4, 0x40,
+	0x0e, 0x1f, 0xf8, 0x31, 0xcc, 0xf2, 0x81, 0x91, 0xf2, 0x1b, 0x30, 0xe5, 0x22, 0xdb, 0x74, 0x05,
+	0x39, 0xbb, 0xf5, 0x58, 0x38, 0x1f, 0xfb, 0x63, 0x8f, 0x34, 0x8b, 0x7a, 0x60, 0xd1, 0x1a, 0x2e,
+	0xe9, 0x97, 0x81, 0x7e, 0xe4, 0xd1, 0x6e, 0x83, 0xf6, 0x3b, 0x7d, 0xc9, 0x86, 0x7c, 0x06, 0x89,
+	0xe0, 0x75, 0xe1, 0x35, 0x67, 0xfb, 0xb6, 0x3f, 0x99, 0x03, 0x46, 0x91, 0x02, 0x0e, 0x66, 0x07,
+	0xe2, 0x82, 0x3a, 0x60, 0x14, 0x49, 0x06, 0x00, 0x07, 0x25, 0x6f, 0x14, 0xe9, 0xbd, 0x38, 0x94,
+	0x8d, 0xfe, 0xd9, 0x01, 0xbf, 0xa1, 0x8e, 0x61, 0xd8, 0x76, 0x71, 0xf3, 0xea, 0xe9, 0x71, 0xfa,
+	0x95, 0xb6, 0xf2, 0x72, 0x46, 0x3a, 0xc0, 0xb3, 0xc1, 0x23, 0x80, 0xea, 0xb6, 0x60, 0xda, 0xad,
+	0x16, 0xf6, 0x62, 0x9c, 0xd2, 0x5a, 0xe0, 0xba, 0x15, 0x2f, 0xc0, 0x6b, 0xc1, 0xe2, 0xdb, 0x66,
+	0xc9, 0x7a, 0x99, 0x5b, 0x98, 0xd3, 0xe1, 0x0d, 0x49, 0x87, 0x78, 0x67, 0x0d, 0x54, 0xb6, 0x0a,
+	0x43, 0x86, 0x59, 0xb2, 0xf0, 0xe8, 0xa6, 0xb8, 0x77, 0x62, 0x56, 0xb3, 0xdd, 0xf3, 0xa9, 0xd2,
+	0x68, 0x7e, 0x99, 0x2f, 0x05, 0xb8, 0x46, 0xeb, 0x3c, 0xd4, 0x6b, 0x46, 0xa9, 0x71, 0x4f, 0xb7,
+	0x6d, 0xff, 0xbb, 0x7a, 0x19, 0x35, 0x24, 0x0e, 0x23, 0x15, 0x86, 0x42, 0x77, 0x78, 0x42, 0x75,
+	0x1f, 0xc9, 0x2c, 0x8c, 0xd9, 0x46, 0xd9, 0xd4, 0x9c, 0x7a, 0x4d, 0x8f, 0x0f, 0xd2, 0x35, 0xff,
+	0x85, 0xf4, 0x1e, 0xde, 0xd7, 0x6d, 0x44, 0x50, 0x72, 0x0c, 0xae, 0xec, 0x6b, 0x7b, 0x38, 0x51,
+	0xa3, 0x2a, 0x7b, 0x20, 0xaf, 0xc2, 0x70, 0xc9, 0xaa, 0x55, 0x34, 0x87, 0x96, 0x1a, 0x53, 0xf1,
+	0x29, 0x73, 0x34, 0x0d, 0x57, 0x28, 0x18, 0x39, 0x12, 0x60, 0xd4, 0xbd, 0xc7, 0xc9, 0x22, 0xb7,
+	0x53, 0x3c, 0x37, 0x22, 0x2e, 0x85, 0x09, 0x65, 0xdc, 0xa4, 0x77, 0x4e, 0x3a, 0xbf, 0xd2, 0xcf,
+	0xfe, 0xfd, 0x61, 0x49, 0xf8, 0xfc, 0xf7, 0x7f, 0xbe, 0x19, 0x48, 0x92, 0xeb, 0x0a, 0xd7, 0x4a,
+	0xb9, 0xac, 0xbe, 0x15, 0x60, 0x04, 0x31, 0xc9, 0x42, 0xdf, 0xb2, 0x2e, 0xc1, 0xc5, 0x10, 0x91,
+	0xc8, 0x6f, 0xd5, 0x27, 0xb3, 0x48, 0xe6, 0x7b, 0x92, 0x51, 0x0e, 0x70, 0x1b, 0x0f, 0xc9, 0x89,
+	0x00, 0xa4, 0xf3, 0x74, 0x91, 0x95, 0xbe, 0x75, 0x3b, 0x2f, 0x03, 0x71, 0xf5, 0x62, 0x49, 0xc8,
+	0x7b, 0xfb, 0x84, 0x77, 0xfa, 0x42, 0x88, 0xf1, 0xee, 0xb4, 0xbc, 0x51, 0x54, 0x0e, 0x8c, 0xe2,
+	0x21, 0xf9, 0x42, 0x80, 0x61, 0xe6, 0x12, 0xc8, 0x7c, 0x77, 0x2e, 0x2d, 0x96, 0x44, 0x5c, 0xe8,
+	0x1f, 0x88, 0x44, 0x17, 0x7c, 0x4e, 0xd7, 0xc9, 0x0c, 0x97, 0x13, 0x33, 0x25, 0xe4, 0x27, 0x01,
+	0xa6, 0x5a, 0xcd, 0x05, 0x51, 0xba, 0x97, 0xe1, 0x5a, 0x17, 0xf1, 0x56, 0xf8, 0x04, 0xe4, 0x77,
+	0xbb, 0x73, 0x40, 0xd7, 0x7d, 0xca, 0x73, 0xe4, 0x75, 0x2e, 0xe5, 0x0a, 0x05, 0xcb, 0x7b, 0x73,
+	0xfa, 0xb3, 0x00, 0x51, 0x8e, 0xa7, 0x20, 0xab, 0x21, 0xf9, 0xb4, 0x38, 0x17, 0x71, 0xed, 0x82,
+	0x59, 0x28, 0xe5, 0x2d, 0x9f, 0x77, 0x9a, 0xbc, 0x19, 0x86, 0xb7, 0x72, 0xd0, 0x74, 0x45, 0x87,
+	0xe4, 0x3b, 0x01, 0x26, 0x82, 0x7e, 0xa3, 0xcb, 0x59, 0xe3, 0xd8, 0x97, 0x2e, 0x67, 0x8d, 0x67,
+	0x5e, 0xa4, 0x75, 0x4e, 0xab, 0x7b, 0x0e, 0x06, 0x73, 0x35, 0xe4, 0x17, 0x01, 0x62, 0x3c, 0x8b,
+	0x41, 0xf8, 0xbb, 0xdd, 0xc3, 0xe6, 0x88, 0xcb, 0x17, 0xc8, 0x40, 0xd6, 0x77, 0xba, 0xb1, 0xee,
+	0xd6, 0x63, 0xc6, 0xda, 0xbb, 0x2d, 0x98, 0x7d, 0x38, 0x24, 0xbf, 0xfa, 0x2a, 0x5a, 0x1c, 0x47,
+	0x6f, 0x15, 0x3c, 0xdf, 0xd3, 0x5b, 0x05, 0xd7, 0xce, 0x48, 0x77, 0xbb, 0xa9, 0x90, 0xc9, 0xcd,
+	0x50, 0x2a, 0x98, 0xc1, 0x3a, 0x24, 0x3f, 0x0a, 0x30, 0x1e, 0xf8, 0xee, 0x92, 0x9b, 0x7d, 0xaf,
+	0xaf, 0x80, 0x05, 0x10, 0xd3, 0x21, 0xa3, 0x91, 0xf5, 0xfb, 0x9d, 0xac, 0x37, 0xfa, 0x0f, 0xb9,
+	0xe7, 0xa3, 0xcc, 0x92, 0x15, 0xb8, 0xb4, 0xbf, 0x17, 0x60, 0xb2, 0xe5, 0x03, 0x4a, 0xe4, 0xee,
+	0x6c, 0x78, 0x9f, 0x7c, 0x51, 0x09, 0x1d, 0x8f, 0xfc, 0xd7, 0x28, 0x4f, 0x85, 0xa4, 0xb9, 0x3c,
+	0xf7, 0x69, 0x4e, 0x1e, 0x2d, 0x80, 0xcf, 0x34, 0xfb, 0xee, 0xf3, 0xb3, 0x84, 0xf0, 0xe2, 0x2c,
+	0x21, 0xfc, 0x7d, 0x96, 0x10, 0xbe, 0x3e, 0x4f, 0x44, 0x5e, 0x9c, 0x27, 0x22, 0x7f, 0x9c, 0x27,
+	0x22, 0x8f, 0x17, 0xcb, 0x86, 0xb3, 0x5b, 0x2f, 0xc8, 0x3b, 0x56, 0xc5, 0x85, 0xf4, 0xdb, 0xa3,
+	0x3c, 0x65, 0xf8, 0x4e, 0xa3, 0xaa, 0xdb, 0x85, 0x61, 0xfa, 0x6f, 0x65, 0xe5, 0xff, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x63, 0x52, 0xa6, 0x55, 0x5d, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1068,6 +1197,9 @@ type QueryClient interface {
 	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// VerifyMessage verifies the BIP-322 signature of an offchain message by an
+	// account, i.e. a proof of ownership of the account address.
+	VerifyMessage(ctx context.Context, in *QueryVerifyMessageRequest, opts ...grpc.CallOption) (*QueryVerifyMessageResponse, error)
 }
 
 type queryClient struct {
@@ -1168,6 +1300,15 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) VerifyMessage(ctx context.Context, in *QueryVerifyMessageRequest, opts ...grpc.CallOption) (*QueryVerifyMessageResponse, error) {
+	out := new(QueryVerifyMessageResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/VerifyMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts.
@@ -1193,6 +1334,9 @@ type QueryServer interface {
 	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// VerifyMessage verifies the BIP-322 signature of an offchain message by an
+	// account, i.e. a proof of ownership of the account address.
+	VerifyMessage(context.Context, *QueryVerifyMessageRequest) (*QueryVerifyMessageResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1229,6 +1373,9 @@ func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *
 func (*UnimplementedQueryServer) AccountInfo(ctx context.Context, req *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (*UnimplementedQueryServer) VerifyMessage(ctx context.Context, req *QueryVerifyMessageRequest) (*QueryVerifyMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMessage not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1414,6 +1561,24 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VerifyMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/VerifyMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyMessage(ctx, req.(*QueryVerifyMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
@@ -1459,6 +1624,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "VerifyMessage",
+			Handler:    _Query_VerifyMessage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -2095,6 +2264,90 @@ func (m *QueryAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryVerifyMessageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyMessageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyMessageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyMessageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyMessageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2362,6 +2615,43 @@ func (m *QueryAccountInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryVerifyMessageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyMessageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3979,6 +4269,258 @@ func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryVerifyMessageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyMessageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyMessageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
+			if m.Message == nil {
+				m.Message = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyMessageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyMessageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -51,7 +51,7 @@ func (s *CLITestSuite) SetupSuite() {
 
 func (s *CLITestSuite) TestNewMsgCreateVestingAccountCmd() {
 	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
-	cmd := cli.NewMsgCreateVestingAccountCmd(address.NewTaprootCodec(&sdk.BitcoinNetParams))
+	cmd := cli.NewMsgCreateVestingAccountCmd(address.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams()))
 	cmd.SetOut(io.Discard)
 
 	extraArgs := []string{
@@ -139,7 +139,7 @@ func (s *CLITestSuite) TestNewMsgCreateVestingAccountCmd() {
 
 func (s *CLITestSuite) TestNewMsgCreatePermanentLockedAccountCmd() {
 	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
-	cmd := cli.NewMsgCreatePermanentLockedAccountCmd(address.NewTaprootCodec(&sdk.BitcoinNetParams))
+	cmd := cli.NewMsgCreatePermanentLockedAccountCmd(address.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams()))
 	cmd.SetOut(io.Discard)
 
 	extraArgs := []string{
@@ -217,7 +217,7 @@ func (s *CLITestSuite) TestNewMsgCreatePermanentLockedAccountCmd() {
 
 func (s *CLITestSuite) TestNewMsgCreatePeriodicVestingAccountCmd() {
 	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
-	cmd := cli.NewMsgCreatePeriodicVestingAccountCmd(address.NewTaprootCodec(&sdk.BitcoinNetParams))
+	cmd := cli.NewMsgCreatePeriodicVestingAccountCmd(address.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams()))
 	cmd.SetOut(io.Discard)
 
 	extraArgs := []string{
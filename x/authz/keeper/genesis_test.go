@@ -52,7 +52,7 @@ func (suite *GenesisTestSuite) SetupTest() {
 	// gomock initializations
 	ctrl := gomock.NewController(suite.T())
 	suite.accountKeeper = authztestutil.NewMockAccountKeeper(ctrl)
-	suite.accountKeeper.EXPECT().AddressCodec().Return(address.NewTaprootCodec(&sdk.BitcoinNetParams)).AnyTimes()
+	suite.accountKeeper.EXPECT().AddressCodec().Return(address.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams())).AnyTimes()
 
 	suite.baseApp = baseapp.NewBaseApp(
 		"authz",
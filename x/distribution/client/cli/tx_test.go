@@ -132,7 +132,7 @@ func (s *CLITestSuite) TestTxWithdrawRewardsCmd() {
 			args := append([]string{tc.valAddr.String()}, tc.args...)
 
 			ctx := svrcmd.CreateExecuteContext(context.Background())
-			cmd := cli.NewWithdrawRewardsCmd(address.NewBech32Codec("cosmosvaloper"), address.NewTaprootCodec(&sdk.BitcoinNetParams))
+			cmd := cli.NewWithdrawRewardsCmd(address.NewBech32Codec("cosmosvaloper"), address.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams()))
 			cmd.SetContext(ctx)
 			cmd.SetArgs(args)
 			s.Require().NoError(client.SetCmdClientContextHandler(s.clientCtx, cmd))
@@ -182,7 +182,7 @@ func (s *CLITestSuite) TestTxWithdrawAllRewardsCmd() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			cmd := cli.NewWithdrawAllRewardsCmd(address.NewBech32Codec("cosmosvaloper"), address.NewTaprootCodec(&sdk.BitcoinNetParams))
+			cmd := cli.NewWithdrawAllRewardsCmd(address.NewBech32Codec("cosmosvaloper"), address.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams()))
 
 			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
 			if tc.expectErrMsg != "" {
@@ -232,7 +232,7 @@ func (s *CLITestSuite) TestTxSetWithdrawAddrCmd() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			cmd := cli.NewSetWithdrawAddrCmd(address.NewTaprootCodec(&sdk.BitcoinNetParams))
+			cmd := cli.NewSetWithdrawAddrCmd(address.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams()))
 
 			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
 			if tc.expectErr {
@@ -280,7 +280,7 @@ func (s *CLITestSuite) TestTxFundCommunityPoolCmd() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			cmd := cli.NewFundCommunityPoolCmd(address.NewTaprootCodec(&sdk.BitcoinNetParams))
+			cmd := cli.NewFundCommunityPoolCmd(address.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams()))
 
 			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
 			if tc.expectErr {
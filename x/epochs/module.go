@@ -8,7 +8,9 @@ import (
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/schema"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -143,11 +145,8 @@ func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
 	sdr[types.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.keeper.Schema)
 }
 
-// TODO add when we have collections full support with schema
-/*
 // ModuleCodec implements schema.HasModuleCodec.
 // It allows the indexer to decode the module's KVPairUpdate.
 func (am AppModule) ModuleCodec() (schema.ModuleCodec, error) {
 	return am.keeper.Schema.ModuleCodec(collections.IndexingOptions{})
 }
-*/
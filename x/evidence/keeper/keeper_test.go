@@ -104,7 +104,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 		storeService,
 		stakingKeeper,
 		slashingKeeper,
-		address.NewTaprootCodec(&sdk.BitcoinNetParams),
+		address.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams()),
 		&evidencetestutil.MockCometinfo{},
 	)
 
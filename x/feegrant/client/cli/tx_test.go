@@ -128,7 +128,7 @@ func (s *CLITestSuite) createGrant(granter, grantee sdk.Address) {
 		commonFlags...,
 	)
 
-	cmd := cli.NewCmdFeeGrant(codecaddress.NewTaprootCodec(&sdk.BitcoinNetParams))
+	cmd := cli.NewCmdFeeGrant(codecaddress.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams()))
 	out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, args)
 	s.Require().NoError(err)
 
@@ -413,7 +413,7 @@ func (s *CLITestSuite) TestNewCmdFeeGrant() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			cmd := cli.NewCmdFeeGrant(codecaddress.NewTaprootCodec(&sdk.BitcoinNetParams))
+			cmd := cli.NewCmdFeeGrant(codecaddress.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams()))
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 
 			if tc.expectErr {
@@ -456,7 +456,7 @@ func (s *CLITestSuite) TestTxWithFeeGrant() {
 		commonFlags...,
 	)
 
-	cmd := cli.NewCmdFeeGrant(codecaddress.NewTaprootCodec(&sdk.BitcoinNetParams))
+	cmd := cli.NewCmdFeeGrant(codecaddress.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams()))
 
 	var res sdk.TxResponse
 	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
@@ -607,7 +607,7 @@ func (s *CLITestSuite) TestFilteredFeeAllowance() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			cmd := cli.NewCmdFeeGrant(codecaddress.NewTaprootCodec(&sdk.BitcoinNetParams))
+			cmd := cli.NewCmdFeeGrant(codecaddress.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams()))
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 			if tc.expectErrMsg != "" {
 				s.Require().Error(err)
@@ -657,7 +657,7 @@ func (s *CLITestSuite) TestFilteredFeeAllowance() {
 					commonFlags...,
 				)
 
-				cmd := cli.NewCmdFeeGrant(codecaddress.NewTaprootCodec(&sdk.BitcoinNetParams))
+				cmd := cli.NewCmdFeeGrant(codecaddress.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams()))
 				out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &sdk.TxResponse{}), out.String())
 
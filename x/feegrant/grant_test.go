@@ -18,7 +18,7 @@ import (
 )
 
 func TestGrant(t *testing.T) {
-	addressCodec := codecaddress.NewTaprootCodec(&sdk.BitcoinNetParams)
+	addressCodec := codecaddress.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams())
 	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
 	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
 	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModuleBasic{})
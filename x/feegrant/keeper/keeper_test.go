@@ -50,7 +50,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	for i := 0; i < len(suite.addrs); i++ {
 		suite.accountKeeper.EXPECT().GetAccount(gomock.Any(), suite.addrs[i]).Return(authtypes.NewBaseAccountWithAddress(suite.addrs[i])).AnyTimes()
 	}
-	suite.accountKeeper.EXPECT().AddressCodec().Return(codecaddress.NewTaprootCodec(&sdk.BitcoinNetParams)).AnyTimes()
+	suite.accountKeeper.EXPECT().AddressCodec().Return(codecaddress.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams())).AnyTimes()
 	suite.bankKeeper = feegranttestutil.NewMockBankKeeper(ctrl)
 	suite.bankKeeper.EXPECT().BlockedAddr(gomock.Any()).Return(false).AnyTimes()
 
@@ -171,7 +171,7 @@ func (suite *KeeperTestSuite) TestKeeperCrud() {
 		})
 	}
 	address := "bc1p3ea0qp87p50zczjmze962vrzqv0vphcsxy6jqs2rexvxe86jm4xqfmldjy"
-	accAddr, err := codecaddress.NewTaprootCodec(&sdk.BitcoinNetParams).StringToBytes(address)
+	accAddr, err := codecaddress.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams()).StringToBytes(address)
 	suite.Require().NoError(err)
 	suite.accountKeeper.EXPECT().GetAccount(gomock.Any(), accAddr).Return(authtypes.NewBaseAccountWithAddress(accAddr)).AnyTimes()
 
@@ -18,7 +18,7 @@ func (suite *KeeperTestSuite) TestGrantAllowance() {
 	oneYear := ctx.BlockTime().AddDate(1, 0, 0)
 	yesterday := ctx.BlockTime().AddDate(0, 0, -1)
 
-	addressCodec := codecaddress.NewTaprootCodec(&sdk.BitcoinNetParams)
+	addressCodec := codecaddress.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams())
 
 	testCases := []struct {
 		name      string
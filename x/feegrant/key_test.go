@@ -13,7 +13,7 @@ import (
 )
 
 func TestMarshalAndUnmarshalFeegrantKey(t *testing.T) {
-	addressCodec := codecaddress.NewTaprootCodec(&sdk.BitcoinNetParams)
+	addressCodec := codecaddress.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams())
 	grantee, err := addressCodec.StringToBytes("bc1py5rep5v282sd4uvcpf6trg06n0fnllj9tu28wxpja0qfqkfs6dkqdrfwtq")
 	require.NoError(t, err)
 	granter, err := addressCodec.StringToBytes("bc1pvnz2gf2adpuvfh6qp0y7tpffuemgn2slqdnplqxlx9343s6gjvgswtj3c6")
@@ -29,7 +29,7 @@ func TestMarshalAndUnmarshalFeegrantKey(t *testing.T) {
 }
 
 func TestMarshalAndUnmarshalFeegrantKeyQueueKey(t *testing.T) {
-	addressCodec := codecaddress.NewTaprootCodec(&sdk.BitcoinNetParams)
+	addressCodec := codecaddress.NewTaprootCodec(sdk.GetConfig().GetBitcoinNetParams())
 	grantee, err := addressCodec.StringToBytes("bc1py5rep5v282sd4uvcpf6trg06n0fnllj9tu28wxpja0qfqkfs6dkqdrfwtq")
 	require.NoError(t, err)
 	granter, err := addressCodec.StringToBytes("bc1pvnz2gf2adpuvfh6qp0y7tpffuemgn2slqdnplqxlx9343s6gjvgswtj3c6")
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/genutil/migrations/taproot"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	flagMapping         = "mapping"
+	flagReport          = "report"
+	flagAccountPrefix   = "account-prefix"
+	flagValidatorPrefix = "validator-prefix"
+	flagConsensusPrefix = "consensus-prefix"
+)
+
+// MigrateTaprootCmd returns a command moving the 20-byte accounts of a genesis
+// to taproot addresses.
+func MigrateTaprootCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-taproot [genesis-file]",
+		Short: "Move the 20-byte accounts of a genesis to taproot addresses",
+		Long: `Move the 20-byte accounts of the source genesis to taproot addresses and print it to STDOUT.
+
+Accounts move to the taproot address derived from their secp256k1 public key, or to the one
+given by the --mapping file, a JSON list of entries such as:
+
+    [{"address": "cosmos1...", "pub_key": "<hex compressed secp256k1 key>"},
+     {"address": "cosmos1...", "taproot_address": "bc1p..."}]
+
+Module accounts move to their taproot module address. The addresses are rewritten in the state
+of all modules. The report lists the moved accounts, and the accounts keeping their address as
+their public key is unknown. It is written to the --report file, or to STDERR.
+`,
+		Example: fmt.Sprintf("%s genesis migrate-taproot /path/to/genesis.json --mapping mapping.json --report report.json", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			appGenesis, err := types.AppGenesisFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var entries []taproot.MappingEntry
+			if mappingFile, _ := cmd.Flags().GetString(flagMapping); mappingFile != "" {
+				bz, err := os.ReadFile(mappingFile)
+				if err != nil {
+					return err
+				}
+
+				entries, err = taproot.ParseMapping(bz)
+				if err != nil {
+					return err
+				}
+			}
+
+			opts := taproot.DefaultOptions()
+			if prefix, _ := cmd.Flags().GetString(flagAccountPrefix); prefix != "" {
+				opts.AccountPrefix = prefix
+			}
+			if prefix, _ := cmd.Flags().GetString(flagValidatorPrefix); prefix != "" {
+				opts.ValidatorPrefix = prefix
+			}
+			if prefix, _ := cmd.Flags().GetString(flagConsensusPrefix); prefix != "" {
+				opts.ConsensusPrefix = prefix
+			}
+
+			var initialState types.AppMap
+			if err := json.Unmarshal(appGenesis.AppState, &initialState); err != nil {
+				return fmt.Errorf("failed to JSON unmarshal initial genesis state: %w", err)
+			}
+
+			newGenState, report, err := taproot.Migrate(initialState, clientCtx.Codec, entries, opts)
+			if err != nil {
+				return fmt.Errorf("failed to migrate genesis state: %w", err)
+			}
+
+			appGenesis.AppState, err = json.Marshal(newGenState)
+			if err != nil {
+				return fmt.Errorf("failed to JSON marshal migrated genesis state: %w", err)
+			}
+
+			reportBz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			if reportFile, _ := cmd.Flags().GetString(flagReport); reportFile != "" {
+				if err := os.WriteFile(reportFile, reportBz, 0o600); err != nil {
+					return err
+				}
+			} else {
+				cmd.PrintErrln(string(reportBz))
+			}
+
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDocument == "" {
+				bz, err := json.Marshal(appGenesis)
+				if err != nil {
+					return fmt.Errorf("failed to marshal app genesis: %w", err)
+				}
+
+				cmd.Println(string(bz))
+				return nil
+			}
+
+			return appGenesis.SaveAs(outputDocument)
+		},
+	}
+
+	cmd.Flags().String(flagMapping, "", "JSON file mapping 20-byte addresses to taproot public keys or addresses")
+	cmd.Flags().String(flagReport, "", "Write the migration report to the given file instead of STDERR")
+	cmd.Flags().String(flagAccountPrefix, "", "Bech32 prefix of the source account addresses (default: the configured one, or cosmos)")
+	cmd.Flags().String(flagValidatorPrefix, "", "Bech32 prefix of the source validator operator addresses (default: the configured one)")
+	cmd.Flags().String(flagConsensusPrefix, "", "Bech32 prefix of the source consensus addresses (default: the configured one)")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Migrated genesis is written to the given file instead of STDOUT")
+
+	return cmd
+}
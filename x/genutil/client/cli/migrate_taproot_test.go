@@ -0,0 +1,53 @@
+package cli_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/genutil/migrations/taproot"
+)
+
+func TestMigrateTaprootGenesis(t *testing.T) {
+	dir := t.TempDir()
+	reportFile := filepath.Join(dir, "report.json")
+	outputFile := filepath.Join(dir, "genesis.json")
+
+	_, err := clitestutil.ExecTestCLICmd(
+		client.Context{Codec: moduletestutil.MakeTestEncodingConfig().Codec},
+		cli.MigrateTaprootCmd(),
+		[]string{
+			"../../types/testdata/app_genesis.json",
+			"--account-prefix=cosmos",
+			"--" + flags.FlagOutputDocument + "=" + outputFile,
+			"--report=" + reportFile,
+		},
+	)
+	require.NoError(t, err)
+
+	bz, err := os.ReadFile(reportFile)
+	require.NoError(t, err)
+	var report taproot.Report
+	require.NoError(t, json.Unmarshal(bz, &report))
+
+	// the module accounts move to their taproot address, the accounts without
+	// public key keep their address
+	require.NotEmpty(t, report.ModuleAccounts)
+	require.NotEmpty(t, report.Unknown)
+	for _, acc := range report.ModuleAccounts {
+		require.True(t, strings.HasPrefix(acc.To, "bc1p"), acc.To)
+	}
+
+	bz, err = os.ReadFile(outputFile)
+	require.NoError(t, err)
+	require.NotContains(t, string(bz), `"cosmos1`)
+}
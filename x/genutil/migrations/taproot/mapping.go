@@ -0,0 +1,138 @@
+package taproot
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	taprootkey "github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MappingEntry maps the 20-byte address of an account to its taproot address.
+// The taproot address is either given or derived from the secp256k1 public
+// key of the account, which then becomes the taproot key of the account.
+type MappingEntry struct {
+	// Address is the bech32 or P2WPKH encoded 20-byte address of the account.
+	Address string `json:"address"`
+	// PubKey is the hex encoded compressed secp256k1 public key of the
+	// account, used as the internal key of its taproot key.
+	PubKey string `json:"pub_key,omitempty"`
+	// TaprootAddress is the P2TR address of the account.
+	TaprootAddress string `json:"taproot_address,omitempty"`
+}
+
+// ParseMapping decodes a JSON list of mapping entries.
+func ParseMapping(bz []byte) ([]MappingEntry, error) {
+	var entries []MappingEntry
+	if err := json.Unmarshal(bz, &entries); err != nil {
+		return nil, fmt.Errorf("invalid address mapping: %w", err)
+	}
+
+	return entries, nil
+}
+
+// Report lists the accounts moved to a taproot address by a migration, and
+// the ones keeping their 20-byte address as their key is unknown.
+type Report struct {
+	// Accounts are the accounts moved to a taproot address.
+	Accounts []RemappedAccount `json:"accounts"`
+	// ModuleAccounts are the module accounts moved to their taproot address.
+	ModuleAccounts []RemappedAccount `json:"module_accounts"`
+	// Unknown are the addresses of the accounts without known public key nor
+	// mapping entry.
+	Unknown []string `json:"unknown"`
+}
+
+// RemappedAccount is an account moved to a taproot address.
+type RemappedAccount struct {
+	// Name is the name of module accounts.
+	Name string `json:"name,omitempty"`
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// mappedAccount is the taproot address of a 20-byte address, and the taproot
+// key of the account if known.
+type mappedAccount struct {
+	address sdk.AccAddress
+	pubKey  *taprootkey.PubKey
+}
+
+// parseEntry resolves the taproot address of a mapping entry, given the
+// bech32 prefix of the 20-byte addresses.
+func parseEntry(entry MappingEntry, accountPrefix string) (from sdk.AccAddress, to mappedAccount, err error) {
+	from, err = decodeAddress(entry.Address, accountPrefix)
+	if err != nil {
+		return nil, to, fmt.Errorf("invalid address %s: %w", entry.Address, err)
+	}
+
+	if entry.PubKey != "" {
+		bz, err := hex.DecodeString(entry.PubKey)
+		if err != nil {
+			return nil, to, fmt.Errorf("invalid public key of %s: %w", entry.Address, err)
+		}
+
+		to.pubKey, err = taprootKey(bz)
+		if err != nil {
+			return nil, to, fmt.Errorf("invalid public key of %s: %w", entry.Address, err)
+		}
+		to.address = sdk.AccAddress(to.pubKey.Address())
+	}
+
+	if entry.TaprootAddress != "" {
+		bz, err := taprootkey.DecodeSegwitAddress(entry.TaprootAddress, taprootkey.GetBitcoinNetParams())
+		if err != nil || len(bz) != 32 {
+			return nil, to, fmt.Errorf("invalid taproot address of %s: %s", entry.Address, entry.TaprootAddress)
+		}
+		if to.address != nil && !to.address.Equals(sdk.AccAddress(bz)) {
+			return nil, to, fmt.Errorf("taproot address of %s doesn't match its public key", entry.Address)
+		}
+		to.address = bz
+	}
+
+	if to.address == nil {
+		return nil, to, fmt.Errorf("no public key nor taproot address for %s", entry.Address)
+	}
+
+	return from, to, nil
+}
+
+// taprootKey returns the taproot key of a compressed secp256k1 public key.
+func taprootKey(bz []byte) (*taprootkey.PubKey, error) {
+	pubKey, err := secp256k1.ParsePubKey(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taprootkey.PubKey{Key: taprootkey.TweakPubKey(pubKey)}, nil
+}
+
+// decodeAddress decodes a 20-byte address in bech32 with the given prefix or
+// as a P2WPKH address.
+func decodeAddress(text, prefix string) (sdk.AccAddress, error) {
+	bz, err := decodeAccAddress(text, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bz) != 20 {
+		return nil, errors.New("not a 20-byte address")
+	}
+
+	return bz, nil
+}
+
+// decodeAccAddress decodes an account address in bech32 with the given prefix
+// or as a SegWit address.
+func decodeAccAddress(text, prefix string) (sdk.AccAddress, error) {
+	bz, err := sdk.GetFromBech32(text, prefix)
+	if err != nil {
+		return taprootkey.DecodeSegwitAddress(text, taprootkey.GetBitcoinNetParams())
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,360 @@
+package taproot
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	taprootkey "github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// Options are the bech32 prefixes of the addresses of the migrated state.
+type Options struct {
+	AccountPrefix   string
+	ValidatorPrefix string
+	ConsensusPrefix string
+}
+
+// DefaultOptions returns the bech32 prefixes of the SDK config, and the main
+// SDK prefix for account addresses if the config has none.
+func DefaultOptions() Options {
+	config := sdk.GetConfig()
+	accountPrefix := config.GetBech32AccountAddrPrefix()
+	if accountPrefix == "" {
+		accountPrefix = sdk.Bech32MainPrefix
+	}
+
+	return Options{
+		AccountPrefix:   accountPrefix,
+		ValidatorPrefix: config.GetBech32ValidatorAddrPrefix(),
+		ConsensusPrefix: config.GetBech32ConsensusAddrPrefix(),
+	}
+}
+
+// Migrate moves the 20-byte accounts of an exported state to taproot
+// addresses, and renders all addresses in their canonical format.
+//
+// An account moves to the taproot address of its mapping entry if any, or to
+// the one derived from its secp256k1 public key otherwise, taking the taproot
+// key as its public key. Module accounts move from their legacy 20-byte
+// address to the one of authtypes.NewModuleAddress. The accounts without
+// known public key keep their address and are listed in the report.
+//
+// Addresses are rewritten in the state of all modules, as bech32 strings with
+// the prefixes of opts or as P2WPKH strings. Consensus addresses are derived
+// from the consensus keys and are never remapped.
+func Migrate(appState types.AppMap, cdc codec.Codec, entries []MappingEntry, opts Options) (types.AppMap, *Report, error) {
+	if opts.AccountPrefix == "" {
+		return nil, nil, errors.New("account address prefix cannot be empty")
+	}
+
+	states := make(map[string]any, len(appState))
+	for name, state := range appState {
+		if len(state) == 0 {
+			continue
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(state))
+		dec.UseNumber()
+
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode %s genesis state: %w", name, err)
+		}
+		states[name] = v
+	}
+
+	m := migrator{cdc: cdc, opts: opts, mapping: map[string]mappedAccount{}, report: &Report{}}
+	if err := m.buildMapping(states[authtypes.ModuleName], entries); err != nil {
+		return nil, nil, err
+	}
+
+	for name, v := range states {
+		v, err := m.rewrite(v)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to migrate %s genesis state: %w", name, err)
+		}
+
+		appState[name], err = json.Marshal(v)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return appState, m.report, nil
+}
+
+// moduleAccountTypeURL is the type URL of module accounts.
+var moduleAccountTypeURL = sdk.MsgTypeURL(&authtypes.ModuleAccount{})
+
+type migrator struct {
+	cdc     codec.Codec
+	opts    Options
+	mapping map[string]mappedAccount
+	report  *Report
+}
+
+// buildMapping maps the 20-byte accounts of the decoded x/auth genesis state
+// and the addresses of the mapping entries to their taproot address. Accounts
+// are read from their JSON, as their address strings may not be valid in the
+// canonical format.
+func (m *migrator) buildMapping(authState any, entries []MappingEntry) error {
+	for _, entry := range entries {
+		from, to, err := parseEntry(entry, m.opts.AccountPrefix)
+		if err != nil {
+			return err
+		}
+		if _, ok := m.mapping[string(from)]; ok {
+			return fmt.Errorf("duplicate mapping entry for %s", entry.Address)
+		}
+		m.mapping[string(from)] = to
+	}
+
+	var accounts []any
+	if authState, ok := authState.(map[string]any); ok {
+		accounts, _ = authState["accounts"].([]any)
+	}
+
+	existing := map[string]bool{}
+	for _, acc := range accounts {
+		acc, ok := acc.(map[string]any)
+		if !ok {
+			return errors.New("invalid auth genesis account")
+		}
+
+		base := baseAccount(acc)
+		if base == nil {
+			return fmt.Errorf("no base account in auth genesis account of type %v", acc["@type"])
+		}
+
+		addrStr, _ := base["address"].(string)
+		from, err := decodeAccAddress(addrStr, m.opts.AccountPrefix)
+		if err != nil {
+			return fmt.Errorf("invalid auth genesis account address %s: %w", addrStr, err)
+		}
+		existing[string(from)] = true
+
+		if len(from) != 20 {
+			continue
+		}
+
+		if name, ok := acc["name"].(string); ok && acc["@type"] == moduleAccountTypeURL &&
+			from.Equals(sdk.AccAddress(crypto.AddressHash([]byte(name)))) {
+			to := authtypes.NewModuleAddress(name)
+			m.mapping[string(from)] = mappedAccount{address: to}
+			m.report.ModuleAccounts = append(m.report.ModuleAccounts, RemappedAccount{
+				Name: name, From: m.legacyString(from), To: to.String(),
+			})
+			continue
+		}
+
+		to, ok := m.mapping[string(from)]
+		if !ok {
+			pubKey, err := m.secp256k1PubKey(base["pub_key"])
+			if err != nil {
+				return fmt.Errorf("invalid public key of %s: %w", m.legacyString(from), err)
+			}
+			if pubKey == nil {
+				m.report.Unknown = append(m.report.Unknown, m.legacyString(from))
+				continue
+			}
+
+			to.pubKey, err = taprootKey(pubKey.Key)
+			if err != nil {
+				return fmt.Errorf("invalid public key of %s: %w", m.legacyString(from), err)
+			}
+			to.address = sdk.AccAddress(to.pubKey.Address())
+			m.mapping[string(from)] = to
+		}
+
+		m.report.Accounts = append(m.report.Accounts, RemappedAccount{From: m.legacyString(from), To: to.address.String()})
+	}
+
+	targets := map[string]bool{}
+	for from, to := range m.mapping {
+		if existing[string(to.address)] || targets[string(to.address)] {
+			return fmt.Errorf("%s can't move to %s, which is already taken", m.legacyString(sdk.AccAddress(from)), to.address)
+		}
+		targets[string(to.address)] = true
+	}
+
+	return nil
+}
+
+// secp256k1PubKey decodes the JSON of the public key of an account, nil if
+// the account has no secp256k1 key.
+func (m *migrator) secp256k1PubKey(v any) (*secp256k1.PubKey, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var pubKey cryptotypes.PubKey
+	if err := m.cdc.UnmarshalInterfaceJSON(bz, &pubKey); err != nil {
+		return nil, err
+	}
+
+	secp256k1PubKey, _ := pubKey.(*secp256k1.PubKey)
+	return secp256k1PubKey, nil
+}
+
+// baseAccount returns the JSON of the base account of an account, the first
+// object with an address, e.g. nested in vesting and module accounts.
+func baseAccount(acc map[string]any) map[string]any {
+	if _, ok := acc["address"].(string); ok {
+		return acc
+	}
+
+	for _, v := range acc {
+		if v, ok := v.(map[string]any); ok {
+			if base := baseAccount(v); base != nil {
+				return base
+			}
+		}
+	}
+
+	return nil
+}
+
+// rewrite rewrites the addresses of a decoded JSON value.
+func (m *migrator) rewrite(v any) (any, error) {
+	switch v := v.(type) {
+	case map[string]any:
+		// accounts moved to a taproot address take its taproot key, if known
+		if addr, ok := v["address"].(string); ok {
+			if _, hasPubKey := v["pub_key"]; hasPubKey {
+				if to, ok := m.lookup(addr); ok {
+					pubKey, err := m.pubKeyJSON(to.pubKey)
+					if err != nil {
+						return nil, err
+					}
+					v["pub_key"] = pubKey
+				}
+			}
+		}
+
+		for key, value := range v {
+			value, err := m.rewrite(value)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = value
+		}
+
+		return v, nil
+
+	case []any:
+		for i, value := range v {
+			value, err := m.rewrite(value)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = value
+		}
+
+		return v, nil
+
+	case string:
+		return m.rewriteAddress(v), nil
+
+	default:
+		return v, nil
+	}
+}
+
+// rewriteAddress remaps an address string and renders it in its canonical
+// format. Other strings are returned unchanged.
+func (m *migrator) rewriteAddress(text string) string {
+	hrp, bz, err := bech32.DecodeAndConvert(text)
+	if err != nil {
+		// a P2WPKH address, as rendered by the codecs of taproot chains
+		bz, err := taprootkey.DecodeSegwitAddress(text, taprootkey.GetBitcoinNetParams())
+		if err != nil || len(bz) != 20 {
+			return text
+		}
+
+		to, ok := m.mapping[string(bz)]
+		if !ok {
+			return text
+		}
+
+		str, err := taprootkey.EncodeSegwitAddress(to.address, taprootkey.GetBitcoinNetParams())
+		if err != nil {
+			return text
+		}
+		return str
+	}
+
+	if len(bz) != 20 && len(bz) != 32 {
+		return text
+	}
+
+	switch hrp {
+	case m.opts.AccountPrefix:
+		if to, ok := m.mapping[string(bz)]; ok {
+			bz = to.address
+		}
+		return sdk.AccAddress(bz).String()
+
+	case m.opts.ValidatorPrefix:
+		if to, ok := m.mapping[string(bz)]; ok {
+			bz = to.address
+		}
+		return sdk.ValAddress(bz).String()
+
+	case m.opts.ConsensusPrefix:
+		return sdk.ConsAddress(bz).String()
+
+	default:
+		return text
+	}
+}
+
+// lookup returns the mapping of an account address string.
+func (m *migrator) lookup(text string) (mappedAccount, bool) {
+	from, err := decodeAddress(text, m.opts.AccountPrefix)
+	if err != nil {
+		return mappedAccount{}, false
+	}
+
+	to, ok := m.mapping[string(from)]
+	return to, ok
+}
+
+// pubKeyJSON returns the decoded JSON of a public key, nil if unknown.
+func (m *migrator) pubKeyJSON(pubKey *taprootkey.PubKey) (any, error) {
+	if pubKey == nil {
+		return nil, nil
+	}
+
+	bz, err := m.cdc.MarshalInterfaceJSON(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var v any
+	if err := json.Unmarshal(bz, &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// legacyString renders a 20-byte address in bech32.
+func (m *migrator) legacyString(addr sdk.AccAddress) string {
+	return sdk.MustBech32ifyAddressBytes(m.opts.AccountPrefix, addr)
+}
@@ -0,0 +1,183 @@
+package taproot_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto"
+	dcrsecp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	taprootkey "github.com/cosmos/cosmos-sdk/crypto/keys/taproot"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil/migrations/taproot"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+var opts = taproot.Options{AccountPrefix: "cosmos", ValidatorPrefix: "cosmosvaloper", ConsensusPrefix: "cosmosvalcons"}
+
+func legacyAccount(addr []byte, pubKey *secp256k1.PubKey, accNum uint64) *authtypes.BaseAccount {
+	acc := &authtypes.BaseAccount{Address: sdk.MustBech32ifyAddressBytes("cosmos", addr), AccountNumber: accNum}
+	if pubKey != nil {
+		if err := acc.SetPubKey(pubKey); err != nil {
+			panic(err)
+		}
+	}
+
+	return acc
+}
+
+func legacyGenesis(t *testing.T, cdc codec.Codec, accounts authtypes.GenesisAccounts, balances []banktypes.Balance) types.AppMap {
+	t.Helper()
+
+	authState := authtypes.NewGenesisState(authtypes.DefaultParams(), accounts)
+	bankState := banktypes.NewGenesisState(banktypes.DefaultParams(), balances, nil, nil, nil)
+
+	return types.AppMap{
+		authtypes.ModuleName: cdc.MustMarshalJSON(authState),
+		banktypes.ModuleName: cdc.MustMarshalJSON(bankState),
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}).Codec
+
+	// an account with a known secp256k1 key
+	secpKey := secp256k1.GenPrivKey().PubKey().(*secp256k1.PubKey)
+	secpAddr := secpKey.Address().Bytes()
+	expSecpKey := &taprootkey.PubKey{Key: mustTweak(t, secpKey.Key)}
+
+	// an account mapped to a taproot address
+	mappedAddr := secp256k1.GenPrivKey().PubKey().Address().Bytes()
+	expMappedAddr := taprootkey.GenPrivKey().PubKey().Address().Bytes()
+	expMappedStr, err := taprootkey.EncodeSegwitAddress(expMappedAddr, taprootkey.GetBitcoinNetParams())
+	require.NoError(t, err)
+
+	// an account without known key
+	unknownAddr := secp256k1.GenPrivKey().PubKey().Address().Bytes()
+
+	// a module account at its legacy address
+	legacyModuleAddr := crypto.AddressHash([]byte("distribution"))
+	moduleAcc := authtypes.NewModuleAccount(legacyAccount(legacyModuleAddr, nil, 3), "distribution")
+
+	accounts := authtypes.GenesisAccounts{
+		legacyAccount(secpAddr, secpKey, 0),
+		legacyAccount(mappedAddr, nil, 1),
+		legacyAccount(unknownAddr, nil, 2),
+		moduleAcc,
+	}
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	balances := []banktypes.Balance{
+		{Address: sdk.MustBech32ifyAddressBytes("cosmos", secpAddr), Coins: coins},
+		{Address: sdk.MustBech32ifyAddressBytes("cosmos", legacyModuleAddr), Coins: coins},
+		{Address: sdk.MustBech32ifyAddressBytes("cosmos", unknownAddr), Coins: coins},
+	}
+
+	entries := []taproot.MappingEntry{{Address: sdk.MustBech32ifyAddressBytes("cosmos", mappedAddr), TaprootAddress: expMappedStr}}
+	appState := legacyGenesis(t, cdc, accounts, balances)
+	// a module state with validator operator and consensus addresses
+	consAddr := secp256k1.GenPrivKey().PubKey().Address().Bytes()
+	appState["other"] = json.RawMessage(`{"validators": [{"operator": "` + sdk.MustBech32ifyAddressBytes("cosmosvaloper", secpAddr) +
+		`", "cons": "` + sdk.MustBech32ifyAddressBytes("cosmosvalcons", consAddr) + `", "power": 12345678901234567890}]}`)
+
+	appState, report, err := taproot.Migrate(appState, cdc, entries, opts)
+	require.NoError(t, err)
+
+	var authState authtypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[authtypes.ModuleName], &authState)
+	migrated, err := authtypes.UnpackAccounts(authState.Accounts)
+	require.NoError(t, err)
+	require.Len(t, migrated, 4)
+
+	require.Equal(t, sdk.AccAddress(expSecpKey.Address()), migrated[0].GetAddress())
+	require.True(t, expSecpKey.Equals(migrated[0].GetPubKey()))
+	require.Equal(t, sdk.AccAddress(expMappedAddr), migrated[1].GetAddress())
+	require.Nil(t, migrated[1].GetPubKey())
+	require.Equal(t, sdk.AccAddress(unknownAddr), migrated[2].GetAddress())
+	require.Equal(t, authtypes.NewModuleAddress("distribution"), migrated[3].GetAddress())
+	require.Equal(t, uint64(3), migrated[3].GetAccountNumber())
+
+	var bankState banktypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[banktypes.ModuleName], &bankState)
+	require.Equal(t, sdk.AccAddress(expSecpKey.Address()).String(), bankState.Balances[0].Address)
+	require.Equal(t, authtypes.NewModuleAddress("distribution").String(), bankState.Balances[1].Address)
+	require.Equal(t, sdk.AccAddress(unknownAddr).String(), bankState.Balances[2].Address)
+
+	require.JSONEq(t, `{"validators": [{"operator": "`+sdk.ValAddress(expSecpKey.Address()).String()+
+		`", "cons": "`+sdk.ConsAddress(consAddr).String()+`", "power": 12345678901234567890}]}`, string(appState["other"]))
+
+	require.Equal(t, []taproot.RemappedAccount{
+		{From: sdk.MustBech32ifyAddressBytes("cosmos", secpAddr), To: sdk.AccAddress(expSecpKey.Address()).String()},
+		{From: sdk.MustBech32ifyAddressBytes("cosmos", mappedAddr), To: sdk.AccAddress(expMappedAddr).String()},
+	}, report.Accounts)
+	require.Equal(t, []taproot.RemappedAccount{
+		{Name: "distribution", From: sdk.MustBech32ifyAddressBytes("cosmos", legacyModuleAddr), To: authtypes.NewModuleAddress("distribution").String()},
+	}, report.ModuleAccounts)
+	require.Equal(t, []string{sdk.MustBech32ifyAddressBytes("cosmos", unknownAddr)}, report.Unknown)
+}
+
+func TestMigrateErrors(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}).Codec
+
+	secpKey := secp256k1.GenPrivKey().PubKey().(*secp256k1.PubKey)
+	secpAddr := secpKey.Address().Bytes()
+	taprootAddr := taprootkey.GenPrivKey().PubKey().Address().Bytes()
+	taprootStr, err := taprootkey.EncodeSegwitAddress(taprootAddr, taprootkey.GetBitcoinNetParams())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		accounts authtypes.GenesisAccounts
+		entries  []taproot.MappingEntry
+		expErr   string
+	}{
+		{
+			"mapping entry without taproot key",
+			authtypes.GenesisAccounts{legacyAccount(secpAddr, nil, 0)},
+			[]taproot.MappingEntry{{Address: sdk.MustBech32ifyAddressBytes("cosmos", secpAddr)}},
+			"no public key nor taproot address",
+		},
+		{
+			"mapping entry with a mismatching key",
+			authtypes.GenesisAccounts{legacyAccount(secpAddr, nil, 0)},
+			[]taproot.MappingEntry{{
+				Address:        sdk.MustBech32ifyAddressBytes("cosmos", secpAddr),
+				PubKey:         hex.EncodeToString(secpKey.Key),
+				TaprootAddress: taprootStr,
+			}},
+			"doesn't match its public key",
+		},
+		{
+			"target address already taken",
+			authtypes.GenesisAccounts{
+				legacyAccount(secpAddr, nil, 0),
+				&authtypes.BaseAccount{Address: taprootStr, AccountNumber: 1},
+			},
+			[]taproot.MappingEntry{{Address: sdk.MustBech32ifyAddressBytes("cosmos", secpAddr), TaprootAddress: taprootStr}},
+			"already taken",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := taproot.Migrate(legacyGenesis(t, cdc, tc.accounts, nil), cdc, tc.entries, opts)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func mustTweak(t *testing.T, bz []byte) []byte {
+	t.Helper()
+
+	pubKey, err := dcrsecp256k1.ParsePubKey(bz)
+	require.NoError(t, err)
+	return taprootkey.TweakPubKey(pubKey)
+}
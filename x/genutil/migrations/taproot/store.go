@@ -0,0 +1,97 @@
+package taproot
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/genesis"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// MigrateStores runs Migrate in place on the state of the modules of
+// storeKeys, a map of module names to their store keys, e.g. in an upgrade
+// handler. The state of these modules is exported, migrated, then imported
+// back into their emptied stores in the genesis order of mm. The validator
+// set updates returned by the modules are ignored, as consensus keys don't
+// change. storeKeys must hold every module whose state holds account
+// addresses, as the state of the other ones keeps the legacy addresses.
+func MigrateStores(ctx sdk.Context, mm *module.Manager, cdc codec.Codec, storeKeys map[string]storetypes.StoreKey,
+	entries []MappingEntry, opts Options,
+) (*Report, error) {
+	modules := make([]string, 0, len(storeKeys))
+	for _, name := range mm.OrderExportGenesis {
+		if _, ok := storeKeys[name]; ok {
+			modules = append(modules, name)
+		}
+	}
+	if len(modules) != len(storeKeys) {
+		return nil, fmt.Errorf("all migrated modules must export their genesis, got %d of %d", len(modules), len(storeKeys))
+	}
+
+	exported, err := mm.ExportGenesisForModules(ctx, cdc, modules)
+	if err != nil {
+		return nil, err
+	}
+
+	appState, report, err := Migrate(types.AppMap(exported), cdc, entries, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range modules {
+		clearStore(ctx.KVStore(storeKeys[name]))
+	}
+
+	for _, name := range mm.OrderInitGenesis {
+		if _, ok := storeKeys[name]; !ok || appState[name] == nil {
+			continue
+		}
+
+		if err := initGenesis(ctx, cdc, mm.Modules[name], appState[name]); err != nil {
+			return nil, fmt.Errorf("failed to import %s genesis state: %w", name, err)
+		}
+	}
+
+	return report, nil
+}
+
+// initGenesis initializes a module like module.Manager.InitGenesis.
+func initGenesis(ctx sdk.Context, cdc codec.JSONCodec, mod any, state json.RawMessage) error {
+	switch mod := mod.(type) {
+	case appmodule.HasGenesis:
+		source, err := genesis.SourceFromRawJSON(state)
+		if err != nil {
+			return err
+		}
+		return mod.InitGenesis(ctx, source)
+
+	case module.HasGenesis:
+		mod.InitGenesis(ctx, cdc, state)
+
+	case module.HasABCIGenesis:
+		mod.InitGenesis(ctx, cdc, state)
+	}
+
+	return nil
+}
+
+// clearStore deletes all the entries of a store.
+func clearStore(store storetypes.KVStore) {
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, bytes.Clone(iter.Key()))
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}